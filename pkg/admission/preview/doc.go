@@ -0,0 +1,5 @@
+// Package preview exposes a dry-run view of the admission chain: it records
+// the named admission plugins as they are built and can run an object through
+// the mutating plugins without persisting anything, reporting the mutated
+// object and which plugins changed it.
+package preview
@@ -0,0 +1,163 @@
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	kauthorizer "k8s.io/apiserver/pkg/authorization/authorizer"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+
+	restutil "github.com/openshift/origin/pkg/util/rest"
+)
+
+// Response is the JSON document returned by the preview endpoint.
+type Response struct {
+	// AppliedPlugins names the admission plugins that mutated the object, in
+	// chain order.
+	AppliedPlugins []string `json:"appliedPlugins"`
+
+	// SkippedPlugins names the admission plugins that were not run because
+	// their Admit has side effects beyond the object, so the preview is
+	// partial with respect to them.
+	SkippedPlugins []string `json:"skippedPlugins,omitempty"`
+
+	// Object is the object after the mutating admission plugins ran.
+	Object json.RawMessage `json:"object,omitempty"`
+
+	// Error describes an admission rejection, if any.  The plugins that ran
+	// before the rejection are still reported in appliedPlugins.
+	Error string `json:"error,omitempty"`
+}
+
+// Handler answers POST requests carrying an object in JSON or YAML by running
+// it through the recorded admission plugins as a dry-run create and returning
+// the mutated object plus the plugins that changed it.
+type Handler struct {
+	registry      *PluginRegistry
+	contextMapper apirequest.RequestContextMapper
+	authorizer    kauthorizer.Authorizer
+	mapper        meta.RESTMapper
+}
+
+// NewHandler returns a Handler backed by the given plugin registry. The
+// authorizer gates the endpoint: a preview is only served to callers who may
+// create the posted resource in the target namespace.
+func NewHandler(registry *PluginRegistry, contextMapper apirequest.RequestContextMapper, authorizer kauthorizer.Authorizer) *Handler {
+	return &Handler{
+		registry:      registry,
+		contextMapper: contextMapper,
+		authorizer:    authorizer,
+		mapper:        restutil.DefaultMultiRESTMapper(),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	obj, err := runtime.Decode(legacyscheme.Codecs.UniversalDecoder(), body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not decode object: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	namespace := accessor.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = req.URL.Query().Get("namespace")
+	}
+	if len(namespace) == 0 {
+		http.Error(w, "a namespace is required, in the object metadata or as the namespace query parameter", http.StatusBadRequest)
+		return
+	}
+	accessor.SetNamespace(namespace)
+
+	gvks, _, err := legacyscheme.Scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		http.Error(w, fmt.Sprintf("could not determine object kind: %v", err), http.StatusBadRequest)
+		return
+	}
+	gvk := gvks[0]
+	mapping, err := h.mapper.RESTMapping(gvk.GroupKind())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not map object kind: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx, ok := h.contextMapper.Get(req)
+	if !ok {
+		http.Error(w, "no context found for request", http.StatusInternalServerError)
+		return
+	}
+	userInfo, ok := apirequest.UserFrom(ctx)
+	if !ok {
+		http.Error(w, "unable to determine the user for this request", http.StatusForbidden)
+		return
+	}
+
+	// only serve a preview to callers who could create the object for real
+	authzAttributes := kauthorizer.AttributesRecord{
+		User:            userInfo,
+		Verb:            "create",
+		Namespace:       namespace,
+		APIGroup:        mapping.GroupVersionKind.Group,
+		APIVersion:      mapping.GroupVersionKind.Version,
+		Resource:        mapping.Resource,
+		Name:            accessor.GetName(),
+		ResourceRequest: true,
+	}
+	authorized, reason, err := h.authorizer.Authorize(authzAttributes)
+	if err != nil {
+		http.Error(w, "unable to authorize the request due to an error", http.StatusInternalServerError)
+		return
+	}
+	if authorized != kauthorizer.DecisionAllow {
+		http.Error(w, fmt.Sprintf("you may not create %s in namespace %q: %s", mapping.Resource, namespace, reason), http.StatusForbidden)
+		return
+	}
+
+	attributes := admission.NewAttributesRecord(
+		obj,
+		nil,
+		gvk,
+		namespace,
+		accessor.GetName(),
+		mapping.GroupVersionKind.GroupVersion().WithResource(mapping.Resource),
+		"",
+		admission.Create,
+		userInfo,
+	)
+
+	response := Response{}
+	response.AppliedPlugins, response.SkippedPlugins, err = Preview(h.registry.Plugins(), attributes)
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	if data, err := runtime.Encode(legacyscheme.Codecs.LegacyCodec(mapping.GroupVersionKind.GroupVersion()), attributes.GetObject()); err == nil {
+		response.Object = json.RawMessage(data)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,101 @@
+package preview
+
+import (
+	"fmt"
+	"sync"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// pluginsWithSideEffects names admission plugins whose Admit does more than
+// mutate or reject the incoming object: they charge quota, provision other
+// objects, or call out to external services. Running them against an object
+// that is never persisted would change cluster state, so the preview skips
+// them and reports them as skipped instead.
+var pluginsWithSideEffects = sets.NewString(
+	"ResourceQuota",
+	"openshift.io/ClusterResourceQuota",
+	"EventRateLimit",
+	"openshift.io/JenkinsBootstrapper",
+	"MutatingAdmissionWebhook",
+	"ValidatingAdmissionWebhook",
+	"ImagePolicyWebhook",
+	"Initializers",
+)
+
+// NamedPlugin pairs an admission plugin with the name it was registered
+// under.
+type NamedPlugin struct {
+	Name   string
+	Plugin admission.Interface
+}
+
+// PluginRegistry records the admission plugins in chain order as they are
+// built, so the preview endpoint can run them individually.
+type PluginRegistry struct {
+	lock    sync.Mutex
+	plugins []NamedPlugin
+}
+
+// NewPluginRegistry returns an empty PluginRegistry.
+func NewPluginRegistry() *PluginRegistry {
+	return &PluginRegistry{}
+}
+
+// Decorate returns an admission decorator that records each plugin after
+// passing it through delegate, preserving whatever decoration the chain
+// builder applies.
+func (r *PluginRegistry) Decorate(delegate admission.Decorator) admission.Decorator {
+	return func(handler admission.Interface, name string) admission.Interface {
+		if delegate != nil {
+			handler = delegate(handler, name)
+		}
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.plugins = append(r.plugins, NamedPlugin{Name: name, Plugin: handler})
+		return handler
+	}
+}
+
+// Plugins returns the recorded plugins in chain order.
+func (r *PluginRegistry) Plugins() []NamedPlugin {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]NamedPlugin{}, r.plugins...)
+}
+
+// Preview runs the attributes' object through each mutating plugin in order
+// and returns the names of the plugins that changed it.  The object held by
+// attributes is mutated in place, exactly as it would be during a real
+// create.  Plugins known to have side effects beyond the object are not run;
+// their names are returned as skipped so the caller knows the preview is
+// partial.  If a plugin rejects the object, the plugins applied so far are
+// returned along with the rejection.
+func Preview(plugins []NamedPlugin, attributes admission.Attributes) (applied, skipped []string, err error) {
+	applied = []string{}
+	skipped = []string{}
+	for _, p := range plugins {
+		if !p.Plugin.Handles(attributes.GetOperation()) {
+			continue
+		}
+		mutator, ok := p.Plugin.(admission.MutationInterface)
+		if !ok {
+			continue
+		}
+		if pluginsWithSideEffects.Has(p.Name) {
+			skipped = append(skipped, p.Name)
+			continue
+		}
+
+		before := attributes.GetObject().DeepCopyObject()
+		if err := mutator.Admit(attributes); err != nil {
+			return applied, skipped, fmt.Errorf("admission plugin %q rejected the object: %v", p.Name, err)
+		}
+		if !apiequality.Semantic.DeepEqual(before, attributes.GetObject()) {
+			applied = append(applied, p.Name)
+		}
+	}
+	return applied, skipped, nil
+}
@@ -0,0 +1,155 @@
+package preview
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+)
+
+type fakePlugin struct {
+	*admission.Handler
+	mutate func(obj *kapi.Pod)
+	err    error
+}
+
+func (f *fakePlugin) Admit(a admission.Attributes) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.mutate != nil {
+		f.mutate(a.GetObject().(*kapi.Pod))
+	}
+	return nil
+}
+
+func newAttributes(pod *kapi.Pod) admission.Attributes {
+	return admission.NewAttributesRecord(
+		pod,
+		nil,
+		kapi.Kind("Pod").WithVersion(""),
+		"test",
+		"pod",
+		schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		"",
+		admission.Create,
+		nil,
+	)
+}
+
+func TestPreview(t *testing.T) {
+	plugins := []NamedPlugin{
+		{Name: "mutates", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.NodeSelector = map[string]string{"region": "test"} },
+		}},
+		{Name: "passes", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+		}},
+		{Name: "wrong-operation", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Delete),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.ServiceAccountName = "never" },
+		}},
+	}
+
+	pod := &kapi.Pod{}
+	applied, skipped, err := Preview(plugins, newAttributes(pod))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applied) != 1 || applied[0] != "mutates" {
+		t.Errorf("expected applied plugins [mutates], got %v", applied)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("expected no skipped plugins, got %v", skipped)
+	}
+	if pod.Spec.NodeSelector["region"] != "test" {
+		t.Errorf("expected the mutation to be applied to the object, got %#v", pod.Spec.NodeSelector)
+	}
+	if len(pod.Spec.ServiceAccountName) != 0 {
+		t.Errorf("plugin that does not handle create should not have run")
+	}
+}
+
+func TestPreviewSkipsSideEffectPlugins(t *testing.T) {
+	plugins := []NamedPlugin{
+		{Name: "ResourceQuota", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.ServiceAccountName = "never" },
+		}},
+		{Name: "mutates", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.NodeSelector = map[string]string{"region": "test"} },
+		}},
+	}
+
+	pod := &kapi.Pod{}
+	applied, skipped, err := Preview(plugins, newAttributes(pod))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applied) != 1 || applied[0] != "mutates" {
+		t.Errorf("expected applied plugins [mutates], got %v", applied)
+	}
+	if len(skipped) != 1 || skipped[0] != "ResourceQuota" {
+		t.Errorf("expected skipped plugins [ResourceQuota], got %v", skipped)
+	}
+	if len(pod.Spec.ServiceAccountName) != 0 {
+		t.Errorf("plugin with side effects should not have run")
+	}
+}
+
+func TestPreviewRejection(t *testing.T) {
+	plugins := []NamedPlugin{
+		{Name: "mutates", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.NodeSelector = map[string]string{"region": "test"} },
+		}},
+		{Name: "rejects", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			err:     fmt.Errorf("not allowed"),
+		}},
+		{Name: "never-runs", Plugin: &fakePlugin{
+			Handler: admission.NewHandler(admission.Create),
+			mutate:  func(pod *kapi.Pod) { pod.Spec.ServiceAccountName = "never" },
+		}},
+	}
+
+	pod := &kapi.Pod{}
+	applied, _, err := Preview(plugins, newAttributes(pod))
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+	if len(applied) != 1 || applied[0] != "mutates" {
+		t.Errorf("expected applied plugins [mutates], got %v", applied)
+	}
+	if len(pod.Spec.ServiceAccountName) != 0 {
+		t.Errorf("plugins after a rejection should not have run")
+	}
+}
+
+func TestPluginRegistryDecorate(t *testing.T) {
+	registry := NewPluginRegistry()
+
+	delegated := []string{}
+	delegate := admission.Decorator(func(handler admission.Interface, name string) admission.Interface {
+		delegated = append(delegated, name)
+		return handler
+	})
+
+	decorator := registry.Decorate(delegate)
+	first := &fakePlugin{Handler: admission.NewHandler(admission.Create)}
+	second := &fakePlugin{Handler: admission.NewHandler(admission.Create)}
+	decorator(first, "first")
+	decorator(second, "second")
+
+	plugins := registry.Plugins()
+	if len(plugins) != 2 || plugins[0].Name != "first" || plugins[1].Name != "second" {
+		t.Errorf("expected plugins [first second] in order, got %v", plugins)
+	}
+	if len(delegated) != 2 {
+		t.Errorf("expected the delegate decorator to be called for each plugin, got %v", delegated)
+	}
+}
@@ -0,0 +1,110 @@
+package internaloauth
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	userv1 "github.com/openshift/api/user/v1"
+	"github.com/openshift/origin/pkg/oauth/apis/oauth"
+)
+
+const (
+	// TokenSourceCIDRsAnnotation holds a comma separated list of CIDRs recorded
+	// on an access token at issuance. When present, the token is only valid for
+	// requests originating from one of the listed networks.
+	TokenSourceCIDRsAnnotation = "oauth.openshift.io/token.source-cidrs"
+	// TokenCertificateFingerprintAnnotation holds the hex encoded SHA-256
+	// fingerprint of a client certificate recorded on an access token at
+	// issuance. When present, the token is only valid on connections that
+	// presented a certificate with that fingerprint.
+	TokenCertificateFingerprintAnnotation = "oauth.openshift.io/token.cert-sha256"
+)
+
+var (
+	errTokenWrongSource = errors.New("token is not valid from this source address")
+	errTokenWrongCert   = errors.New("token is not valid for the presented client certificate")
+	errTokenBound       = errors.New("token is bound to request attributes that cannot be verified on this connection")
+)
+
+// NewBindingValidator returns a validator that enforces the optional source
+// CIDR and client certificate bindings recorded on a token against the
+// attributes of the current request. Tokens without binding annotations are
+// accepted unchanged.
+func NewBindingValidator(remoteAddr string, peerCertificates []*x509.Certificate) OAuthTokenValidator {
+	return OAuthTokenValidatorFunc(
+		func(token *oauth.OAuthAccessToken, _ *userv1.User) error {
+			if cidrs, ok := token.Annotations[TokenSourceCIDRsAnnotation]; ok {
+				if err := validateSourceCIDRs(cidrs, remoteAddr); err != nil {
+					return err
+				}
+			}
+			if fingerprint, ok := token.Annotations[TokenCertificateFingerprintAnnotation]; ok {
+				if err := validateCertificateFingerprint(fingerprint, peerCertificates); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// NewUnboundTokenValidator returns a validator that rejects any token carrying
+// binding annotations. It guards token authentication paths that have no view
+// of the request and therefore cannot enforce bindings.
+func NewUnboundTokenValidator() OAuthTokenValidator {
+	return OAuthTokenValidatorFunc(
+		func(token *oauth.OAuthAccessToken, _ *userv1.User) error {
+			if _, ok := token.Annotations[TokenSourceCIDRsAnnotation]; ok {
+				return errTokenBound
+			}
+			if _, ok := token.Annotations[TokenCertificateFingerprintAnnotation]; ok {
+				return errTokenBound
+			}
+			return nil
+		},
+	)
+}
+
+func validateSourceCIDRs(cidrs, remoteAddr string) error {
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("unable to determine source address for token validation: %q", remoteAddr)
+	}
+	for _, cidr := range strings.Split(cidrs, ",") {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			// an unparseable binding fails closed
+			continue
+		}
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+	return errTokenWrongSource
+}
+
+func validateCertificateFingerprint(fingerprint string, peerCertificates []*x509.Certificate) error {
+	for _, cert := range peerCertificates {
+		if CertificateFingerprint(cert) == strings.ToLower(fingerprint) {
+			return nil
+		}
+	}
+	return errTokenWrongCert
+}
+
+// CertificateFingerprint returns the hex encoded SHA-256 digest of the
+// certificate in DER form, the value recorded in
+// TokenCertificateFingerprintAnnotation.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
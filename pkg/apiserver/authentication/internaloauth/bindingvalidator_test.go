@@ -0,0 +1,108 @@
+package internaloauth
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/origin/pkg/oauth/apis/oauth"
+)
+
+func TestBindingValidatorSourceCIDRs(t *testing.T) {
+	tests := []struct {
+		name        string
+		cidrs       string
+		remoteAddr  string
+		expectError bool
+	}{
+		{
+			name:       "no binding",
+			cidrs:      "",
+			remoteAddr: "10.0.0.1:39212",
+		},
+		{
+			name:       "address in range",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "10.1.2.3:39212",
+		},
+		{
+			name:       "address in second range",
+			cidrs:      "192.168.0.0/16,10.0.0.0/8",
+			remoteAddr: "10.1.2.3:39212",
+		},
+		{
+			name:        "address out of range",
+			cidrs:       "192.168.0.0/16",
+			remoteAddr:  "10.1.2.3:39212",
+			expectError: true,
+		},
+		{
+			name:       "remote address without port",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "10.1.2.3",
+		},
+		{
+			name:        "unparseable binding fails closed",
+			cidrs:       "not-a-cidr",
+			remoteAddr:  "10.1.2.3:39212",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		token := &oauth.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "token"},
+		}
+		if len(test.cidrs) > 0 {
+			token.Annotations = map[string]string{TokenSourceCIDRsAnnotation: test.cidrs}
+		}
+		err := NewBindingValidator(test.remoteAddr, nil).Validate(token, nil)
+		if test.expectError && err == nil {
+			t.Errorf("%s: expected error, got none", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestUnboundTokenValidator(t *testing.T) {
+	unbound := &oauth.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{Name: "token"},
+	}
+	if err := NewUnboundTokenValidator().Validate(unbound, nil); err != nil {
+		t.Errorf("unexpected error for unbound token: %v", err)
+	}
+
+	bound := &oauth.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "token",
+			Annotations: map[string]string{TokenSourceCIDRsAnnotation: "10.0.0.0/8"},
+		},
+	}
+	if err := NewUnboundTokenValidator().Validate(bound, nil); err == nil {
+		t.Errorf("expected error for token with source binding")
+	}
+
+	certBound := &oauth.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "token",
+			Annotations: map[string]string{TokenCertificateFingerprintAnnotation: "abcdef"},
+		},
+	}
+	if err := NewUnboundTokenValidator().Validate(certBound, nil); err == nil {
+		t.Errorf("expected error for token with certificate binding")
+	}
+}
+
+func TestBindingValidatorCertificateFingerprint(t *testing.T) {
+	token := &oauth.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "token",
+			Annotations: map[string]string{TokenCertificateFingerprintAnnotation: "abcdef"},
+		},
+	}
+	if err := NewBindingValidator("10.0.0.1:1", nil).Validate(token, nil); err == nil {
+		t.Errorf("expected error when no client certificate is presented")
+	}
+}
@@ -0,0 +1,67 @@
+package internaloauth
+
+import (
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kauthenticator "k8s.io/apiserver/pkg/authentication/authenticator"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+	kcoreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	userclient "github.com/openshift/client-go/user/clientset/versioned/typed/user/v1"
+	oauthclient "github.com/openshift/origin/pkg/oauth/generated/internalclientset/typed/oauth/internalversion"
+)
+
+// boundTokenAuthenticator authenticates bearer tokens like the token
+// authenticator, but additionally enforces source CIDR and client certificate
+// bindings recorded on tokens, which requires access to the request.
+type boundTokenAuthenticator struct {
+	delegate *tokenAuthenticator
+}
+
+// NewBoundTokenAuthenticator returns a request authenticator for bearer tokens
+// that enforces any bindings recorded on the token at issuance. Tokens without
+// bindings behave exactly as with NewTokenAuthenticator.
+func NewBoundTokenAuthenticator(tokens oauthclient.OAuthAccessTokenInterface, users userclient.UserInterface, serviceAccounts kcoreclient.ServiceAccountsGetter, groupMapper UserToGroupMapper, validators ...OAuthTokenValidator) kauthenticator.Request {
+	return &boundTokenAuthenticator{
+		delegate: &tokenAuthenticator{
+			tokens:          tokens,
+			users:           users,
+			serviceAccounts: serviceAccounts,
+			groupMapper:     groupMapper,
+			validators:      OAuthTokenValidators(validators),
+		},
+	}
+}
+
+func (a *boundTokenAuthenticator) AuthenticateRequest(req *http.Request) (kuser.Info, bool, error) {
+	auth := strings.TrimSpace(req.Header.Get("Authorization"))
+	if auth == "" {
+		return nil, false, nil
+	}
+	parts := strings.Split(auth, " ")
+	if len(parts) < 2 || !strings.EqualFold(parts[0], "bearer") {
+		return nil, false, nil
+	}
+	token := parts[1]
+	if len(token) == 0 {
+		return nil, false, nil
+	}
+
+	var peerCertificates []*x509.Certificate
+	if req.TLS != nil {
+		peerCertificates = req.TLS.PeerCertificates
+	}
+
+	user, ok, err := a.delegate.authenticateToken(token, NewBindingValidator(req.RemoteAddr, peerCertificates))
+	if kerrors.IsNotFound(err) {
+		// not an OAuth access token, let the rest of the chain handle it
+		return nil, false, nil
+	}
+	if ok {
+		req.Header.Del("Authorization")
+	}
+	return user, ok, err
+}
@@ -0,0 +1,24 @@
+package internaloauth
+
+import (
+	"errors"
+
+	userv1 "github.com/openshift/api/user/v1"
+	"github.com/openshift/origin/pkg/oauth/apis/oauth"
+)
+
+var errUserDisabled = errors.New("user is disabled")
+
+// NewDisabledUserValidator rejects tokens that belong to a disabled user,
+// which makes deactivating a user take effect without deleting the user
+// or any of its tokens.
+func NewDisabledUserValidator() OAuthTokenValidator {
+	return OAuthTokenValidatorFunc(
+		func(_ *oauth.OAuthAccessToken, user *userv1.User) error {
+			if user.Disabled {
+				return errUserDisabled
+			}
+			return nil
+		},
+	)
+}
@@ -0,0 +1,55 @@
+package internaloauth
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	userapi "github.com/openshift/api/user/v1"
+	userfake "github.com/openshift/client-go/user/clientset/versioned/fake"
+	oapi "github.com/openshift/origin/pkg/oauth/apis/oauth"
+	oauthfake "github.com/openshift/origin/pkg/oauth/generated/internalclientset/fake"
+)
+
+func TestAuthenticateTokenDisabledUser(t *testing.T) {
+	fakeOAuthClient := oauthfake.NewSimpleClientset(
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "enabledToken", CreationTimestamp: metav1.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "alice",
+			UserUID:    "alice-uid",
+		},
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabledToken", CreationTimestamp: metav1.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "bob",
+			UserUID:    "bob-uid",
+		},
+	)
+	fakeUserClient := userfake.NewSimpleClientset(
+		&userapi.User{ObjectMeta: metav1.ObjectMeta{Name: "alice", UID: "alice-uid"}},
+		&userapi.User{ObjectMeta: metav1.ObjectMeta{Name: "bob", UID: "bob-uid"}, Disabled: true},
+	)
+
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{}, NewDisabledUserValidator())
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("enabledToken")
+	if !found || err != nil {
+		t.Errorf("Unexpected error authenticating enabled user: found=%v err=%v", found, err)
+	}
+	if userInfo == nil || userInfo.GetName() != "alice" {
+		t.Errorf("Unexpected user: %v", userInfo)
+	}
+
+	userInfo, found, err = tokenAuthenticator.AuthenticateToken("disabledToken")
+	if found {
+		t.Error("Found token for disabled user, but it should be rejected!")
+	}
+	if err != errUserDisabled {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if userInfo != nil {
+		t.Errorf("Unexpected user: %v", userInfo)
+	}
+}
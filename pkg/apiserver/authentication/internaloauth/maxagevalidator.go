@@ -0,0 +1,38 @@
+package internaloauth
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+
+	userv1 "github.com/openshift/api/user/v1"
+	"github.com/openshift/origin/pkg/oauth/apis/oauth"
+	oauthclientlister "github.com/openshift/origin/pkg/oauth/generated/listers/oauth/internalversion"
+)
+
+// NewMaxAgeValidator returns a validator that enforces the OAuth client's
+// current accessTokenMaxAgeSeconds against every token it sees.  The lifetime
+// a token carries in ExpiresIn is fixed when the token is minted, so this is
+// what allows administrators to shorten the lifetime of already issued tokens
+// by lowering the max age on the client.
+func NewMaxAgeValidator(oauthClients oauthclientlister.OAuthClientLister) OAuthTokenValidator {
+	return OAuthTokenValidatorFunc(
+		func(token *oauth.OAuthAccessToken, _ *userv1.User) error {
+			oauthClient, err := oauthClients.Get(token.ClientName)
+			if err != nil {
+				// without the client we cannot enforce a client specific max
+				// age; the expiration validator still enforces ExpiresIn
+				glog.V(5).Infof("Failed to fetch OAuthClient %q for max age value: %v", token.ClientName, err)
+				return nil
+			}
+			maxAge := oauthClient.AccessTokenMaxAgeSeconds
+			if maxAge == nil || *maxAge <= 0 {
+				return nil
+			}
+			if token.CreationTimestamp.Add(time.Duration(*maxAge) * time.Second).Before(time.Now()) {
+				return errExpired
+			}
+			return nil
+		},
+	)
+}
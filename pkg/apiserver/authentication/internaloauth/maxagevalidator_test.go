@@ -0,0 +1,79 @@
+package internaloauth
+
+import (
+	"testing"
+	"time"
+
+	userapi "github.com/openshift/api/user/v1"
+	userfake "github.com/openshift/client-go/user/clientset/versioned/fake"
+	oapi "github.com/openshift/origin/pkg/oauth/apis/oauth"
+	oauthfake "github.com/openshift/origin/pkg/oauth/generated/internalclientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAuthenticateTokenMaxAge(t *testing.T) {
+	shortMaxAge := int32(600) // 10 minutes
+	fakeOAuthClient := oauthfake.NewSimpleClientset(
+		// token created an hour ago whose client now only allows a 10 minute lifetime
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "oldToken", CreationTimestamp: metav1.Time{Time: time.Now().Add(-1 * time.Hour)}},
+			ClientName: "shortClient",
+			ExpiresIn:  86400, // minted with a much longer lifetime
+			UserName:   "foo",
+			UserUID:    string("bar"),
+		},
+		// fresh token from the same client
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "freshToken", CreationTimestamp: metav1.Time{Time: time.Now()}},
+			ClientName: "shortClient",
+			ExpiresIn:  86400,
+			UserName:   "foo",
+			UserUID:    string("bar"),
+		},
+		// old token from a client without a max age
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlimitedToken", CreationTimestamp: metav1.Time{Time: time.Now().Add(-1 * time.Hour)}},
+			ClientName: "unlimitedClient",
+			ExpiresIn:  86400,
+			UserName:   "foo",
+			UserUID:    string("bar"),
+		},
+		&oapi.OAuthClient{
+			ObjectMeta:               metav1.ObjectMeta{Name: "shortClient"},
+			AccessTokenMaxAgeSeconds: &shortMaxAge,
+		},
+		&oapi.OAuthClient{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlimitedClient"},
+		},
+	)
+	fakeUserClient := userfake.NewSimpleClientset(&userapi.User{ObjectMeta: metav1.ObjectMeta{Name: "foo", UID: "bar"}})
+	lister := &fakeOAuthClientLister{
+		clients: fakeOAuthClient.Oauth().OAuthClients(),
+	}
+
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{}, NewMaxAgeValidator(lister))
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("oldToken")
+	if found {
+		t.Error("Found token, but it should be missing!")
+	}
+	if err != errExpired {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if userInfo != nil {
+		t.Errorf("Unexpected user: %v", userInfo)
+	}
+
+	for _, tokenName := range []string{"freshToken", "unlimitedToken"} {
+		userInfo, found, err := tokenAuthenticator.AuthenticateToken(tokenName)
+		if !found {
+			t.Errorf("%s: did not find a token!", tokenName)
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tokenName, err)
+		}
+		if userInfo == nil {
+			t.Errorf("%s: did not get a user!", tokenName)
+		}
+	}
+}
@@ -1,37 +1,55 @@
 package internaloauth
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kauthenticator "k8s.io/apiserver/pkg/authentication/authenticator"
+	apiserverserviceaccount "k8s.io/apiserver/pkg/authentication/serviceaccount"
 	kuser "k8s.io/apiserver/pkg/authentication/user"
+	kcoreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	userapi "github.com/openshift/api/user/v1"
 	userclient "github.com/openshift/client-go/user/clientset/versioned/typed/user/v1"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+	"github.com/openshift/origin/pkg/oauth/apis/oauth"
 	oauthclient "github.com/openshift/origin/pkg/oauth/generated/internalclientset/typed/oauth/internalversion"
 )
 
 type tokenAuthenticator struct {
-	tokens      oauthclient.OAuthAccessTokenInterface
-	users       userclient.UserInterface
-	groupMapper UserToGroupMapper
-	validators  OAuthTokenValidator
+	tokens          oauthclient.OAuthAccessTokenInterface
+	users           userclient.UserInterface
+	serviceAccounts kcoreclient.ServiceAccountsGetter
+	groupMapper     UserToGroupMapper
+	validators      OAuthTokenValidator
 }
 
-func NewTokenAuthenticator(tokens oauthclient.OAuthAccessTokenInterface, users userclient.UserInterface, groupMapper UserToGroupMapper, validators ...OAuthTokenValidator) kauthenticator.Token {
+func NewTokenAuthenticator(tokens oauthclient.OAuthAccessTokenInterface, users userclient.UserInterface, serviceAccounts kcoreclient.ServiceAccountsGetter, groupMapper UserToGroupMapper, validators ...OAuthTokenValidator) kauthenticator.Token {
 	return &tokenAuthenticator{
-		tokens:      tokens,
-		users:       users,
-		groupMapper: groupMapper,
-		validators:  OAuthTokenValidators(validators),
+		tokens:          tokens,
+		users:           users,
+		serviceAccounts: serviceAccounts,
+		groupMapper:     groupMapper,
+		validators:      OAuthTokenValidators(validators),
 	}
 }
 
 func (a *tokenAuthenticator) AuthenticateToken(name string) (kuser.Info, bool, error) {
+	return a.authenticateToken(name)
+}
+
+// authenticateToken looks up and validates the named token, running any
+// request-scoped validators in addition to the configured ones.
+func (a *tokenAuthenticator) authenticateToken(name string, requestValidators ...OAuthTokenValidator) (kuser.Info, bool, error) {
 	token, err := a.tokens.Get(name, metav1.GetOptions{})
 	if err != nil {
 		return nil, false, err
 	}
 
+	if saNamespace, saName, err := apiserverserviceaccount.SplitUsername(token.UserName); err == nil {
+		return a.authenticateServiceAccountToken(token, saNamespace, saName, requestValidators)
+	}
+
 	user, err := a.users.Get(token.UserName, metav1.GetOptions{})
 	if err != nil {
 		return nil, false, err
@@ -40,6 +58,9 @@ func (a *tokenAuthenticator) AuthenticateToken(name string) (kuser.Info, bool, e
 	if err := a.validators.Validate(token, user); err != nil {
 		return nil, false, err
 	}
+	if err := OAuthTokenValidators(requestValidators).Validate(token, user); err != nil {
+		return nil, false, err
+	}
 
 	groups, err := a.groupMapper.GroupsFor(user.Name)
 	if err != nil {
@@ -60,3 +81,35 @@ func (a *tokenAuthenticator) AuthenticateToken(name string) (kuser.Info, bool, e
 		},
 	}, true, nil
 }
+
+// authenticateServiceAccountToken resolves a token minted for a service account.
+// Service accounts are not backed by User objects, so the service account itself
+// is looked up and a transient user is synthesized for the validators, which
+// keeps the UID check effective when a service account is deleted and recreated.
+func (a *tokenAuthenticator) authenticateServiceAccountToken(token *oauth.OAuthAccessToken, saNamespace, saName string, requestValidators []OAuthTokenValidator) (kuser.Info, bool, error) {
+	if a.serviceAccounts == nil {
+		return nil, false, fmt.Errorf("OAuth tokens for service account %q are not supported by this authenticator", token.UserName)
+	}
+
+	serviceAccount, err := a.serviceAccounts.ServiceAccounts(saNamespace).Get(saName, metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	user := &userapi.User{ObjectMeta: metav1.ObjectMeta{Name: token.UserName, UID: serviceAccount.UID}}
+	if err := a.validators.Validate(token, user); err != nil {
+		return nil, false, err
+	}
+	if err := OAuthTokenValidators(requestValidators).Validate(token, user); err != nil {
+		return nil, false, err
+	}
+
+	return &kuser.DefaultInfo{
+		Name:   token.UserName,
+		UID:    string(serviceAccount.UID),
+		Groups: apiserverserviceaccount.MakeGroupNames(saNamespace, saName),
+		Extra: map[string][]string{
+			authorizationapi.ScopesKey: token.Scopes,
+		},
+	}, true, nil
+}
@@ -5,16 +5,19 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
+	kfake "k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
 
 	userapi "github.com/openshift/api/user/v1"
 	userfake "github.com/openshift/client-go/user/clientset/versioned/fake"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
 	oapi "github.com/openshift/origin/pkg/oauth/apis/oauth"
 	oauthfake "github.com/openshift/origin/pkg/oauth/generated/internalclientset/fake"
 	oauthclient "github.com/openshift/origin/pkg/oauth/generated/internalclientset/typed/oauth/internalversion"
@@ -31,7 +34,7 @@ func TestAuthenticateTokenInvalidUID(t *testing.T) {
 	)
 	fakeUserClient := userfake.NewSimpleClientset(&userapi.User{ObjectMeta: metav1.ObjectMeta{Name: "foo", UID: "bar2"}})
 
-	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), NoopGroupMapper{}, NewUIDValidator())
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{}, NewUIDValidator())
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -45,10 +48,72 @@ func TestAuthenticateTokenInvalidUID(t *testing.T) {
 	}
 }
 
+func TestAuthenticateTokenServiceAccount(t *testing.T) {
+	fakeOAuthClient := oauthfake.NewSimpleClientset(
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "satoken", CreationTimestamp: metav1.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "system:serviceaccount:default:builder",
+			UserUID:    "sauid",
+			Scopes:     []string{"user:check-access"},
+		},
+	)
+	fakeUserClient := userfake.NewSimpleClientset()
+	fakeKubeClient := kfake.NewSimpleClientset(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "builder", Namespace: "default", UID: "sauid"}})
+
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), fakeKubeClient.CoreV1(), NoopGroupMapper{}, NewExpirationValidator(), NewUIDValidator())
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("satoken")
+	if !found {
+		t.Error("Did not find token!")
+	}
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if userInfo == nil {
+		t.Fatal("Did not get a user!")
+	}
+	if userInfo.GetName() != "system:serviceaccount:default:builder" {
+		t.Errorf("Unexpected user name: %v", userInfo.GetName())
+	}
+	if userInfo.GetUID() != "sauid" {
+		t.Errorf("Unexpected user UID: %v", userInfo.GetUID())
+	}
+	if scopes := userInfo.GetExtra()[authorizationapi.ScopesKey]; len(scopes) != 1 || scopes[0] != "user:check-access" {
+		t.Errorf("Unexpected scopes: %v", userInfo.GetExtra())
+	}
+}
+
+func TestAuthenticateTokenServiceAccountRecreated(t *testing.T) {
+	fakeOAuthClient := oauthfake.NewSimpleClientset(
+		&oapi.OAuthAccessToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "satoken", CreationTimestamp: metav1.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "system:serviceaccount:default:builder",
+			UserUID:    "sauid1",
+		},
+	)
+	fakeUserClient := userfake.NewSimpleClientset()
+	fakeKubeClient := kfake.NewSimpleClientset(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "builder", Namespace: "default", UID: "sauid2"}})
+
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), fakeKubeClient.CoreV1(), NoopGroupMapper{}, NewUIDValidator())
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("satoken")
+	if found {
+		t.Error("Found token, but it should be missing!")
+	}
+	if err == nil {
+		t.Error("Expected error is missing!")
+	}
+	if userInfo != nil {
+		t.Errorf("Unexpected user: %v", userInfo)
+	}
+}
+
 func TestAuthenticateTokenNotFound(t *testing.T) {
 	fakeOAuthClient := oauthfake.NewSimpleClientset()
 	fakeUserClient := userfake.NewSimpleClientset()
-	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{})
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -71,7 +136,7 @@ func TestAuthenticateTokenOtherGetError(t *testing.T) {
 		return true, nil, errors.New("get error")
 	})
 	fakeUserClient := userfake.NewSimpleClientset()
-	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(fakeOAuthClient.Oauth().OAuthAccessTokens(), fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{})
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -177,7 +242,7 @@ func TestAuthenticateTokenTimeout(t *testing.T) {
 	// add some padding to all sleep invocations to make sure we are not failing on any boundary values
 	buffer := time.Nanosecond
 
-	tokenAuthenticator := NewTokenAuthenticator(accessTokenGetter, fakeUserClient.UserV1().Users(), NoopGroupMapper{}, timeouts)
+	tokenAuthenticator := NewTokenAuthenticator(accessTokenGetter, fakeUserClient.UserV1().Users(), nil, NoopGroupMapper{}, timeouts)
 
 	go timeouts.Run(stopCh)
 
@@ -85,6 +85,26 @@ const (
 	// DeploymentStatusReasonAnnotation represents the reason for deployment being in a given state
 	// Used for specifying the reason for cancellation or failure of a deployment
 	DeploymentStatusReasonAnnotation = "openshift.io/deployment.status-reason"
+	// DeploymentReadinessGateAnnotation names a pod condition type, set by an external
+	// controller such as a load balancer registrar, that new pods of a rollout must
+	// report True before the deployment is accepted and old pods are scaled down
+	DeploymentReadinessGateAnnotation = "openshift.io/deployment-config.readiness-gate"
+	// DeploymentMetricsCheckEndpointAnnotation holds the base URL of a Prometheus
+	// compatible API that is queried after a rollout completes to decide whether
+	// the deployment should be failed
+	DeploymentMetricsCheckEndpointAnnotation = "openshift.io/deployment-config.metrics-check-endpoint"
+	// DeploymentMetricsCheckQueryAnnotation holds a Prometheus expression that is
+	// evaluated after a rollout completes. The expression should encode its own
+	// threshold, alert rule style (e.g. a 5xx rate compared against a limit), so
+	// that it returns samples only while the condition is violated; any returned
+	// sample with a non-zero value fails the deployment
+	DeploymentMetricsCheckQueryAnnotation = "openshift.io/deployment-config.metrics-check-query"
+	// DeploymentDrainedNodeAnnotation is set on a deployment config whose in-flight
+	// deployer pod was waited on during a node drain, recording the drained node
+	DeploymentDrainedNodeAnnotation = "openshift.io/deployment-config.drained-node"
+	// DeploymentCauseMessageAnnotation holds a human readable message describing why the
+	// deployment was started, as recorded by `oc rollout latest --message`
+	DeploymentCauseMessageAnnotation = "openshift.io/deployment.cause-message"
 	// DeploymentCancelledAnnotation indicates that the deployment has been cancelled
 	// The annotation value does not matter and its mere presence indicates cancellation
 	DeploymentCancelledAnnotation = "openshift.io/deployment.cancelled"
@@ -468,6 +488,11 @@ const (
 	// DeploymentReplicaFailure is added in a deployment config when one of its pods
 	// fails to be created or deleted.
 	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+	// DeploymentTriggersResolved means all automatic image change triggers for the
+	// DeploymentConfig have been resolved to concrete image references. The condition is
+	// set to false by the image trigger controller when a trigger points at a tag that
+	// does not exist or failed to import, explaining why the config has not deployed.
+	DeploymentTriggersResolved DeploymentConditionType = "TriggersResolved"
 )
 
 type DeploymentConditionReason string
@@ -498,6 +523,12 @@ const (
 	// CancelledRolloutReason is added in a deployment config when its newest rollout was
 	// interrupted by cancellation.
 	CancelledRolloutReason DeploymentConditionReason = "RolloutCancelled"
+	// TriggerResolutionFailedReason is added in a deployment config when one of its image
+	// change triggers refers to a tag that cannot be resolved to an image.
+	TriggerResolutionFailedReason DeploymentConditionReason = "TriggerResolutionFailed"
+	// TriggersResolvedReason is added in a deployment config when a previously failing
+	// image change trigger resolves successfully.
+	TriggersResolvedReason DeploymentConditionReason = "TriggersResolved"
 )
 
 // DeploymentCondition describes the state of a deployment config at a certain point.
@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	apirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
@@ -16,11 +17,14 @@ import (
 	"k8s.io/kubernetes/pkg/apis/autoscaling"
 	autoscalingvalidation "k8s.io/kubernetes/pkg/apis/autoscaling/validation"
 	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/printers"
+	printerstorage "k8s.io/kubernetes/pkg/printers/storage"
 	"k8s.io/kubernetes/staging/src/k8s.io/apimachinery/pkg/labels"
 
 	appsapiv1 "github.com/openshift/api/apps/v1"
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	"github.com/openshift/origin/pkg/apps/registry/deployconfig"
+	printersinternal "github.com/openshift/origin/pkg/printers/internalversion"
 	"github.com/openshift/origin/pkg/util/restoptions"
 )
 
@@ -55,6 +59,8 @@ func NewREST(optsGetter restoptions.Getter) (*REST, *StatusREST, *ScaleREST, err
 		CreateStrategy: deployconfig.GroupStrategy,
 		UpdateStrategy: deployconfig.GroupStrategy,
 		DeleteStrategy: deployconfig.GroupStrategy,
+
+		TableConvertor: printerstorage.TableConvertor{TablePrinter: printers.NewTablePrinter().With(printersinternal.AddHandlers)},
 	}
 
 	options := &generic.StoreOptions{RESTOptions: optsGetter}
@@ -98,12 +104,20 @@ func (r *ScaleREST) GroupVersionKind(containingGV schema.GroupVersion) schema.Gr
 
 // Get retrieves (computes) the Scale subresource for the given DeploymentConfig name.
 func (r *ScaleREST) Get(ctx apirequest.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
-	deploymentConfig, err := r.store.Get(ctx, name, options)
+	uncastObj, err := r.store.Get(ctx, name, options)
 	if err != nil {
 		return nil, err
 	}
+	deploymentConfig := uncastObj.(*appsapi.DeploymentConfig)
 
-	return scaleFromConfig(deploymentConfig.(*appsapi.DeploymentConfig)), nil
+	// Surface misconfigured selectors on reads so that an autoscaler targeting
+	// this config reports a clear error instead of scaling against a selector
+	// that does not cover the config's pods.
+	if err := validateScaleSelector(deploymentConfig); err != nil {
+		return nil, err
+	}
+
+	return scaleFromConfig(deploymentConfig), nil
 }
 
 // Update scales the DeploymentConfig for the given Scale subresource, returning the updated Scale.
@@ -129,6 +143,20 @@ func (r *ScaleREST) Update(ctx apirequest.Context, name string, objInfo rest.Upd
 		return nil, false, errors.NewInvalid(extensions.Kind("Scale"), scale.Name, errs)
 	}
 
+	// The selector reported on the scale subresource is derived from the
+	// deployment config and cannot be changed through it. Reject updates that
+	// carry a different selector so a client cannot silently detach the config
+	// from the replication controllers of its existing deployments.
+	if len(scale.Status.Selector) > 0 && scale.Status.Selector != old.Status.Selector {
+		return nil, false, errors.NewInvalid(extensions.Kind("Scale"), scale.Name, field.ErrorList{
+			field.Invalid(field.NewPath("status", "selector"), scale.Status.Selector, "selector is immutable on the scale subresource"),
+		})
+	}
+
+	if err := validateScaleSelector(deploymentConfig); err != nil {
+		return nil, false, err
+	}
+
 	deploymentConfig.Spec.Replicas = scale.Spec.Replicas
 	if _, _, err := r.store.Update(ctx, deploymentConfig.Name, rest.DefaultUpdatedObjectInfo(deploymentConfig), createValidation, updateValidation); err != nil {
 		return nil, false, err
@@ -137,6 +165,23 @@ func (r *ScaleREST) Update(ctx apirequest.Context, name string, objInfo rest.Upd
 	return scale, false, nil
 }
 
+// validateScaleSelector ensures that the implicit selector reported on the
+// scale subresource still selects the pods of the config's deployments. The
+// replication controllers created for a config always carry the deployment
+// config label with the config's name, so a config whose spec.selector pins
+// that label to another value would leave an autoscaler scaling replication
+// controllers whose pods its selector can never match, orphaning them.
+func validateScaleSelector(dc *appsapi.DeploymentConfig) error {
+	value, ok := dc.Spec.Selector[appsapi.DeploymentConfigLabel]
+	if !ok || value == dc.Name {
+		return nil
+	}
+	return errors.NewInvalid(extensions.Kind("Scale"), dc.Name, field.ErrorList{
+		field.Invalid(field.NewPath("spec", "selector").Key(appsapi.DeploymentConfigLabel), value,
+			fmt.Sprintf("must match the deployment config name %q to select the pods of its deployments", dc.Name)),
+	})
+}
+
 // scaleFromConfig builds a scale resource out of a deployment config.
 func scaleFromConfig(dc *appsapi.DeploymentConfig) *autoscaling.Scale {
 	// We need to make sure that the implicit selector won't have invalid value specified by user.
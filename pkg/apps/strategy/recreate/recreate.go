@@ -128,6 +128,9 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 	if updateAcceptor == nil {
 		updateAcceptor = s.getUpdateAcceptor(retryTimeout, config.Spec.MinReadySeconds)
 	}
+	if gate := config.Annotations[appsapi.DeploymentReadinessGateAnnotation]; len(gate) > 0 {
+		updateAcceptor = stratsupport.NewAcceptPodsWithCondition(s.out, s.podClient, retryTimeout, kapi.PodConditionType(gate), updateAcceptor)
+	}
 
 	// Execute any pre-hook.
 	if params != nil && params.Pre != nil {
@@ -221,6 +224,10 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 		}
 	}
 
+	if err := stratsupport.CheckRolloutMetrics(s.out, config); err != nil {
+		return fmt.Errorf("metrics check failed: %v", err)
+	}
+
 	return nil
 }
 
@@ -58,6 +58,8 @@ type RollingDeploymentStrategy struct {
 	initialStrategy acceptingDeploymentStrategy
 	// rcClient is used to deal with ReplicationControllers.
 	rcClient kcoreclient.ReplicationControllersGetter
+	// podClient is used to list pods when verifying readiness gates.
+	podClient kcoreclient.PodsGetter
 	// eventClient is a client to access events
 	eventClient kcoreclient.EventsGetter
 	// tags is a client used to perform tag actions
@@ -101,6 +103,7 @@ func NewRollingDeploymentStrategy(namespace string, client kclientset.Interface,
 		decoder:         decoder,
 		initialStrategy: initialStrategy,
 		rcClient:        client.Core(),
+		podClient:       client.Core(),
 		eventClient:     client.Core(),
 		tags:            tags,
 		apiRetryPeriod:  defaultApiRetryPeriod,
@@ -124,6 +127,9 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 
 	params := config.Spec.Strategy.RollingParams
 	updateAcceptor := s.getUpdateAcceptor(time.Duration(*params.TimeoutSeconds)*time.Second, config.Spec.MinReadySeconds)
+	if gate := config.Annotations[appsapi.DeploymentReadinessGateAnnotation]; len(gate) > 0 {
+		updateAcceptor = stratsupport.NewAcceptPodsWithCondition(s.out, s.podClient, time.Duration(*params.TimeoutSeconds)*time.Second, kapi.PodConditionType(gate), updateAcceptor)
+	}
 
 	// If there's no prior deployment, delegate to another strategy since the
 	// rolling updater only supports transitioning between two deployments.
@@ -251,6 +257,9 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 			return fmt.Errorf("post hook failed: %s", err)
 		}
 	}
+	if err := stratsupport.CheckRolloutMetrics(s.out, config); err != nil {
+		return fmt.Errorf("metrics check failed: %v", err)
+	}
 	return nil
 }
 
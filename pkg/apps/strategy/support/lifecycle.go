@@ -3,6 +3,7 @@ package support
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -22,6 +24,7 @@ import (
 
 	"github.com/openshift/origin/pkg/api/apihelpers"
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	strat "github.com/openshift/origin/pkg/apps/strategy"
 	strategyutil "github.com/openshift/origin/pkg/apps/strategy/util"
 	appsutil "github.com/openshift/origin/pkg/apps/util"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
@@ -202,6 +205,12 @@ func (e *hookExecutor) executeExecNewPod(hook *appsapi.LifecycleHook, rc *kapi.R
 		return err
 	}
 
+	// Surface the docker labels of the image that triggered this deployment to
+	// the hook so it can make decisions based on image metadata.
+	if env := e.triggeringImageLabelEnv(config, hook.ExecNewPod.ContainerName); len(env) > 0 {
+		podSpec.Spec.Containers[0].Env = append(podSpec.Spec.Containers[0].Env, env...)
+	}
+
 	// Track whether the pod has already run to completion and avoid showing logs
 	// or the Success message twice.
 	completed, created := false, false
@@ -302,6 +311,65 @@ func (e *hookExecutor) readPodLogs(pod *kapi.Pod, wg *sync.WaitGroup) {
 	}
 }
 
+// triggeringImageLabelEnv resolves the image change trigger feeding the hook
+// container and converts the docker labels on the triggering image into
+// environment variables of the form OPENSHIFT_DEPLOYMENT_IMAGE_LABEL_<NAME>,
+// where <NAME> is the label name uppercased with non-alphanumeric characters
+// replaced by underscores. Lookup failures are ignored - hooks run with the
+// same environment they would have had before this feature.
+func (e *hookExecutor) triggeringImageLabelEnv(config *appsapi.DeploymentConfig, containerName string) []kapi.EnvVar {
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type != appsapi.DeploymentTriggerOnImageChange || trigger.ImageChangeParams == nil {
+			continue
+		}
+		params := trigger.ImageChangeParams
+		if !sets.NewString(params.ContainerNames...).Has(containerName) {
+			continue
+		}
+		namespace := params.From.Namespace
+		if len(namespace) == 0 {
+			namespace = config.Namespace
+		}
+		tag, err := e.tags.ImageStreamTags(namespace).Get(params.From.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+		if tag.Image.DockerImageMetadata.Config == nil {
+			return nil
+		}
+		names := []string{}
+		labels := tag.Image.DockerImageMetadata.Config.Labels
+		for name := range labels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		env := []kapi.EnvVar{}
+		for _, name := range names {
+			env = append(env, kapi.EnvVar{
+				Name:  "OPENSHIFT_DEPLOYMENT_IMAGE_LABEL_" + envVarSafeName(name),
+				Value: labels[name],
+			})
+		}
+		return env
+	}
+	return nil
+}
+
+// envVarSafeName converts a docker label name into a valid environment
+// variable suffix.
+func envVarSafeName(name string) string {
+	safe := make([]rune, 0, len(name))
+	for _, r := range strings.ToUpper(name) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			safe = append(safe, r)
+		default:
+			safe = append(safe, '_')
+		}
+	}
+	return string(safe)
+}
+
 // makeHookPod makes a pod spec from a hook and replication controller.
 func makeHookPod(hook *appsapi.LifecycleHook, rc *kapi.ReplicationController, strategy *appsapi.DeploymentStrategy, suffix string, startTime time.Time) (*kapi.Pod, error) {
 	exec := hook.ExecNewPod
@@ -517,3 +585,74 @@ func (c *acceptAvailablePods) Accept(rc *kapi.ReplicationController) error {
 	}
 	return nil
 }
+
+// NewAcceptPodsWithCondition makes a new acceptPodsWithCondition from a real client.
+func NewAcceptPodsWithCondition(
+	out io.Writer,
+	podClient kcoreclient.PodsGetter,
+	timeout time.Duration,
+	condition kapi.PodConditionType,
+	delegate strat.UpdateAcceptor,
+) *acceptPodsWithCondition {
+	return &acceptPodsWithCondition{
+		out:       out,
+		podClient: podClient,
+		timeout:   timeout,
+		condition: condition,
+		delegate:  delegate,
+	}
+}
+
+// acceptPodsWithCondition accepts a replication controller once its delegate has
+// accepted it and every pod reports the configured readiness gate condition True.
+// The condition is expected to be set by an external controller, e.g. a load
+// balancer registration.
+type acceptPodsWithCondition struct {
+	out       io.Writer
+	podClient kcoreclient.PodsGetter
+	// timeout is how long to wait for the condition after the delegate accepts.
+	timeout   time.Duration
+	condition kapi.PodConditionType
+	delegate  strat.UpdateAcceptor
+}
+
+// Accept the replication controller once its delegate does and all of its pods
+// report the readiness gate condition True.
+func (c *acceptPodsWithCondition) Accept(rc *kapi.ReplicationController) error {
+	if c.delegate != nil {
+		if err := c.delegate.Accept(rc); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(c.out, "--> Waiting for pods of %s to report condition %q\n", rc.Name, c.condition)
+	err := wait.PollImmediate(time.Second, c.timeout, func() (bool, error) {
+		selector := labels.SelectorFromSet(rc.Spec.Selector)
+		pods, err := c.podClient.Pods(rc.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return false, err
+		}
+		if int32(len(pods.Items)) < rc.Spec.Replicas {
+			return false, nil
+		}
+		for i := range pods.Items {
+			if !hasPodCondition(&pods.Items[i], c.condition) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("pods for rc %s/%s took longer than %.f seconds to report condition %q", rc.Namespace, rc.Name, c.timeout.Seconds(), c.condition)
+	}
+	return err
+}
+
+func hasPodCondition(pod *kapi.Pod, conditionType kapi.PodConditionType) bool {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == conditionType {
+			return pod.Status.Conditions[i].Status == kapi.ConditionTrue
+		}
+	}
+	return false
+}
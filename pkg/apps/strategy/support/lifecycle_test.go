@@ -633,3 +633,79 @@ func (a envByNameAsc) Swap(i, j int) {
 func (a envByNameAsc) Less(i, j int) bool {
 	return a[j].Name < a[i].Name
 }
+
+func TestHookExecutor_envVarSafeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"io.openshift.schema-version", "IO_OPENSHIFT_SCHEMA_VERSION"},
+		{"version", "VERSION"},
+		{"Build.Number2", "BUILD_NUMBER2"},
+	}
+	for _, test := range tests {
+		if actual := envVarSafeName(test.name); actual != test.expected {
+			t.Errorf("envVarSafeName(%q): expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestAcceptPodsWithCondition(t *testing.T) {
+	gate := kapi.PodConditionType("openshift.io/lb-registered")
+	rc := &kapi.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-1", Namespace: "test"},
+		Spec: kapi.ReplicationControllerSpec{
+			Replicas: 2,
+			Selector: map[string]string{"deployment": "config-1"},
+		},
+	}
+	pod := func(name string, status kapi.ConditionStatus) kapi.Pod {
+		return kapi.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels:    map[string]string{"deployment": "config-1"},
+			},
+			Status: kapi.PodStatus{
+				Conditions: []kapi.PodCondition{{Type: gate, Status: status}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		pods      []kapi.Pod
+		expectErr bool
+	}{
+		{
+			name: "all pods report the condition",
+			pods: []kapi.Pod{pod("config-1-a", kapi.ConditionTrue), pod("config-1-b", kapi.ConditionTrue)},
+		},
+		{
+			name:      "one pod has the condition false",
+			pods:      []kapi.Pod{pod("config-1-a", kapi.ConditionTrue), pod("config-1-b", kapi.ConditionFalse)},
+			expectErr: true,
+		},
+		{
+			name:      "not enough pods",
+			pods:      []kapi.Pod{pod("config-1-a", kapi.ConditionTrue)},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		client := &fake.Clientset{}
+		pods := test.pods
+		client.AddReactor("list", "pods", func(a clientgotesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.PodList{Items: pods}, nil
+		})
+		acceptor := NewAcceptPodsWithCondition(ioutil.Discard, client.Core(), 50*time.Millisecond, gate, nil)
+		err := acceptor.Accept(rc)
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+)
+
+// metricsCheckTimeout bounds a single query against the metrics endpoint.
+const metricsCheckTimeout = 30 * time.Second
+
+// CheckRolloutMetrics evaluates the metrics check annotations on config, if
+// present, against the configured Prometheus compatible endpoint. The query is
+// expected to encode its own threshold, alert rule style, so that it returns
+// samples only while the condition is violated; any sample with a non-zero
+// value fails the check and the deployment with it. Configs without both
+// annotations are not checked.
+func CheckRolloutMetrics(out io.Writer, config *appsapi.DeploymentConfig) error {
+	endpoint := config.Annotations[appsapi.DeploymentMetricsCheckEndpointAnnotation]
+	query := config.Annotations[appsapi.DeploymentMetricsCheckQueryAnnotation]
+	if len(endpoint) == 0 || len(query) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "--> Checking rollout metrics with query %q\n", query)
+	checker := &metricsChecker{client: &http.Client{Timeout: metricsCheckTimeout}}
+	if err := checker.check(endpoint, query); err != nil {
+		return err
+	}
+	fmt.Fprint(out, "--> Rollout metrics check passed\n")
+	return nil
+}
+
+// metricsChecker evaluates Prometheus expressions over HTTP.
+type metricsChecker struct {
+	client *http.Client
+}
+
+// prometheusResponse is the subset of the Prometheus query API response needed
+// to decide whether an expression returned any firing samples.
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// check runs query against the instant query API of the Prometheus server at
+// endpoint and returns an error if any returned sample has a non-zero value.
+func (c *metricsChecker) check(endpoint, query string) error {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid metrics endpoint %q: %v", endpoint, err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return fmt.Errorf("invalid metrics endpoint %q: scheme must be http or https", endpoint)
+	}
+	queryURL := *base
+	queryURL.Path = singleJoiningSlash(queryURL.Path, "api/v1/query")
+	queryURL.RawQuery = url.Values{"query": []string{query}}.Encode()
+
+	resp, err := c.client.Get(queryURL.String())
+	if err != nil {
+		return fmt.Errorf("metrics query failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("metrics query failed: endpoint returned status %s", resp.Status)
+	}
+	response := &prometheusResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("metrics query failed: cannot decode response: %v", err)
+	}
+	if response.Status != "success" {
+		return fmt.Errorf("metrics query failed: %s", response.Error)
+	}
+	for _, sample := range response.Data.Result {
+		if len(sample.Value) < 2 {
+			continue
+		}
+		raw, ok := sample.Value[1].(string)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("metrics query failed: cannot parse sample value %q: %v", raw, err)
+		}
+		if value != 0 {
+			return fmt.Errorf("metrics check query %q reported %v for %v", query, raw, sample.Metric)
+		}
+	}
+	return nil
+}
+
+// singleJoiningSlash joins the two path segments with exactly one slash.
+func singleJoiningSlash(a, b string) string {
+	for len(a) > 0 && a[len(a)-1] == '/' {
+		a = a[:len(a)-1]
+	}
+	return a + "/" + b
+}
@@ -0,0 +1,109 @@
+package support
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+)
+
+func TestCheckRolloutMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		status      int
+		annotations map[string]string
+		expectErr   bool
+		expectQuery bool
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			expectQuery: false,
+		},
+		{
+			name: "query without endpoint",
+			annotations: map[string]string{
+				appsapi.DeploymentMetricsCheckQueryAnnotation: `up == 0`,
+			},
+			expectQuery: false,
+		},
+		{
+			name:        "empty result",
+			response:    `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			status:      http.StatusOK,
+			expectQuery: true,
+		},
+		{
+			name:        "all samples zero",
+			response:    `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"route":"frontend"},"value":[1519123456.789,"0"]}]}}`,
+			status:      http.StatusOK,
+			expectQuery: true,
+		},
+		{
+			name:        "firing sample",
+			response:    `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"route":"frontend"},"value":[1519123456.789,"0.12"]}]}}`,
+			status:      http.StatusOK,
+			expectQuery: true,
+			expectErr:   true,
+		},
+		{
+			name:        "query error",
+			response:    `{"status":"error","error":"parse error at char 3"}`,
+			status:      http.StatusBadRequest,
+			expectQuery: true,
+			expectErr:   true,
+		},
+		{
+			name:        "malformed response",
+			response:    `not json`,
+			status:      http.StatusOK,
+			expectQuery: true,
+			expectErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		query := `sum(rate(haproxy_server_http_responses_total{code="5xx"}[5m])) > 0.05`
+		queried := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queried = true
+			if expected := "/api/v1/query"; r.URL.Path != expected {
+				t.Errorf("%s: expected query path %q, got %q", test.name, expected, r.URL.Path)
+			}
+			if got := r.URL.Query().Get("query"); got != query {
+				t.Errorf("%s: expected query %q, got %q", test.name, query, got)
+			}
+			w.WriteHeader(test.status)
+			fmt.Fprint(w, test.response)
+		}))
+
+		annotations := test.annotations
+		if annotations == nil && test.expectQuery {
+			annotations = map[string]string{
+				appsapi.DeploymentMetricsCheckEndpointAnnotation: server.URL,
+				appsapi.DeploymentMetricsCheckQueryAnnotation:    query,
+			}
+		}
+		config := &appsapi.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Annotations: annotations},
+		}
+
+		err := CheckRolloutMetrics(ioutil.Discard, config)
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if queried != test.expectQuery {
+			t.Errorf("%s: expected queried=%t, got %t", test.name, test.expectQuery, queried)
+		}
+		server.Close()
+	}
+}
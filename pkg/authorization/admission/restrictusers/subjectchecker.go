@@ -59,6 +59,9 @@ type RoleBindingRestrictionContext struct {
 	// groupToLabels maps group name to labels.Set.
 	groupToLabelSet map[string]labels.Set
 
+	// namespaceToLabels maps namespace name to labels.Set.
+	namespaceToLabelSet map[string]labels.Set
+
 	// namespace is the namespace for which the RoleBindingRestriction makes
 	// determinations.
 	namespace string
@@ -68,12 +71,13 @@ type RoleBindingRestrictionContext struct {
 // object.
 func NewRoleBindingRestrictionContext(ns string, kc kclientset.Interface, userClient userclient.UserV1Interface, groupCache GroupCache) (*RoleBindingRestrictionContext, error) {
 	return &RoleBindingRestrictionContext{
-		namespace:       ns,
-		kclient:         kc,
-		userClient:      userClient,
-		groupCache:      groupCache,
-		userToLabelSet:  map[string]labels.Set{},
-		groupToLabelSet: map[string]labels.Set{},
+		namespace:           ns,
+		kclient:             kc,
+		userClient:          userClient,
+		groupCache:          groupCache,
+		userToLabelSet:      map[string]labels.Set{},
+		groupToLabelSet:     map[string]labels.Set{},
+		namespaceToLabelSet: map[string]labels.Set{},
 	}, nil
 }
 
@@ -128,6 +132,23 @@ func (ctx *RoleBindingRestrictionContext) labelSetForGroup(subject rbac.Subject)
 	return ctx.groupToLabelSet[subject.Name], nil
 }
 
+// labelSetForNamespace returns the label set for the given namespace.
+func (ctx *RoleBindingRestrictionContext) labelSetForNamespace(namespace string) (labels.Set, error) {
+	labelSet, ok := ctx.namespaceToLabelSet[namespace]
+	if ok {
+		return labelSet, nil
+	}
+
+	ns, err := ctx.kclient.Core().Namespaces().Get(namespace, metav1.GetOptions{})
+	if err != nil {
+		return labels.Set{}, err
+	}
+
+	ctx.namespaceToLabelSet[namespace] = labels.Set(ns.Labels)
+
+	return ctx.namespaceToLabelSet[namespace], nil
+}
+
 // UserSubjectChecker determines whether a user subject is allowed in
 // rolebindings in the project.
 type UserSubjectChecker struct {
@@ -185,6 +206,26 @@ func (checker UserSubjectChecker) Allowed(subject rbac.Subject, ctx *RoleBinding
 		}
 	}
 
+	if len(checker.userRestriction.GroupSelectors) != 0 {
+		subjectGroups, err := ctx.groupsForUser(subject)
+		if err != nil {
+			return false, err
+		}
+
+		for _, labelSelector := range checker.userRestriction.GroupSelectors {
+			selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+			if err != nil {
+				return false, err
+			}
+
+			for _, group := range subjectGroups {
+				if selector.Matches(labels.Set(group.Labels)) {
+					return true, nil
+				}
+			}
+		}
+	}
+
 	return false, nil
 }
 
@@ -270,6 +311,24 @@ func (checker ServiceAccountSubjectChecker) Allowed(subject rbac.Subject, ctx *R
 		}
 	}
 
+	if len(checker.serviceAccountRestriction.NamespaceSelectors) != 0 {
+		labelSet, err := ctx.labelSetForNamespace(subjectNamespace)
+		if err != nil {
+			return false, err
+		}
+
+		for _, labelSelector := range checker.serviceAccountRestriction.NamespaceSelectors {
+			selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+			if err != nil {
+				return false, err
+			}
+
+			if selector.Matches(labelSet) {
+				return true, nil
+			}
+		}
+	}
+
 	for _, serviceAccountRef := range checker.serviceAccountRestriction.ServiceAccounts {
 		serviceAccountNamespace := serviceAccountRef.Namespace
 		if len(serviceAccountNamespace) == 0 {
@@ -71,6 +71,12 @@ func TestSubjectCheckers(t *testing.T) {
 					Labels:    map[string]string{"xyzzy": "thud"},
 				},
 			},
+			&kapi.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "namespace",
+					Labels: map[string]string{"team": "napoli"},
+				},
+			},
 		}
 	)
 
@@ -139,6 +145,32 @@ func TestSubjectCheckers(t *testing.T) {
 			subject:     userBobRef,
 			shouldAllow: false,
 		},
+		{
+			name: "allow regular user by group label selector match",
+			checker: mustNewSubjectChecker(t,
+				&authorizationapi.RoleBindingRestrictionSpec{
+					UserRestriction: &authorizationapi.UserRestriction{
+						GroupSelectors: []metav1.LabelSelector{
+							{MatchLabels: map[string]string{"baz": "quux"}},
+						},
+					},
+				}),
+			subject:     userBobRef,
+			shouldAllow: true,
+		},
+		{
+			name: "prohibit regular user when no group matches on group label selector",
+			checker: mustNewSubjectChecker(t,
+				&authorizationapi.RoleBindingRestrictionSpec{
+					UserRestriction: &authorizationapi.UserRestriction{
+						GroupSelectors: []metav1.LabelSelector{
+							{MatchLabels: map[string]string{"baz": "quux"}},
+						},
+					},
+				}),
+			subject:     userAliceRef,
+			shouldAllow: false,
+		},
 		{
 			name: "allow regular group by literal name match",
 			checker: mustNewSubjectChecker(t,
@@ -310,6 +342,32 @@ func TestSubjectCheckers(t *testing.T) {
 			subject:     serviceaccountRef,
 			shouldAllow: true,
 		},
+		{
+			name: "allow service account by match on namespace label selector",
+			checker: mustNewSubjectChecker(t,
+				&authorizationapi.RoleBindingRestrictionSpec{
+					ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{
+						NamespaceSelectors: []metav1.LabelSelector{
+							{MatchLabels: map[string]string{"team": "napoli"}},
+						},
+					},
+				}),
+			subject:     serviceaccountRef,
+			shouldAllow: true,
+		},
+		{
+			name: "prohibit service account when namespace label selector does not match",
+			checker: mustNewSubjectChecker(t,
+				&authorizationapi.RoleBindingRestrictionSpec{
+					ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{
+						NamespaceSelectors: []metav1.LabelSelector{
+							{MatchLabels: map[string]string{"team": "torino"}},
+						},
+					},
+				}),
+			subject:     serviceaccountRef,
+			shouldAllow: false,
+		},
 	}
 
 	stopCh := make(chan struct{})
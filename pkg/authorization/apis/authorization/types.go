@@ -27,6 +27,11 @@ const (
 	ScopesKey           = "scopes.authorization.openshift.io"
 	ScopesAllNamespaces = "*"
 
+	// RoleBindingExpirationAnnotation holds an RFC3339 time after which the role
+	// binding no longer applies; a controller deletes bindings past their expiry.
+	// It is set by the policy commands when --duration is given.
+	RoleBindingExpirationAnnotation = "authorization.openshift.io/expiration"
+
 	UserKind           = "User"
 	GroupKind          = "Group"
 	ServiceAccountKind = "ServiceAccount"
@@ -589,6 +594,11 @@ type UserRestriction struct {
 
 	// Selectors specifies a list of label selectors over user labels.
 	Selectors []metav1.LabelSelector
+
+	// GroupSelectors specifies a list of label selectors over group labels.
+	// Users that are members of a group matching one of the selectors are
+	// allowed to be bound to roles.
+	GroupSelectors []metav1.LabelSelector
 }
 
 // GroupRestriction matches a group either by a string match on the group name
@@ -611,6 +621,11 @@ type ServiceAccountRestriction struct {
 	// Namespaces specifies a list of literal namespace names.  ServiceAccounts
 	// from inside the whitelisted namespaces are allowed to be bound to roles.
 	Namespaces []string
+
+	// NamespaceSelectors specifies a list of label selectors over namespace
+	// labels.  ServiceAccounts from namespaces matching one of the selectors
+	// are allowed to be bound to roles.
+	NamespaceSelectors []metav1.LabelSelector
 }
 
 // ServiceAccountReference specifies a service account and namespace by their
@@ -1034,6 +1034,7 @@ func Convert_authorization_ServiceAccountReference_To_v1_ServiceAccountReference
 func autoConvert_v1_ServiceAccountRestriction_To_authorization_ServiceAccountRestriction(in *v1.ServiceAccountRestriction, out *authorization.ServiceAccountRestriction, s conversion.Scope) error {
 	out.ServiceAccounts = *(*[]authorization.ServiceAccountReference)(unsafe.Pointer(&in.ServiceAccounts))
 	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
 	return nil
 }
 
@@ -1045,6 +1046,7 @@ func Convert_v1_ServiceAccountRestriction_To_authorization_ServiceAccountRestric
 func autoConvert_authorization_ServiceAccountRestriction_To_v1_ServiceAccountRestriction(in *authorization.ServiceAccountRestriction, out *v1.ServiceAccountRestriction, s conversion.Scope) error {
 	out.ServiceAccounts = *(*[]v1.ServiceAccountReference)(unsafe.Pointer(&in.ServiceAccounts))
 	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
 	return nil
 }
 
@@ -1199,6 +1201,7 @@ func autoConvert_v1_UserRestriction_To_authorization_UserRestriction(in *v1.User
 	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
 	out.Selectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.Selectors))
+	out.GroupSelectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.GroupSelectors))
 	return nil
 }
 
@@ -1211,6 +1214,7 @@ func autoConvert_authorization_UserRestriction_To_v1_UserRestriction(in *authori
 	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
 	out.Selectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.Selectors))
+	out.GroupSelectors = *(*[]meta_v1.LabelSelector)(unsafe.Pointer(&in.GroupSelectors))
 	return nil
 }
 
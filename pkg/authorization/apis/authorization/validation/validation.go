@@ -486,7 +486,8 @@ func ValidateRoleBindingRestrictionUser(user *authorizationapi.UserRestriction,
 	allErrs := field.ErrorList{}
 	const invalidMsg = `must specify at least one user, group, or label selector`
 
-	if !(len(user.Users) > 0 || len(user.Groups) > 0 || len(user.Selectors) > 0) {
+	if !(len(user.Users) > 0 || len(user.Groups) > 0 || len(user.Selectors) > 0 ||
+		len(user.GroupSelectors) > 0) {
 		allErrs = append(allErrs, field.Required(fld.Child("users"), invalidMsg))
 	}
 
@@ -496,6 +497,12 @@ func ValidateRoleBindingRestrictionUser(user *authorizationapi.UserRestriction,
 				fld.Child("selector").Index(i))...)
 	}
 
+	for i, selector := range user.GroupSelectors {
+		allErrs = append(allErrs,
+			unversionedvalidation.ValidateLabelSelector(&selector,
+				fld.Child("groupSelector").Index(i))...)
+	}
+
 	return allErrs
 }
 
@@ -520,10 +527,17 @@ func ValidateRoleBindingRestrictionServiceAccount(sa *authorizationapi.ServiceAc
 	allErrs := field.ErrorList{}
 	const invalidMsg = `must specify at least one service account or namespace`
 
-	if !(len(sa.ServiceAccounts) > 0 || len(sa.Namespaces) > 0) {
+	if !(len(sa.ServiceAccounts) > 0 || len(sa.Namespaces) > 0 ||
+		len(sa.NamespaceSelectors) > 0) {
 		allErrs = append(allErrs,
 			field.Required(fld.Child("serviceaccounts"), invalidMsg))
 	}
 
+	for i, selector := range sa.NamespaceSelectors {
+		allErrs = append(allErrs,
+			unversionedvalidation.ValidateLabelSelector(&selector,
+				fld.Child("namespaceSelector").Index(i))...)
+	}
+
 	return allErrs
 }
@@ -1210,6 +1210,13 @@ func (in *ServiceAccountRestriction) DeepCopyInto(out *ServiceAccountRestriction
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1408,6 +1415,13 @@ func (in *UserRestriction) DeepCopyInto(out *UserRestriction) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GroupSelectors != nil {
+		in, out := &in.GroupSelectors, &out.GroupSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -0,0 +1,163 @@
+package expiredrolebindings
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	rbacinformers "k8s.io/client-go/informers/rbac/v1"
+	rbacclient "k8s.io/client-go/kubernetes/typed/rbac/v1"
+	rbaclisters "k8s.io/client-go/listers/rbac/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/controller"
+
+	"github.com/golang/glog"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+)
+
+// ExpiredRoleBindingsController deletes role bindings once the time recorded in
+// their expiration annotation has passed, so that temporary access grants made
+// with `oc policy add-role-to-user --duration` clean up automatically.
+type ExpiredRoleBindingsController struct {
+	roleBindingClient rbacclient.RoleBindingsGetter
+
+	roleBindingLister rbaclisters.RoleBindingLister
+	roleBindingSynced cache.InformerSynced
+
+	// now is for testing
+	now func() time.Time
+
+	syncHandler func(key string) error
+	queue       workqueue.RateLimitingInterface
+}
+
+// NewExpiredRoleBindingsController creates a new controller
+func NewExpiredRoleBindingsController(roleBindingInformer rbacinformers.RoleBindingInformer, roleBindingClient rbacclient.RoleBindingsGetter) *ExpiredRoleBindingsController {
+	c := &ExpiredRoleBindingsController{
+		roleBindingClient: roleBindingClient,
+
+		roleBindingLister: roleBindingInformer.Lister(),
+		roleBindingSynced: roleBindingInformer.Informer().HasSynced,
+
+		now: time.Now,
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ExpiredRoleBindingsController"),
+	}
+	c.syncHandler = c.syncRoleBinding
+
+	roleBindingInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			metadata, err := meta.Accessor(obj)
+			if err != nil {
+				return false
+			}
+			_, ok := metadata.GetAnnotations()[authorizationapi.RoleBindingExpirationAnnotation]
+			return ok
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.enqueue(obj)
+			},
+			UpdateFunc: func(old, cur interface{}) {
+				c.enqueue(cur)
+			},
+		},
+	})
+	return c
+}
+
+func (c *ExpiredRoleBindingsController) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// syncRoleBinding deletes the role binding if its expiry has passed, or
+// requeues it to be handled again when the expiry is reached.
+func (c *ExpiredRoleBindingsController) syncRoleBinding(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	binding, err := c.roleBindingLister.RoleBindings(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	value, ok := binding.Annotations[authorizationapi.RoleBindingExpirationAnnotation]
+	if !ok {
+		return nil
+	}
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// malformed annotations cannot be acted on; deleting would be guessing
+		utilruntime.HandleError(fmt.Errorf("role binding %s has an unparseable expiration %q: %v", key, value, err))
+		return nil
+	}
+	if remaining := expiry.Sub(c.now()); remaining > 0 {
+		c.queue.AddAfter(key, remaining)
+		return nil
+	}
+
+	glog.V(2).Infof("Deleting expired role binding %s (expired %s)", key, value)
+	err = c.roleBindingClient.RoleBindings(namespace).Delete(name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &binding.UID},
+	})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// Run starts the controller and blocks until stopCh is closed.
+func (c *ExpiredRoleBindingsController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	glog.Infof("Starting ExpiredRoleBindingsController")
+	defer glog.Infof("Shutting down ExpiredRoleBindingsController")
+
+	if !controller.WaitForCacheSync("ExpiredRoleBindingsController", stopCh, c.roleBindingSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (c *ExpiredRoleBindingsController) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *ExpiredRoleBindingsController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHandler(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("%v failed with : %v", key, err))
+	c.queue.AddRateLimited(key)
+
+	return true
+}
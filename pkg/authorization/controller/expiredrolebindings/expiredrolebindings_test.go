@@ -0,0 +1,87 @@
+package expiredrolebindings
+
+import (
+	"testing"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclientfake "k8s.io/client-go/kubernetes/fake"
+	rbaclisters "k8s.io/client-go/listers/rbac/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/controller"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+)
+
+func TestSyncRoleBinding(t *testing.T) {
+	now := time.Date(2018, 2, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		keyToSync    string
+		expectDelete bool
+	}{
+		{
+			name:         "expired",
+			annotations:  map[string]string{authorizationapi.RoleBindingExpirationAnnotation: now.Add(-time.Hour).Format(time.RFC3339)},
+			keyToSync:    "foo/bar",
+			expectDelete: true,
+		},
+		{
+			name:        "not yet expired",
+			annotations: map[string]string{authorizationapi.RoleBindingExpirationAnnotation: now.Add(time.Hour).Format(time.RFC3339)},
+			keyToSync:   "foo/bar",
+		},
+		{
+			name:        "malformed expiry",
+			annotations: map[string]string{authorizationapi.RoleBindingExpirationAnnotation: "tomorrow"},
+			keyToSync:   "foo/bar",
+		},
+		{
+			name:      "no annotation",
+			keyToSync: "foo/bar",
+		},
+		{
+			name:        "missing binding",
+			annotations: map[string]string{authorizationapi.RoleBindingExpirationAnnotation: now.Add(-time.Hour).Format(time.RFC3339)},
+			keyToSync:   "foo/missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			binding := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar", Annotations: test.annotations},
+			}
+			roleBindingIndexer := cache.NewIndexer(controller.KeyFunc, cache.Indexers{})
+			roleBindingIndexer.Add(binding)
+			fakeClient := kubeclientfake.NewSimpleClientset([]runtime.Object{binding}...)
+
+			c := ExpiredRoleBindingsController{
+				roleBindingClient: fakeClient.RbacV1(),
+				roleBindingLister: rbaclisters.NewRoleBindingLister(roleBindingIndexer),
+				now:               func() time.Time { return now },
+				queue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "test"),
+			}
+
+			if err := c.syncRoleBinding(test.keyToSync); err != nil {
+				t.Fatal(err)
+			}
+
+			deleted := false
+			for _, action := range fakeClient.Actions() {
+				if _, ok := action.(clienttesting.DeleteAction); ok {
+					deleted = true
+				}
+			}
+			if deleted != test.expectDelete {
+				t.Errorf("expected delete=%t, got %t (actions: %#v)", test.expectDelete, deleted, fakeClient.Actions())
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ type jenkinsBootstrapper struct {
 
 	privilegedRESTClientConfig restclient.Config
 	serviceClient              coreclient.ServicesGetter
+	namespaceClient            coreclient.NamespacesGetter
 	templateClient             templateclient.Interface
 
 	jenkinsConfig configapi.JenkinsPipelineConfig
@@ -77,6 +78,19 @@ func (a *jenkinsBootstrapper) Admit(attributes admission.Attributes) error {
 		return nil
 	}
 
+	// a namespace may select one of the configured template profiles (e.g. ephemeral,
+	// small or large persistent storage) for its auto-provisioned Jenkins
+	jenkinsConfig := a.jenkinsConfig
+	if ns, err := a.namespaceClient.Namespaces().Get(namespace, metav1.GetOptions{}); err == nil {
+		if profile := ns.Annotations[buildapi.JenkinsPipelineProfileAnnotation]; len(profile) > 0 {
+			overridden, ok := jenkinscontroller.ConfigForProfile(jenkinsConfig, profile)
+			if !ok {
+				return kapierrors.NewBadRequest(fmt.Sprintf("jenkins template profile %q selected by namespace %q is not configured", profile, namespace))
+			}
+			jenkinsConfig = overridden
+		}
+	}
+
 	// TODO pull this from a cache.
 	if _, err := a.serviceClient.Services(namespace).Get(svcName, metav1.GetOptions{}); !kapierrors.IsNotFound(err) {
 		// if it isn't a "not found" error, return the error.  Either its nil and there's nothing to do or something went really wrong
@@ -84,13 +98,13 @@ func (a *jenkinsBootstrapper) Admit(attributes admission.Attributes) error {
 	}
 
 	glog.V(3).Infof("Adding new jenkins service %q to the project %q", svcName, namespace)
-	jenkinsTemplate := jenkinscontroller.NewPipelineTemplate(namespace, a.jenkinsConfig, a.templateClient)
+	jenkinsTemplate := jenkinscontroller.NewPipelineTemplate(namespace, jenkinsConfig, a.templateClient)
 	objects, errs := jenkinsTemplate.Process()
 	if len(errs) > 0 {
 		return kutilerrors.NewAggregate(errs)
 	}
 	if !jenkinsTemplate.HasJenkinsService(objects) {
-		return fmt.Errorf("template %s/%s does not contain required service %q", a.jenkinsConfig.TemplateNamespace, a.jenkinsConfig.TemplateName, a.jenkinsConfig.ServiceName)
+		return fmt.Errorf("template %s/%s does not contain required service %q", jenkinsConfig.TemplateNamespace, jenkinsConfig.TemplateName, jenkinsConfig.ServiceName)
 	}
 
 	impersonatingConfig := authenticationclient.NewImpersonatingConfig(attributes.GetUserInfo(), a.privilegedRESTClientConfig)
@@ -148,6 +162,7 @@ func (a *jenkinsBootstrapper) SetRESTClientConfig(restClientConfig restclient.Co
 
 func (q *jenkinsBootstrapper) SetInternalKubeClientSet(c kclientset.Interface) {
 	q.serviceClient = c.Core()
+	q.namespaceClient = c.Core()
 }
 
 func (a *jenkinsBootstrapper) SetOpenshiftInternalTemplateClient(c templateclient.Interface) {
@@ -158,6 +173,9 @@ func (a *jenkinsBootstrapper) ValidateInitialization() error {
 	if a.serviceClient == nil {
 		return fmt.Errorf("missing serviceClient")
 	}
+	if a.namespaceClient == nil {
+		return fmt.Errorf("missing namespaceClient")
+	}
 	if a.templateClient == nil {
 		return fmt.Errorf("missing templateClient")
 	}
@@ -63,6 +63,76 @@ const (
 	// forces the build to be processed by the build controller queue without waiting
 	// for a resync.
 	BuildAcceptedAnnotation = "build.openshift.io/accepted"
+	// BuildEgressAllowlistAnnotation holds a comma separated list of CIDRs a build
+	// pod is permitted to reach, enforced through a NetworkPolicy the build
+	// controller creates alongside the build pod. On a build pod it is set from
+	// the BuildOverrides configuration; on a namespace it narrows the configured
+	// allowlist for builds in that namespace.
+	BuildEgressAllowlistAnnotation = "build.openshift.io/egress-allowlist"
+	// BuildDefaultEnvAnnotation is a namespace annotation holding a comma separated list of
+	// name=value pairs applied as default environment variables to builds in the namespace.
+	BuildDefaultEnvAnnotation = "build.openshift.io/default-env"
+	// BuildDefaultNodeSelectorAnnotation is a namespace annotation holding a comma separated
+	// list of key=value pairs applied as the default node selector for build pods in the
+	// namespace.
+	BuildDefaultNodeSelectorAnnotation = "build.openshift.io/default-node-selector"
+	// BuildDefaultResourceRequestsAnnotation is a namespace annotation holding a comma
+	// separated list of resource=quantity pairs (e.g. cpu=500m,memory=1Gi) applied as default
+	// resource requests for builds in the namespace.
+	BuildDefaultResourceRequestsAnnotation = "build.openshift.io/default-resource-requests"
+	// BuildDefaultResourceLimitsAnnotation is a namespace annotation holding a comma separated
+	// list of resource=quantity pairs applied as default resource limits for builds in the
+	// namespace.
+	BuildDefaultResourceLimitsAnnotation = "build.openshift.io/default-resource-limits"
+	// BuildSpreadPolicyAnnotation selects how concurrent build pods are spread across
+	// nodes. It may be set on a BuildConfig (the generator copies it to builds) or
+	// directly on a build, and overrides the cluster default from the BuildDefaults
+	// configuration. Valid values are "none", "buildconfig" and "namespace".
+	BuildSpreadPolicyAnnotation = "build.openshift.io/spread-policy"
+	// BuildSpreadPolicyNone disables build pod spreading.
+	BuildSpreadPolicyNone = "none"
+	// BuildSpreadPolicyBuildConfig spreads concurrent builds of the same build config
+	// across nodes with a soft pod anti-affinity.
+	BuildSpreadPolicyBuildConfig = "buildconfig"
+	// BuildSpreadPolicyNamespace spreads all concurrent builds in the build's namespace
+	// across nodes with a soft pod anti-affinity.
+	BuildSpreadPolicyNamespace = "namespace"
+	// BuildLogsArchiveURLAnnotation records the URL completed build logs were shipped
+	// to by the build controller's configured log sink. The build log subresource
+	// serves logs from this location once the build pod is gone.
+	BuildLogsArchiveURLAnnotation = "build.openshift.io/logs-archive-url"
+	// BuildConfigRetryMaxAnnotation is a BuildConfig annotation holding the maximum number
+	// of times builds that fail for transient infrastructure reasons (deleted build pod,
+	// builder image pull failure, registry push failure) are retried automatically.
+	// When absent or zero no automatic retries happen.
+	BuildConfigRetryMaxAnnotation = "build.openshift.io/retry-max"
+	// BuildConfigRetryBackoffSecondsAnnotation is a BuildConfig annotation holding the base
+	// delay in seconds before a failed build is retried. The delay doubles with every
+	// attempt. Defaults to 30 seconds.
+	BuildConfigRetryBackoffSecondsAnnotation = "build.openshift.io/retry-backoff-seconds"
+	// BuildRetryAttemptAnnotation records on a build which automatic retry attempt it is,
+	// starting at 1 for the first retry.
+	BuildRetryAttemptAnnotation = "build.openshift.io/retry-attempt"
+	// BuildRetryOfAnnotation records on a build the name of the failed build it was
+	// instantiated to retry.
+	BuildRetryOfAnnotation = "build.openshift.io/retry-of"
+	// BuildTriggerEnvFromLabelsAnnotation is a BuildConfig annotation holding a comma
+	// separated list of NAME=label pairs. When an image change trigger fires, the named
+	// docker labels of the triggering image (e.g. version, vcs-ref) are passed to the
+	// instantiated build as environment variables with the given names.
+	BuildTriggerEnvFromLabelsAnnotation = "image.build.openshift.io/env-from-labels"
+	// JenkinsPipelineProfileAnnotation is a namespace annotation selecting which of the
+	// configured Jenkins template profiles (for example ephemeral, persistent-small or
+	// persistent-large) is used when Jenkins is auto-provisioned for the namespace.
+	JenkinsPipelineProfileAnnotation = "pipeline.build.openshift.io/jenkins-profile"
+	// WebHookAllowedRefsAnnotation is a BuildConfig annotation holding a comma separated
+	// list of glob patterns matched against the branch name of incoming webhook push
+	// events. When set, pushes to branches that do not match any pattern are ignored.
+	WebHookAllowedRefsAnnotation = "webhook.build.openshift.io/allowed-refs"
+	// WebHookAllowedPathsAnnotation is a BuildConfig annotation holding a comma separated
+	// list of repository path prefixes. When set, webhook push events only trigger a build
+	// if at least one changed file in the payload falls under one of the prefixes.
+	WebHookAllowedPathsAnnotation = "webhook.build.openshift.io/allowed-paths"
 
 	// BuildStartedEventReason is the reason associated with the event registered when a build is started (pod is created).
 	BuildStartedEventReason = "BuildStarted"
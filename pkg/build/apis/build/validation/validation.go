@@ -631,8 +631,15 @@ func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path, isGe
 	if len(webHook.Secret) == 0 && webHook.SecretReference == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath, webHook, "must provide a value for at least one of secret or secretReference"))
 	}
-	if webHook.SecretReference != nil && len(webHook.SecretReference.Name) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("secretReference").Child("name"), ""))
+	if webHook.SecretReference != nil {
+		switch {
+		case len(webHook.SecretReference.Name) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretReference").Child("name"), ""))
+		default:
+			if reasons := validation.ValidateSecretName(webHook.SecretReference.Name, false); len(reasons) != 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("secretReference").Child("name"), webHook.SecretReference.Name, strings.Join(reasons, ", ")))
+			}
+		}
 	}
 	if !isGeneric && webHook.AllowEnv {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("allowEnv"), webHook, "git webhooks cannot allow env vars"))
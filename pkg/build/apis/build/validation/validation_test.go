@@ -2605,6 +2605,17 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Required(field.NewPath("generic.secretReference.name"), "")},
 		},
+		"Webhook trigger with invalid secretref name": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					SecretReference: &buildapi.SecretLocalReference{
+						Name: "Invalid_Name",
+					},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("generic.secretReference.name"), "Invalid_Name", "")},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -28,12 +28,17 @@ import (
 	"github.com/openshift/origin/pkg/build/webhook/generic"
 	"github.com/openshift/origin/pkg/build/webhook/github"
 	"github.com/openshift/origin/pkg/build/webhook/gitlab"
+	"github.com/openshift/origin/pkg/git"
 	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset"
 )
 
 type ExtraConfig struct {
 	KubeAPIServerClientConfig *restclient.Config
 	KubeletClientConfig       *kubeletclient.KubeletClientConfig
+	// BuildLogSinkURL is the base URL the build controller archives completed
+	// build logs to. The logs subresource only serves archived log locations
+	// that point under this base.
+	BuildLogSinkURL string
 
 	// TODO these should all become local eventually
 	Scheme   *runtime.Scheme
@@ -148,8 +153,9 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 			ImageStreamImages: imageClient.Image(),
 			ImageStreamTags:   imageClient.Image(),
 		},
-		ServiceAccounts: kubeInternalClient.Core(),
-		Secrets:         kubeInternalClient.Core(),
+		ServiceAccounts:     kubeInternalClient.Core(),
+		Secrets:             kubeInternalClient.Core(),
+		GitRepositoryForEnv: git.NewRepositoryWithEnv,
 	}
 	buildConfigWebHooks := buildconfigregistry.NewWebHookREST(
 		buildClient.Build(),
@@ -169,7 +175,7 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 	v1Storage := map[string]rest.Storage{}
 	v1Storage["builds"] = buildStorage
 	v1Storage["builds/clone"] = buildclone.NewStorage(buildGenerator)
-	v1Storage["builds/log"] = buildlogregistry.NewREST(buildClient.Build(), kubeInternalClient.Core(), nodeConnectionInfoGetter)
+	v1Storage["builds/log"] = buildlogregistry.NewREST(buildClient.Build(), kubeInternalClient.Core(), nodeConnectionInfoGetter, c.ExtraConfig.BuildLogSinkURL)
 	v1Storage["builds/details"] = buildDetailsStorage
 
 	v1Storage["buildConfigs"] = buildConfigStorage
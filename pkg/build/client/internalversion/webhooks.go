@@ -2,6 +2,7 @@ package internalversion
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 
 	"k8s.io/client-go/rest"
@@ -28,14 +29,24 @@ func (c *webhooks) WebHookURL(name string, trigger *buildapi.BuildTriggerPolicy)
 	hooks := c.client.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks")
 	switch {
 	case trigger.GenericWebHook != nil:
-		return hooks.Suffix("<secret>", "generic").URL(), nil
+		return hooks.Suffix(secretPlaceholder(trigger.GenericWebHook), "generic").URL(), nil
 	case trigger.GitHubWebHook != nil:
-		return hooks.Suffix("<secret>", "github").URL(), nil
+		return hooks.Suffix(secretPlaceholder(trigger.GitHubWebHook), "github").URL(), nil
 	case trigger.GitLabWebHook != nil:
-		return hooks.Suffix("<secret>", "gitlab").URL(), nil
+		return hooks.Suffix(secretPlaceholder(trigger.GitLabWebHook), "gitlab").URL(), nil
 	case trigger.BitbucketWebHook != nil:
-		return hooks.Suffix("<secret>", "bitbucket").URL(), nil
+		return hooks.Suffix(secretPlaceholder(trigger.BitbucketWebHook), "bitbucket").URL(), nil
 	default:
 		return nil, ErrTriggerIsNotAWebHook
 	}
 }
+
+// secretPlaceholder returns the placeholder used for the secret segment of a webhook URL.
+// Triggers that only reference a secret include its name so users know which secret holds
+// the value to substitute.
+func secretPlaceholder(trigger *buildapi.WebHookTrigger) string {
+	if len(trigger.Secret) == 0 && trigger.SecretReference != nil {
+		return fmt.Sprintf("<secret:%s>", trigger.SecretReference.Name)
+	}
+	return "<secret>"
+}
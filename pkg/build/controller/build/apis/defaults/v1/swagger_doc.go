@@ -16,6 +16,9 @@ var map_BuildDefaultsConfig = map[string]string{
 	"nodeSelector":           "nodeSelector is a selector which must be true for the build pod to fit on a node",
 	"annotations":            "annotations are annotations that will be added to the build pod",
 	"resources":              "resources defines resource requirements to execute the build.",
+	"allowNamespaceDefaults": "allowNamespaceDefaults enables per-namespace build defaults read from annotations on the build's namespace. Namespace defaults are applied after the defaults in this config and never override them.",
+	"namespaceDefaultsMaxResources": "namespaceDefaultsMaxResources bounds the resource requests and limits a namespace may set through annotations. Quantities larger than the bound are reduced to it. If empty, namespaces may default any value.",
+	"spreadPolicy":                  "spreadPolicy sets the default pod anti-affinity applied to build pods so that concurrent builds spread across nodes instead of saturating a single node. Valid values are \"none\" (no anti-affinity), \"buildconfig\" (spread builds of the same build config) and \"namespace\" (spread all builds in a namespace). A build config may override the default with the build.openshift.io/spread-policy annotation.",
 }
 
 func (BuildDefaultsConfig) SwaggerDoc() map[string]string {
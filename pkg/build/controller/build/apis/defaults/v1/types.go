@@ -43,6 +43,23 @@ type BuildDefaultsConfig struct {
 
 	// resources defines resource requirements to execute the build.
 	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
+
+	// allowNamespaceDefaults enables per-namespace build defaults read from annotations on
+	// the build's namespace. Namespace defaults are applied after the defaults in this config
+	// and never override them.
+	AllowNamespaceDefaults bool `json:"allowNamespaceDefaults,omitempty"`
+
+	// namespaceDefaultsMaxResources bounds the resource requests and limits a namespace may
+	// set through annotations. Quantities larger than the bound are reduced to it. If empty,
+	// namespaces may default any value.
+	NamespaceDefaultsMaxResources kapi.ResourceRequirements `json:"namespaceDefaultsMaxResources,omitempty"`
+
+	// spreadPolicy sets the default pod anti-affinity applied to build pods so that
+	// concurrent builds spread across nodes instead of saturating a single node. Valid
+	// values are "none" (no anti-affinity), "buildconfig" (spread builds of the same
+	// build config) and "namespace" (spread all builds in a namespace). A build config
+	// may override the default with the build.openshift.io/spread-policy annotation.
+	SpreadPolicy string `json:"spreadPolicy,omitempty"`
 }
 
 // SourceStrategyDefaultsConfig contains values that apply to builds using the
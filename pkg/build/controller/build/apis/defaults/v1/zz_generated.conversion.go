@@ -53,6 +53,11 @@ func autoConvert_v1_BuildDefaultsConfig_To_defaults_BuildDefaultsConfig(in *Buil
 	if err := core_v1.Convert_v1_ResourceRequirements_To_core_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	out.AllowNamespaceDefaults = in.AllowNamespaceDefaults
+	if err := core_v1.Convert_v1_ResourceRequirements_To_core_ResourceRequirements(&in.NamespaceDefaultsMaxResources, &out.NamespaceDefaultsMaxResources, s); err != nil {
+		return err
+	}
+	out.SpreadPolicy = in.SpreadPolicy
 	return nil
 }
 
@@ -83,6 +88,11 @@ func autoConvert_defaults_BuildDefaultsConfig_To_v1_BuildDefaultsConfig(in *defa
 	if err := core_v1.Convert_core_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	out.AllowNamespaceDefaults = in.AllowNamespaceDefaults
+	if err := core_v1.Convert_core_ResourceRequirements_To_v1_ResourceRequirements(&in.NamespaceDefaultsMaxResources, &out.NamespaceDefaultsMaxResources, s); err != nil {
+		return err
+	}
+	out.SpreadPolicy = in.SpreadPolicy
 	return nil
 }
 
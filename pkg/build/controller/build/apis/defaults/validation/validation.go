@@ -4,6 +4,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/apis/core/validation"
 
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
 	buildvalidation "github.com/openshift/origin/pkg/build/apis/build/validation"
 	"github.com/openshift/origin/pkg/build/controller/build/apis/defaults"
 	"github.com/openshift/origin/pkg/build/util"
@@ -18,6 +19,17 @@ func ValidateBuildDefaultsConfig(config *defaults.BuildDefaultsConfig) field.Err
 	allErrs = append(allErrs, buildvalidation.ValidateImageLabels(config.ImageLabels, field.NewPath("imageLabels"))...)
 	allErrs = append(allErrs, buildvalidation.ValidateNodeSelector(config.NodeSelector, field.NewPath("nodeSelector"))...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(config.Annotations, field.NewPath("annotations"))...)
+	allErrs = append(allErrs, validateSpreadPolicy(config.SpreadPolicy, field.NewPath("spreadPolicy"))...)
+	return allErrs
+}
+
+func validateSpreadPolicy(policy string, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch policy {
+	case "", buildapi.BuildSpreadPolicyNone, buildapi.BuildSpreadPolicyBuildConfig, buildapi.BuildSpreadPolicyNamespace:
+	default:
+		allErrs = append(allErrs, field.NotSupported(path, policy, []string{buildapi.BuildSpreadPolicyNone, buildapi.BuildSpreadPolicyBuildConfig, buildapi.BuildSpreadPolicyNamespace}))
+	}
 	return allErrs
 }
 
@@ -50,6 +50,7 @@ func (in *BuildDefaultsConfig) DeepCopyInto(out *BuildDefaultsConfig) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	in.NamespaceDefaultsMaxResources.DeepCopyInto(&out.NamespaceDefaultsMaxResources)
 	return
 }
 
@@ -32,4 +32,12 @@ type BuildOverridesConfig struct {
 	// tolerations is a list of Tolerations that will override any existing
 	// tolerations set on a build pod.
 	Tolerations []kapi.Toleration
+
+	// allowedEgressCIDRs is a list of CIDRs that build pods are permitted to
+	// reach. When set, the allowlist is recorded on every build pod and the
+	// build controller creates a NetworkPolicy restricting the pod's egress
+	// to it, so enforcement requires a network plugin that supports
+	// NetworkPolicy. A namespace may narrow (but not widen) the list via the
+	// build.openshift.io/egress-allowlist annotation.
+	AllowedEgressCIDRs []string
 }
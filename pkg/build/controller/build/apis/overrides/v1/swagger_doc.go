@@ -11,6 +11,7 @@ var map_BuildOverridesConfig = map[string]string{
 	"imageLabels":  "imageLabels is a list of docker labels that are applied to the resulting image. If user provided a label in their Build/BuildConfig with the same name as one in this list, the user's label will be overwritten.",
 	"nodeSelector": "nodeSelector is a selector which must be true for the build pod to fit on a node",
 	"annotations":  "annotations are annotations that will be added to the build pod",
+	"allowedEgressCIDRs": "allowedEgressCIDRs is a list of CIDRs that build pods are permitted to reach. When set, the allowlist is recorded on every build pod and the build controller creates a NetworkPolicy restricting the pod's egress to it, so enforcement requires a network plugin that supports NetworkPolicy. A namespace may narrow (but not widen) the list via the build.openshift.io/egress-allowlist annotation.",
 }
 
 func (BuildOverridesConfig) SwaggerDoc() map[string]string {
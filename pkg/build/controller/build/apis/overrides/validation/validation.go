@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"net"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/apis/core/validation"
 
@@ -13,6 +15,17 @@ func ValidateBuildOverridesConfig(config *overrides.BuildOverridesConfig) field.
 	allErrs = append(allErrs, buildvalidation.ValidateImageLabels(config.ImageLabels, field.NewPath("imageLabels"))...)
 	allErrs = append(allErrs, buildvalidation.ValidateNodeSelector(config.NodeSelector, field.NewPath("nodeSelector"))...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(config.Annotations, field.NewPath("annotations"))...)
+	allErrs = append(allErrs, validateEgressCIDRs(config.AllowedEgressCIDRs, field.NewPath("allowedEgressCIDRs"))...)
 
 	return allErrs
 }
+
+func validateEgressCIDRs(cidrs []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, "must be a valid CIDR"))
+		}
+	}
+	return allErrs
+}
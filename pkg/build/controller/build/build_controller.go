@@ -2,6 +2,8 @@ package build
 
 import (
 	"fmt"
+	"io"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"github.com/golang/glog"
 	metrics "github.com/openshift/origin/pkg/build/metrics/prometheus"
 	"k8s.io/api/core/v1"
+	knetworkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -19,6 +22,7 @@ import (
 	kexternalclientset "k8s.io/client-go/kubernetes"
 	kexternalcoreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	kexternalnetworkingclient "k8s.io/client-go/kubernetes/typed/networking/v1"
 	v1lister "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -119,12 +123,14 @@ func (q *resourceTriggerQueue) Pop(key string) []string {
 // a secret or make it usable by a build - but this is identical to our existing model
 // where a service account determines access to secrets used in pods.
 type BuildController struct {
-	buildPatcher      buildclient.BuildPatcher
-	buildLister       buildlister.BuildLister
-	buildConfigGetter buildlister.BuildConfigLister
-	buildDeleter      buildclient.BuildDeleter
-	podClient         kexternalcoreclient.PodsGetter
-	kubeClient        kclientset.Interface
+	buildPatcher            buildclient.BuildPatcher
+	buildLister             buildlister.BuildLister
+	buildConfigGetter       buildlister.BuildConfigLister
+	buildDeleter            buildclient.BuildDeleter
+	buildConfigInstantiator buildclient.BuildConfigInstantiator
+	podClient               kexternalcoreclient.PodsGetter
+	networkPolicyClient     kexternalnetworkingclient.NetworkPoliciesGetter
+	kubeClient              kclientset.Interface
 
 	buildQueue       workqueue.RateLimitingInterface
 	imageStreamQueue *resourceTriggerQueue
@@ -148,6 +154,8 @@ type BuildController struct {
 	buildDefaults  builddefaults.BuildDefaults
 	buildOverrides buildoverrides.BuildOverrides
 
+	logSink logSink
+
 	recorder record.EventRecorder
 }
 
@@ -167,6 +175,9 @@ type BuildControllerParams struct {
 	CustomBuildStrategy *strategy.CustomBuildStrategy
 	BuildDefaults       builddefaults.BuildDefaults
 	BuildOverrides      buildoverrides.BuildOverrides
+	// BuildLogSinkURL, when set, is the base http(s) URL completed build logs are
+	// shipped to so they remain retrievable after the build pod is deleted.
+	BuildLogSinkURL string
 }
 
 // NewBuildController creates a new BuildController.
@@ -178,18 +189,20 @@ func NewBuildController(params *BuildControllerParams) *BuildController {
 	buildLister := params.BuildInformer.Lister()
 	buildConfigGetter := params.BuildConfigInformer.Lister()
 	c := &BuildController{
-		buildPatcher:      buildClient,
-		buildLister:       buildLister,
-		buildConfigGetter: buildConfigGetter,
-		buildDeleter:      buildClient,
-		secretStore:       params.SecretInformer.Lister(),
-		podClient:         params.KubeClientExternal.Core(),
-		kubeClient:        params.KubeClientInternal,
-		podInformer:       params.PodInformer.Informer(),
-		podStore:          params.PodInformer.Lister(),
-		buildInformer:     params.BuildInformer.Informer(),
-		buildStore:        params.BuildInformer.Lister(),
-		imageStreamStore:  params.ImageStreamInformer.Lister(),
+		buildPatcher:            buildClient,
+		buildLister:             buildLister,
+		buildConfigGetter:       buildConfigGetter,
+		buildDeleter:            buildClient,
+		buildConfigInstantiator: buildclient.NewClientBuildConfigInstantiatorClient(params.BuildClientInternal),
+		secretStore:             params.SecretInformer.Lister(),
+		podClient:               params.KubeClientExternal.Core(),
+		networkPolicyClient:     params.KubeClientExternal.NetworkingV1(),
+		kubeClient:              params.KubeClientInternal,
+		podInformer:             params.PodInformer.Informer(),
+		podStore:                params.PodInformer.Lister(),
+		buildInformer:           params.BuildInformer.Informer(),
+		buildStore:              params.BuildInformer.Lister(),
+		imageStreamStore:        params.ImageStreamInformer.Lister(),
 		createStrategy: &typeBasedFactoryStrategy{
 			dockerBuildStrategy: params.DockerBuildStrategy,
 			sourceBuildStrategy: params.SourceBuildStrategy,
@@ -206,6 +219,15 @@ func NewBuildController(params *BuildControllerParams) *BuildController {
 		runPolicies: policy.GetAllRunPolicies(buildLister, buildClient),
 	}
 
+	if len(params.BuildLogSinkURL) > 0 {
+		sink, err := newHTTPSink(params.BuildLogSinkURL)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("build log archival is disabled: %v", err))
+		} else {
+			c.logSink = sink
+		}
+	}
+
 	c.podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: c.podUpdated,
 		DeleteFunc: c.podDeleted,
@@ -490,6 +512,9 @@ func (bc *BuildController) createPodSpec(build *buildapi.Build) (*v1.Pod, error)
 	if err := bc.buildOverrides.ApplyOverrides(podSpec); err != nil {
 		return nil, fmt.Errorf("failed to apply build overrides for build %s/%s: %v", build.Namespace, build.Name, err)
 	}
+	if err := bc.applyNamespaceEgressAllowlist(build.Namespace, podSpec); err != nil {
+		return nil, fmt.Errorf("failed to apply egress allowlist for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
 
 	// Handle resolving ValueFrom references in build environment variables
 	if err := common.ResolveValueFrom(podSpec, bc.kubeClient); err != nil {
@@ -498,6 +523,111 @@ func (bc *BuildController) createPodSpec(build *buildapi.Build) (*v1.Pod, error)
 	return podSpec, nil
 }
 
+// applyNamespaceEgressAllowlist narrows the egress allowlist on the build pod
+// using the BuildEgressAllowlistAnnotation on the build's namespace, if present.
+// The namespace annotation can only remove entries from the allowlist applied
+// by the BuildOverrides configuration, never add to it.
+func (bc *BuildController) applyNamespaceEgressAllowlist(namespace string, pod *v1.Pod) error {
+	ns, err := bc.kubeClient.Core().Namespaces().Get(namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	requested, exists := ns.Annotations[buildapi.BuildEgressAllowlistAnnotation]
+	if !exists {
+		return nil
+	}
+	for _, cidr := range strings.Split(requested, ",") {
+		if len(cidr) == 0 {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid CIDR %q in the %s annotation on namespace %s: %v", cidr, buildapi.BuildEgressAllowlistAnnotation, namespace, err)
+		}
+	}
+	configured := ""
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	} else {
+		configured = pod.Annotations[buildapi.BuildEgressAllowlistAnnotation]
+	}
+	pod.Annotations[buildapi.BuildEgressAllowlistAnnotation] = intersectEgressAllowlists(configured, requested)
+	return nil
+}
+
+// intersectEgressAllowlists returns the entries of requested that are also in
+// configured. An empty configured list means the cluster does not restrict
+// build egress and the requested list is used as-is.
+func intersectEgressAllowlists(configured, requested string) string {
+	if len(configured) == 0 {
+		return requested
+	}
+	allowed := map[string]bool{}
+	for _, cidr := range strings.Split(configured, ",") {
+		allowed[cidr] = true
+	}
+	kept := []string{}
+	for _, cidr := range strings.Split(requested, ",") {
+		if allowed[cidr] {
+			kept = append(kept, cidr)
+		}
+	}
+	return strings.Join(kept, ",")
+}
+
+// ensureEgressNetworkPolicy creates the NetworkPolicy that enforces the egress
+// allowlist recorded on the build pod. It is called before the pod itself is
+// created so the pod is never scheduled without the allowlist in force. The
+// policy selects only this build's pod by name and label, and is owned by the
+// build, so it is garbage collected along with it. An allowlist annotation
+// whose intersection came out empty yields a policy with no egress rules,
+// which denies all egress for the pod.
+func (bc *BuildController) ensureEgressNetworkPolicy(build *buildapi.Build, pod *v1.Pod) error {
+	allowlist, exists := pod.Annotations[buildapi.BuildEgressAllowlistAnnotation]
+	if !exists {
+		return nil
+	}
+	peers := []knetworkingv1.NetworkPolicyPeer{}
+	for _, cidr := range strings.Split(allowlist, ",") {
+		if len(cidr) == 0 {
+			continue
+		}
+		peers = append(peers, knetworkingv1.NetworkPolicyPeer{
+			IPBlock: &knetworkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+	controller := true
+	policy := &knetworkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name + "-egress",
+			Namespace: pod.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: strategy.BuildControllerRefKind.GroupVersion().String(),
+					Kind:       strategy.BuildControllerRefKind.Kind,
+					Name:       build.Name,
+					UID:        build.UID,
+					Controller: &controller,
+				},
+			},
+		},
+		Spec: knetworkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{buildapi.BuildLabel: pod.Labels[buildapi.BuildLabel]},
+			},
+			PolicyTypes: []knetworkingv1.PolicyType{knetworkingv1.PolicyTypeEgress},
+		},
+	}
+	if len(peers) > 0 {
+		policy.Spec.Egress = []knetworkingv1.NetworkPolicyEgressRule{{To: peers}}
+	}
+	glog.V(5).Infof("Creating egress network policy %s/%s for allowlist %q", policy.Namespace, policy.Name, allowlist)
+	_, err := bc.networkPolicyClient.NetworkPolicies(pod.Namespace).Create(policy)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 // resolveImageSecretAsReference returns a LocalObjectReference to a secret that should
 // be able to push/pull at the image location.
 // Note that we are using controller level permissions to resolve the secret,
@@ -891,6 +1021,17 @@ func (bc *BuildController) createBuildPod(build *buildapi.Build) (*buildUpdate,
 		return update, nil
 	}
 
+	// The policy enforcing the egress allowlist must be in force before the
+	// pod exists, or the pod could egress freely while the policy is still
+	// being created. A failure here leaves the build in New to be retried
+	// without a pod ever having been scheduled.
+	if err := bc.ensureEgressNetworkPolicy(build, buildPod); err != nil {
+		bc.recorder.Eventf(build, kapi.EventTypeWarning, "FailedCreate", "Error creating egress network policy: %v", err)
+		update.setReason(buildapi.StatusReasonCannotCreateBuildPod)
+		update.setMessage(buildapi.StatusMessageCannotCreateBuildPod)
+		return update, fmt.Errorf("failed to create egress network policy for build pod %s/%s: %v", buildPod.Namespace, buildPod.Name, err)
+	}
+
 	glog.V(4).Infof("Pod %s/%s for build %s is about to be created", build.Namespace, buildPod.Name, buildDesc(build))
 	_, err = bc.podClient.Pods(build.Namespace).Create(buildPod)
 	if err != nil && !errors.IsAlreadyExists(err) {
@@ -920,6 +1061,7 @@ func (bc *BuildController) createBuildPod(build *buildapi.Build) (*buildUpdate,
 	} else {
 		glog.V(4).Infof("Created pod %s/%s for build %s", build.Namespace, buildPod.Name, buildDesc(build))
 	}
+
 	update = transitionToPhase(buildapi.BuildPhasePending, "", "")
 
 	if pushSecret != nil {
@@ -1089,6 +1231,64 @@ func (bc *BuildController) handleBuildCompletion(build *buildapi.Build) {
 	if err := common.HandleBuildPruning(bcName, build.Namespace, bc.buildLister, bc.buildConfigGetter, bc.buildDeleter); err != nil {
 		utilruntime.HandleError(fmt.Errorf("failed to prune old builds %s/%s: %v", build.Namespace, build.Name, err))
 	}
+	bc.retryBuildIfNeeded(build)
+	if bc.logSink != nil {
+		go bc.archiveBuildLogs(build)
+	}
+}
+
+// archiveBuildLogs ships the logs of a completed build to the configured log sink and
+// records the location on the build so the build log subresource can serve them after
+// the build pod is deleted.
+func (bc *BuildController) archiveBuildLogs(build *buildapi.Build) {
+	if len(build.Annotations[buildapi.BuildLogsArchiveURLAnnotation]) > 0 {
+		return
+	}
+	podName := buildapi.GetBuildPodName(build)
+	pod, err := bc.podClient.Pods(build.Namespace).Get(podName, metav1.GetOptions{})
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("cannot archive logs for build %s: %v", buildDesc(build), err))
+		return
+	}
+
+	// Funnel the logs of all containers, in execution order, into the upload so the
+	// archive matches what the build log subresource streams from the pod.
+	reader, writer := io.Pipe()
+	go func() {
+		defer writer.Close()
+		containers := append([]v1.Container{}, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for _, container := range containers {
+			stream, err := bc.podClient.Pods(pod.Namespace).GetLogs(podName, &v1.PodLogOptions{Container: container.Name}).Stream()
+			if err != nil {
+				glog.V(4).Infof("Unable to stream logs of container %s of build %s: %v", container.Name, buildDesc(build), err)
+				continue
+			}
+			io.Copy(writer, stream)
+			stream.Close()
+		}
+	}()
+
+	location, err := bc.logSink.Store(build, reader)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to archive logs for build %s: %v", buildDesc(build), err))
+		return
+	}
+
+	updatedBuild := build.DeepCopy()
+	if updatedBuild.Annotations == nil {
+		updatedBuild.Annotations = map[string]string{}
+	}
+	updatedBuild.Annotations[buildapi.BuildLogsArchiveURLAnnotation] = location
+	patch, err := validation.CreateBuildPatch(build, updatedBuild)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to create a patch recording the archived logs of build %s: %v", buildDesc(build), err))
+		return
+	}
+	if _, err := bc.buildPatcher.Patch(build.Namespace, build.Name, patch); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to record the archived log location on build %s: %v", buildDesc(build), err))
+	}
+	glog.V(4).Infof("Archived logs of build %s to %s", buildDesc(build), location)
 }
 
 func (bc *BuildController) enqueueBuildConfig(ns, name string) {
@@ -3,7 +3,10 @@ package defaults
 import (
 	"github.com/golang/glog"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kcoreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kubeletapis "k8s.io/kubernetes/pkg/kubelet/apis"
 
 	buildadmission "github.com/openshift/origin/pkg/build/admission"
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
@@ -17,6 +20,10 @@ import (
 
 type BuildDefaults struct {
 	config *defaultsapi.BuildDefaultsConfig
+
+	// Client is used to read per-namespace build defaults from namespace annotations when
+	// allowNamespaceDefaults is enabled in the plugin config.
+	Client kcoreclient.NamespacesGetter
 }
 
 // NewBuildDefaults creates a new BuildDefaults that will apply the defaults specified in the plugin config
@@ -46,10 +53,24 @@ func (b BuildDefaults) ApplyDefaults(pod *v1.Pod) error {
 	}
 
 	glog.V(4).Infof("Applying defaults to build %s/%s", build.Namespace, build.Name)
-	b.applyBuildDefaults(build)
+	b.applyBuildDefaults(build, b.config)
 
 	glog.V(4).Infof("Applying defaults to pod %s/%s", pod.Namespace, pod.Name)
-	b.applyPodDefaults(pod)
+	b.applyPodDefaults(pod, b.config)
+
+	if b.config.AllowNamespaceDefaults && b.Client != nil {
+		nsConfig, err := b.namespaceDefaults(pod.Namespace)
+		if err != nil {
+			return err
+		}
+		if nsConfig != nil {
+			glog.V(4).Infof("Applying namespace defaults to build %s/%s", build.Namespace, build.Name)
+			b.applyBuildDefaults(build, nsConfig)
+			b.applyPodDefaults(pod, nsConfig)
+		}
+	}
+
+	b.applySpreadPolicy(pod, build)
 
 	err = buildadmission.SetPodLogLevelFromBuild(pod, build)
 	if err != nil {
@@ -59,27 +80,27 @@ func (b BuildDefaults) ApplyDefaults(pod *v1.Pod) error {
 	return buildadmission.SetBuildInPod(pod, build, version)
 }
 
-func (b BuildDefaults) applyPodDefaults(pod *v1.Pod) {
-	if len(b.config.NodeSelector) != 0 && pod.Spec.NodeSelector == nil {
+func (b BuildDefaults) applyPodDefaults(pod *v1.Pod, config *defaultsapi.BuildDefaultsConfig) {
+	if len(config.NodeSelector) != 0 && pod.Spec.NodeSelector == nil {
 		// only apply nodeselector defaults if the pod has no nodeselector labels
 		// already.
 		pod.Spec.NodeSelector = map[string]string{}
-		for k, v := range b.config.NodeSelector {
+		for k, v := range config.NodeSelector {
 			addDefaultNodeSelector(k, v, pod.Spec.NodeSelector)
 		}
 	}
 
-	if len(b.config.Annotations) != 0 {
+	if len(config.Annotations) != 0 {
 		if pod.Annotations == nil {
 			pod.Annotations = map[string]string{}
 		}
-		for k, v := range b.config.Annotations {
+		for k, v := range config.Annotations {
 			addDefaultAnnotation(k, v, pod.Annotations)
 		}
 	}
 
 	// Apply default resources
-	defaultResources := b.config.Resources
+	defaultResources := config.Resources
 	allContainers := make([]*v1.Container, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
 	for i := range pod.Spec.Containers {
 		allContainers = append(allContainers, &pod.Spec.Containers[i])
@@ -89,7 +110,7 @@ func (b BuildDefaults) applyPodDefaults(pod *v1.Pod) {
 	}
 
 	for _, c := range allContainers {
-		util.MergeTrustedEnvWithoutDuplicates(util.CopyApiEnvVarToV1EnvVar(b.config.Env), &c.Env, false)
+		util.MergeTrustedEnvWithoutDuplicates(util.CopyApiEnvVarToV1EnvVar(config.Env), &c.Env, false)
 
 		if c.Resources.Limits == nil {
 			c.Resources.Limits = v1.ResourceList{}
@@ -112,20 +133,20 @@ func (b BuildDefaults) applyPodDefaults(pod *v1.Pod) {
 	}
 }
 
-func (b BuildDefaults) applyBuildDefaults(build *buildapi.Build) {
+func (b BuildDefaults) applyBuildDefaults(build *buildapi.Build, config *defaultsapi.BuildDefaultsConfig) {
 	// Apply default env
-	for _, envVar := range b.config.Env {
+	for _, envVar := range config.Env {
 		glog.V(5).Infof("Adding default environment variable %s=%s to build %s/%s", envVar.Name, envVar.Value, build.Namespace, build.Name)
 		addDefaultEnvVar(build, envVar)
 	}
 
 	// Apply default labels
-	for _, lbl := range b.config.ImageLabels {
+	for _, lbl := range config.ImageLabels {
 		glog.V(5).Infof("Adding default image label %s=%s to build %s/%s", lbl.Name, lbl.Value, build.Namespace, build.Name)
 		addDefaultLabel(lbl, &build.Spec.Output.ImageLabels)
 	}
 
-	sourceDefaults := b.config.SourceStrategyDefaults
+	sourceDefaults := config.SourceStrategyDefaults
 	sourceStrategy := build.Spec.Strategy.SourceStrategy
 	if sourceDefaults != nil && sourceDefaults.Incremental != nil && *sourceDefaults.Incremental &&
 		sourceStrategy != nil && sourceStrategy.Incremental == nil {
@@ -138,32 +159,32 @@ func (b BuildDefaults) applyBuildDefaults(build *buildapi.Build) {
 	if build.Spec.Source.Git == nil {
 		return
 	}
-	if len(b.config.GitHTTPProxy) != 0 {
+	if len(config.GitHTTPProxy) != 0 {
 		if build.Spec.Source.Git.HTTPProxy == nil {
-			t := b.config.GitHTTPProxy
+			t := config.GitHTTPProxy
 			glog.V(5).Infof("Setting default Git HTTP proxy of build %s/%s to %s", build.Namespace, build.Name, t)
 			build.Spec.Source.Git.HTTPProxy = &t
 		}
 	}
 
-	if len(b.config.GitHTTPSProxy) != 0 {
+	if len(config.GitHTTPSProxy) != 0 {
 		if build.Spec.Source.Git.HTTPSProxy == nil {
-			t := b.config.GitHTTPSProxy
+			t := config.GitHTTPSProxy
 			glog.V(5).Infof("Setting default Git HTTPS proxy of build %s/%s to %s", build.Namespace, build.Name, t)
 			build.Spec.Source.Git.HTTPSProxy = &t
 		}
 	}
 
-	if len(b.config.GitNoProxy) != 0 {
+	if len(config.GitNoProxy) != 0 {
 		if build.Spec.Source.Git.NoProxy == nil {
-			t := b.config.GitNoProxy
+			t := config.GitNoProxy
 			glog.V(5).Infof("Setting default Git no proxy of build %s/%s to %s", build.Namespace, build.Name, t)
 			build.Spec.Source.Git.NoProxy = &t
 		}
 	}
 
 	//Apply default resources
-	defaultResources := b.config.Resources
+	defaultResources := config.Resources
 	if build.Spec.Resources.Limits == nil {
 		build.Spec.Resources.Limits = kapi.ResourceList{}
 	}
@@ -184,6 +205,57 @@ func (b BuildDefaults) applyBuildDefaults(build *buildapi.Build) {
 	}
 }
 
+// applySpreadPolicy sets a soft pod anti-affinity on the build pod so concurrent
+// builds spread across nodes instead of saturating a single node's disk and CPU.
+// The policy comes from the build's spread-policy annotation when present (copied
+// from its build config by the generator), otherwise from the cluster default.
+func (b BuildDefaults) applySpreadPolicy(pod *v1.Pod, build *buildapi.Build) {
+	policy := b.config.SpreadPolicy
+	if value, ok := build.Annotations[buildapi.BuildSpreadPolicyAnnotation]; ok {
+		policy = value
+	}
+	if len(policy) == 0 || policy == buildapi.BuildSpreadPolicyNone {
+		return
+	}
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.PodAntiAffinity != nil {
+		return
+	}
+
+	term := v1.PodAffinityTerm{TopologyKey: kubeletapis.LabelHostname}
+	switch policy {
+	case buildapi.BuildSpreadPolicyBuildConfig:
+		configName := buildutil.ConfigNameForBuild(build)
+		if len(configName) == 0 {
+			glog.V(4).Infof("Build %s/%s has no build config, not spreading its pod", build.Namespace, build.Name)
+			return
+		}
+		term.LabelSelector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{buildapi.BuildConfigLabel: buildapi.LabelValue(configName)},
+		}
+	case buildapi.BuildSpreadPolicyNamespace:
+		term.LabelSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: buildapi.BuildLabel, Operator: metav1.LabelSelectorOpExists},
+			},
+		}
+	default:
+		// the cluster default is validated with the plugin config; an annotation
+		// carrying an unknown policy is ignored
+		glog.V(4).Infof("Ignoring unknown spread policy %q on build %s/%s", policy, build.Namespace, build.Name)
+		return
+	}
+
+	glog.V(5).Infof("Setting spread policy %q anti-affinity on build pod %s/%s", policy, pod.Namespace, pod.Name)
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &v1.Affinity{}
+	}
+	pod.Spec.Affinity.PodAntiAffinity = &v1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{
+			{Weight: 100, PodAffinityTerm: term},
+		},
+	}
+}
+
 func addDefaultEnvVar(build *buildapi.Build, v kapi.EnvVar) {
 	envVars := buildutil.GetBuildEnv(build)
 
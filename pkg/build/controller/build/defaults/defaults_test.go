@@ -598,3 +598,85 @@ func TestResourceDefaults(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildDefaultsSpreadPolicy(t *testing.T) {
+	tests := []struct {
+		name             string
+		defaultPolicy    string
+		annotationPolicy string
+		expectedPolicy   string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:           "cluster default buildconfig policy",
+			defaultPolicy:  buildapi.BuildSpreadPolicyBuildConfig,
+			expectedPolicy: buildapi.BuildSpreadPolicyBuildConfig,
+		},
+		{
+			name:           "cluster default namespace policy",
+			defaultPolicy:  buildapi.BuildSpreadPolicyNamespace,
+			expectedPolicy: buildapi.BuildSpreadPolicyNamespace,
+		},
+		{
+			name:             "annotation overrides cluster default",
+			defaultPolicy:    buildapi.BuildSpreadPolicyNamespace,
+			annotationPolicy: buildapi.BuildSpreadPolicyBuildConfig,
+			expectedPolicy:   buildapi.BuildSpreadPolicyBuildConfig,
+		},
+		{
+			name:             "annotation disables cluster default",
+			defaultPolicy:    buildapi.BuildSpreadPolicyBuildConfig,
+			annotationPolicy: buildapi.BuildSpreadPolicyNone,
+		},
+		{
+			name:             "annotation enables spreading without cluster default",
+			annotationPolicy: buildapi.BuildSpreadPolicyNamespace,
+			expectedPolicy:   buildapi.BuildSpreadPolicyNamespace,
+		},
+	}
+
+	for _, test := range tests {
+		build := u.Build().WithDockerStrategy().AsBuild()
+		build.Annotations = map[string]string{buildapi.BuildConfigAnnotation: "test-bc"}
+		if len(test.annotationPolicy) != 0 {
+			build.Annotations[buildapi.BuildSpreadPolicyAnnotation] = test.annotationPolicy
+		}
+
+		defaults := BuildDefaults{config: &defaultsapi.BuildDefaultsConfig{SpreadPolicy: test.defaultPolicy}}
+		pod := u.Pod().WithBuild(t, build, "v1")
+		if err := defaults.ApplyDefaults((*v1.Pod)(pod)); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		if len(test.expectedPolicy) == 0 {
+			if pod.Spec.Affinity != nil {
+				t.Errorf("%s: expected no affinity, got %#v", test.name, pod.Spec.Affinity)
+			}
+			continue
+		}
+
+		if pod.Spec.Affinity == nil || pod.Spec.Affinity.PodAntiAffinity == nil ||
+			len(pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) != 1 {
+			t.Errorf("%s: expected a single preferred anti-affinity term, got %#v", test.name, pod.Spec.Affinity)
+			continue
+		}
+		term := pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm
+		if term.TopologyKey != "kubernetes.io/hostname" {
+			t.Errorf("%s: unexpected topology key %q", test.name, term.TopologyKey)
+		}
+		switch test.expectedPolicy {
+		case buildapi.BuildSpreadPolicyBuildConfig:
+			if term.LabelSelector == nil || term.LabelSelector.MatchLabels[buildapi.BuildConfigLabel] != "test-bc" {
+				t.Errorf("%s: unexpected label selector %#v", test.name, term.LabelSelector)
+			}
+		case buildapi.BuildSpreadPolicyNamespace:
+			if term.LabelSelector == nil || len(term.LabelSelector.MatchExpressions) != 1 ||
+				term.LabelSelector.MatchExpressions[0].Key != buildapi.BuildLabel {
+				t.Errorf("%s: unexpected label selector %#v", test.name, term.LabelSelector)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package defaults
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	defaultsapi "github.com/openshift/origin/pkg/build/controller/build/apis/defaults"
+)
+
+// namespaceDefaults reads the build default annotations from the provided namespace and
+// returns a config holding them, or nil if the namespace sets no defaults.
+func (b BuildDefaults) namespaceDefaults(namespace string) (*defaultsapi.BuildDefaultsConfig, error) {
+	ns, err := b.Client.Namespaces().Get(namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return namespaceDefaultsFromAnnotations(ns.Annotations, b.config.NamespaceDefaultsMaxResources)
+}
+
+// namespaceDefaultsFromAnnotations converts the build default annotations on a namespace into
+// a config. Resource quantities larger than the provided bound are reduced to the bound.
+func namespaceDefaultsFromAnnotations(annotations map[string]string, bound kapi.ResourceRequirements) (*defaultsapi.BuildDefaultsConfig, error) {
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	config := &defaultsapi.BuildDefaultsConfig{}
+	found := false
+
+	if value, ok := annotations[buildapi.BuildDefaultEnvAnnotation]; ok {
+		pairs, err := parseAnnotationPairs(buildapi.BuildDefaultEnvAnnotation, value)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range pairs {
+			config.Env = append(config.Env, kapi.EnvVar{Name: pair[0], Value: pair[1]})
+		}
+		found = true
+	}
+
+	if value, ok := annotations[buildapi.BuildDefaultNodeSelectorAnnotation]; ok {
+		pairs, err := parseAnnotationPairs(buildapi.BuildDefaultNodeSelectorAnnotation, value)
+		if err != nil {
+			return nil, err
+		}
+		config.NodeSelector = map[string]string{}
+		for _, pair := range pairs {
+			config.NodeSelector[pair[0]] = pair[1]
+		}
+		found = true
+	}
+
+	if value, ok := annotations[buildapi.BuildDefaultResourceRequestsAnnotation]; ok {
+		requests, err := parseAnnotationResourceList(buildapi.BuildDefaultResourceRequestsAnnotation, value, bound.Requests)
+		if err != nil {
+			return nil, err
+		}
+		config.Resources.Requests = requests
+		found = true
+	}
+
+	if value, ok := annotations[buildapi.BuildDefaultResourceLimitsAnnotation]; ok {
+		limits, err := parseAnnotationResourceList(buildapi.BuildDefaultResourceLimitsAnnotation, value, bound.Limits)
+		if err != nil {
+			return nil, err
+		}
+		config.Resources.Limits = limits
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+	return config, nil
+}
+
+// parseAnnotationPairs splits a comma separated list of key=value pairs.
+func parseAnnotationPairs(annotation, value string) ([][2]string, error) {
+	var pairs [][2]string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("annotation %s has invalid entry %q, expected key=value", annotation, entry)
+		}
+		pairs = append(pairs, [2]string{parts[0], parts[1]})
+	}
+	return pairs, nil
+}
+
+// parseAnnotationResourceList converts a comma separated list of resource=quantity pairs into
+// a resource list, reducing quantities that exceed the bound to the bound.
+func parseAnnotationResourceList(annotation, value string, bound kapi.ResourceList) (kapi.ResourceList, error) {
+	pairs, err := parseAnnotationPairs(annotation, value)
+	if err != nil {
+		return nil, err
+	}
+	list := kapi.ResourceList{}
+	for _, pair := range pairs {
+		name := kapi.ResourceName(pair[0])
+		quantity, err := resource.ParseQuantity(pair[1])
+		if err != nil {
+			return nil, fmt.Errorf("annotation %s has invalid quantity %q for resource %s: %v", annotation, pair[1], name, err)
+		}
+		if max, ok := bound[name]; ok && quantity.Cmp(max) > 0 {
+			quantity = max.DeepCopy()
+		}
+		list[name] = quantity
+	}
+	return list, nil
+}
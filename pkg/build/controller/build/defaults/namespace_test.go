@@ -0,0 +1,83 @@
+package defaults
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+)
+
+func TestNamespaceDefaultsFromAnnotations(t *testing.T) {
+	bound := kapi.ResourceRequirements{
+		Requests: kapi.ResourceList{
+			kapi.ResourceCPU: resource.MustParse("1"),
+		},
+		Limits: kapi.ResourceList{
+			kapi.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+
+	// no annotations produces no config
+	config, err := namespaceDefaultsFromAnnotations(nil, bound)
+	if err != nil || config != nil {
+		t.Errorf("expected no config for empty annotations, got %#v, %v", config, err)
+	}
+
+	// unrelated annotations produce no config
+	config, err = namespaceDefaultsFromAnnotations(map[string]string{"other": "value"}, bound)
+	if err != nil || config != nil {
+		t.Errorf("expected no config for unrelated annotations, got %#v, %v", config, err)
+	}
+
+	// env and node selector are parsed
+	config, err = namespaceDefaultsFromAnnotations(map[string]string{
+		buildapi.BuildDefaultEnvAnnotation:          "HTTP_PROXY=http://proxy:3128,DEBUG=1",
+		buildapi.BuildDefaultNodeSelectorAnnotation: "region=build",
+	}, bound)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedEnv := []kapi.EnvVar{
+		{Name: "HTTP_PROXY", Value: "http://proxy:3128"},
+		{Name: "DEBUG", Value: "1"},
+	}
+	if !reflect.DeepEqual(config.Env, expectedEnv) {
+		t.Errorf("unexpected env: %#v", config.Env)
+	}
+	if !reflect.DeepEqual(config.NodeSelector, map[string]string{"region": "build"}) {
+		t.Errorf("unexpected node selector: %#v", config.NodeSelector)
+	}
+
+	// resources above the bound are reduced to the bound
+	config, err = namespaceDefaultsFromAnnotations(map[string]string{
+		buildapi.BuildDefaultResourceRequestsAnnotation: "cpu=4,memory=512Mi",
+		buildapi.BuildDefaultResourceLimitsAnnotation:   "memory=8Gi",
+	}, bound)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cpu := config.Resources.Requests[kapi.ResourceCPU]; cpu.Cmp(resource.MustParse("1")) != 0 {
+		t.Errorf("expected cpu request clamped to 1, got %s", cpu.String())
+	}
+	if memory := config.Resources.Requests[kapi.ResourceMemory]; memory.Cmp(resource.MustParse("512Mi")) != 0 {
+		t.Errorf("expected memory request 512Mi, got %s", memory.String())
+	}
+	if memory := config.Resources.Limits[kapi.ResourceMemory]; memory.Cmp(resource.MustParse("2Gi")) != 0 {
+		t.Errorf("expected memory limit clamped to 2Gi, got %s", memory.String())
+	}
+
+	// invalid entries are rejected
+	if _, err := namespaceDefaultsFromAnnotations(map[string]string{
+		buildapi.BuildDefaultEnvAnnotation: "novalue",
+	}, bound); err == nil {
+		t.Errorf("expected error for entry without a value")
+	}
+	if _, err := namespaceDefaultsFromAnnotations(map[string]string{
+		buildapi.BuildDefaultResourceLimitsAnnotation: "memory=notaquantity",
+	}, bound); err == nil {
+		t.Errorf("expected error for invalid quantity")
+	}
+}
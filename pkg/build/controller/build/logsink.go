@@ -0,0 +1,61 @@
+package build
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+)
+
+// logSink stores the logs of a completed build in an external location.
+type logSink interface {
+	// Store uploads the logs for the given build and returns the URL they can be
+	// retrieved from.
+	Store(build *buildapi.Build, logs io.Reader) (string, error)
+}
+
+// httpSink uploads build logs with an HTTP PUT to baseURL/<namespace>/<name>. S3 and
+// Swift object stores are addressed through their HTTP APIs using pre-signed or
+// temp-url style base URLs.
+type httpSink struct {
+	base   *url.URL
+	client *http.Client
+}
+
+// newHTTPSink creates a log sink uploading to the given http(s) base URL.
+func newHTTPSink(baseURL string) (*httpSink, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log sink URL %q: %v", baseURL, err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("log sink URL %q must use the http or https scheme", baseURL)
+	}
+	return &httpSink{
+		base:   base,
+		client: &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+func (s *httpSink) Store(build *buildapi.Build, logs io.Reader) (string, error) {
+	location := *s.base
+	location.Path = path.Join(location.Path, build.Namespace, build.Name)
+	req, err := http.NewRequest(http.MethodPut, location.String(), logs)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("log sink returned status %s for %s", resp.Status, location.String())
+	}
+	return location.String(), nil
+}
@@ -0,0 +1,75 @@
+package build
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+)
+
+func TestHTTPSinkStore(t *testing.T) {
+	var gotMethod, gotPath, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotBody = string(body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	sink, err := newHTTPSink(server.URL + "/logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build := &buildapi.Build{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-build-1", Namespace: "test"},
+	}
+	location, err := sink.Store(build, strings.NewReader("the build log"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("expected a PUT request, got %s", gotMethod)
+	}
+	if expected := "/logs/test/data-build-1"; gotPath != expected {
+		t.Errorf("expected upload path %q, got %q", expected, gotPath)
+	}
+	if gotBody != "the build log" {
+		t.Errorf("unexpected uploaded body: %q", gotBody)
+	}
+	if expected := server.URL + "/logs/test/data-build-1"; location != expected {
+		t.Errorf("expected location %q, got %q", expected, location)
+	}
+}
+
+func TestHTTPSinkStoreFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	sink, err := newHTTPSink(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build := &buildapi.Build{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-build-1", Namespace: "test"},
+	}
+	if _, err := sink.Store(build, strings.NewReader("the build log")); err == nil {
+		t.Errorf("expected an error from a non-2xx sink response")
+	}
+}
+
+func TestNewHTTPSinkInvalidURL(t *testing.T) {
+	for _, invalid := range []string{"ftp://example.com/logs", "example.com/logs"} {
+		if _, err := newHTTPSink(invalid); err == nil {
+			t.Errorf("%s: expected an error", invalid)
+		}
+	}
+}
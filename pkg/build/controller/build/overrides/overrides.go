@@ -2,6 +2,7 @@ package overrides
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 
@@ -90,6 +91,14 @@ func (b BuildOverrides) ApplyOverrides(pod *v1.Pod) error {
 		pod.Annotations[k] = v
 	}
 
+	if len(b.config.AllowedEgressCIDRs) != 0 {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		glog.V(5).Infof("Applying egress allowlist %v to build pod %s/%s", b.config.AllowedEgressCIDRs, pod.Namespace, pod.Name)
+		pod.Annotations[buildapi.BuildEgressAllowlistAnnotation] = strings.Join(b.config.AllowedEgressCIDRs, ",")
+	}
+
 	// Override Tolerations
 	if len(b.config.Tolerations) != 0 {
 		glog.V(5).Infof("Overriding tolerations for pod %s/%s", pod.Namespace, pod.Name)
@@ -290,3 +290,40 @@ func TestBuildOverrideAnnotations(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildOverrideEgressAllowlist(t *testing.T) {
+	tests := []struct {
+		name     string
+		cidrs    []string
+		expected string
+	}{
+		{
+			name:     "no allowlist",
+			cidrs:    nil,
+			expected: "",
+		},
+		{
+			name:     "single cidr",
+			cidrs:    []string{"10.0.0.0/8"},
+			expected: "10.0.0.0/8",
+		},
+		{
+			name:     "multiple cidrs",
+			cidrs:    []string{"10.0.0.0/8", "192.168.1.0/24"},
+			expected: "10.0.0.0/8,192.168.1.0/24",
+		},
+	}
+
+	for _, test := range tests {
+		overrides := BuildOverrides{config: &overridesapi.BuildOverridesConfig{AllowedEgressCIDRs: test.cidrs}}
+		pod := u.Pod().WithBuild(t, u.Build().AsBuild(), "v1")
+		err := overrides.ApplyOverrides((*v1.Pod)(pod))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		actual := pod.Annotations[buildapi.BuildEgressAllowlistAnnotation]
+		if actual != test.expected {
+			t.Errorf("%s: expected egress allowlist %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package build
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+const (
+	// defaultRetryBackoff is the delay before the first automatic retry when the
+	// build config does not set a backoff of its own.
+	defaultRetryBackoff = 30 * time.Second
+	// maxRetryBackoff caps the exponentially growing retry delay.
+	maxRetryBackoff = 10 * time.Minute
+)
+
+// transientFailureReasons are failure reasons caused by the cluster
+// infrastructure rather than the build itself, where rerunning the build has a
+// reasonable chance of succeeding.
+var transientFailureReasons = map[buildapi.StatusReason]bool{
+	buildapi.StatusReasonBuildPodDeleted:           true,
+	buildapi.StatusReasonPullBuilderImageFailed:    true,
+	buildapi.StatusReasonPushImageToRegistryFailed: true,
+}
+
+// isTransientBuildFailure returns true if the build ended because of an
+// infrastructure failure that an automatic retry may recover from.
+func isTransientBuildFailure(build *buildapi.Build) bool {
+	if build.Status.Phase != buildapi.BuildPhaseFailed && build.Status.Phase != buildapi.BuildPhaseError {
+		return false
+	}
+	return transientFailureReasons[build.Status.Reason]
+}
+
+// retryAttempt returns which automatic retry attempt the build represents.
+// Builds that were not started by the retry logic return 0.
+func retryAttempt(build *buildapi.Build) int {
+	attempt, err := strconv.Atoi(build.Annotations[buildapi.BuildRetryAttemptAnnotation])
+	if err != nil || attempt < 0 {
+		return 0
+	}
+	return attempt
+}
+
+// retryBackoff returns how long to wait before starting the given retry
+// attempt. The configured base backoff doubles with every attempt and is
+// capped at maxRetryBackoff.
+func retryBackoff(config *buildapi.BuildConfig, attempt int) time.Duration {
+	backoff := defaultRetryBackoff
+	if seconds, err := strconv.Atoi(config.Annotations[buildapi.BuildConfigRetryBackoffSecondsAnnotation]); err == nil && seconds > 0 {
+		backoff = time.Duration(seconds) * time.Second
+	}
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// retryBuildIfNeeded instantiates a new build when the given build failed for
+// a transient infrastructure reason and the owning build config opted into
+// automatic retries. The new build records the attempt number and the failed
+// build it retries so repeated syncs of the failed build do not retry twice.
+func (bc *BuildController) retryBuildIfNeeded(build *buildapi.Build) {
+	if !isTransientBuildFailure(build) {
+		return
+	}
+	configName := buildutil.ConfigNameForBuild(build)
+	if len(configName) == 0 {
+		return
+	}
+	config, err := bc.buildConfigGetter.BuildConfigs(build.Namespace).Get(configName)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("cannot evaluate retry policy for build %s: %v", buildDesc(build), err))
+		return
+	}
+	maxAttempts, err := strconv.Atoi(config.Annotations[buildapi.BuildConfigRetryMaxAnnotation])
+	if err != nil || maxAttempts <= 0 {
+		return
+	}
+
+	attempt := retryAttempt(build) + 1
+	if attempt > maxAttempts {
+		bc.recorder.Eventf(build, kapi.EventTypeWarning, "BuildRetriesExhausted", "Build %s/%s failed with transient reason %s after %d automatic retries", build.Namespace, build.Name, build.Status.Reason, maxAttempts)
+		return
+	}
+
+	// if a retry of this build was already instantiated, do nothing
+	builds, err := bc.buildLister.Builds(build.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("cannot evaluate retry policy for build %s: %v", buildDesc(build), err))
+		return
+	}
+	for _, existing := range builds {
+		if existing.Annotations[buildapi.BuildRetryOfAnnotation] == build.Name {
+			return
+		}
+	}
+
+	backoff := retryBackoff(config, attempt)
+	glog.V(4).Infof("Retrying build %s (attempt %d/%d, reason %s) in %v", buildDesc(build), attempt, maxAttempts, build.Status.Reason, backoff)
+	bc.recorder.Eventf(build, kapi.EventTypeNormal, "BuildRetryScheduled", "Build %s/%s failed with transient reason %s, retry %d/%d scheduled in %v", build.Namespace, build.Name, build.Status.Reason, attempt, maxAttempts, backoff)
+
+	namespace := build.Namespace
+	buildName := build.Name
+	time.AfterFunc(backoff, func() {
+		request := &buildapi.BuildRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configName,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					buildapi.BuildRetryAttemptAnnotation: strconv.Itoa(attempt),
+					buildapi.BuildRetryOfAnnotation:      buildName,
+				},
+			},
+			TriggeredBy: []buildapi.BuildTriggerCause{
+				{Message: fmt.Sprintf("Automatic retry %d/%d of failed build %s", attempt, maxAttempts, buildName)},
+			},
+		}
+		if _, err := bc.buildConfigInstantiator.Instantiate(namespace, request); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to retry build %s/%s: %v", namespace, buildName, err))
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package build
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+)
+
+func TestIsTransientBuildFailure(t *testing.T) {
+	tests := []struct {
+		phase    buildapi.BuildPhase
+		reason   buildapi.StatusReason
+		expected bool
+	}{
+		{buildapi.BuildPhaseFailed, buildapi.StatusReasonBuildPodDeleted, true},
+		{buildapi.BuildPhaseError, buildapi.StatusReasonPullBuilderImageFailed, true},
+		{buildapi.BuildPhaseFailed, buildapi.StatusReasonPushImageToRegistryFailed, true},
+		{buildapi.BuildPhaseFailed, buildapi.StatusReasonGenericBuildFailed, false},
+		{buildapi.BuildPhaseComplete, buildapi.StatusReasonBuildPodDeleted, false},
+		{buildapi.BuildPhaseCancelled, buildapi.StatusReasonBuildPodDeleted, false},
+	}
+	for _, test := range tests {
+		build := mockBuild(test.phase, buildapi.BuildOutput{})
+		build.Status.Reason = test.reason
+		if actual := isTransientBuildFailure(build); actual != test.expected {
+			t.Errorf("phase %s, reason %s: expected %t, got %t", test.phase, test.reason, test.expected, actual)
+		}
+	}
+}
+
+func TestRetryAttempt(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseFailed, buildapi.BuildOutput{})
+	if attempt := retryAttempt(build); attempt != 0 {
+		t.Errorf("expected attempt 0 without annotation, got %d", attempt)
+	}
+	build.Annotations[buildapi.BuildRetryAttemptAnnotation] = "2"
+	if attempt := retryAttempt(build); attempt != 2 {
+		t.Errorf("expected attempt 2, got %d", attempt)
+	}
+	build.Annotations[buildapi.BuildRetryAttemptAnnotation] = "not-a-number"
+	if attempt := retryAttempt(build); attempt != 0 {
+		t.Errorf("expected attempt 0 for malformed annotation, got %d", attempt)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		attempt     int
+		expected    time.Duration
+	}{
+		{
+			name:     "default backoff",
+			attempt:  1,
+			expected: 30 * time.Second,
+		},
+		{
+			name:        "configured backoff",
+			annotations: map[string]string{buildapi.BuildConfigRetryBackoffSecondsAnnotation: "10"},
+			attempt:     1,
+			expected:    10 * time.Second,
+		},
+		{
+			name:        "backoff doubles per attempt",
+			annotations: map[string]string{buildapi.BuildConfigRetryBackoffSecondsAnnotation: "10"},
+			attempt:     3,
+			expected:    40 * time.Second,
+		},
+		{
+			name:        "backoff is capped",
+			annotations: map[string]string{buildapi.BuildConfigRetryBackoffSecondsAnnotation: "60"},
+			attempt:     20,
+			expected:    maxRetryBackoff,
+		},
+		{
+			name:        "malformed backoff uses default",
+			annotations: map[string]string{buildapi.BuildConfigRetryBackoffSecondsAnnotation: "soon"},
+			attempt:     1,
+			expected:    30 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		config := &buildapi.BuildConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-buildconfig", Annotations: test.annotations},
+		}
+		if actual := retryBackoff(config, test.attempt); actual != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
@@ -37,6 +37,33 @@ func NewPipelineTemplate(ns string, conf serverapi.JenkinsPipelineConfig, templa
 	}
 }
 
+// ConfigForProfile returns a copy of conf with the template reference and parameters
+// replaced by the named template profile. ok is false when no such profile is
+// configured.
+func ConfigForProfile(conf serverapi.JenkinsPipelineConfig, name string) (serverapi.JenkinsPipelineConfig, bool) {
+	profile, ok := conf.TemplateProfiles[name]
+	if !ok {
+		return conf, false
+	}
+	if len(profile.TemplateNamespace) > 0 {
+		conf.TemplateNamespace = profile.TemplateNamespace
+	}
+	if len(profile.TemplateName) > 0 {
+		conf.TemplateName = profile.TemplateName
+	}
+	if len(profile.Parameters) > 0 {
+		parameters := map[string]string{}
+		for name, value := range conf.Parameters {
+			parameters[name] = value
+		}
+		for name, value := range profile.Parameters {
+			parameters[name] = value
+		}
+		conf.Parameters = parameters
+	}
+	return conf, true
+}
+
 // Process processes the Jenkins template. If an error occurs
 func (t *PipelineTemplate) Process() (*kapi.List, []error) {
 	var errors []error
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	serverapi "github.com/openshift/origin/pkg/cmd/server/apis/config"
 	templateapi "github.com/openshift/origin/pkg/template/apis/template"
 )
 
@@ -40,3 +41,51 @@ func TestSubstituteTemplateParameters(t *testing.T) {
 			"parameter_foo_bar_2", "value_foo_bar_2", template.Parameters[1].Name, template.Parameters[1].Value)
 	}
 }
+
+func TestConfigForProfile(t *testing.T) {
+	conf := serverapi.JenkinsPipelineConfig{
+		TemplateNamespace: "openshift",
+		TemplateName:      "jenkins-ephemeral",
+		ServiceName:       "jenkins",
+		Parameters:        map[string]string{"MEMORY_LIMIT": "512Mi"},
+		TemplateProfiles: map[string]serverapi.JenkinsPipelineTemplateProfile{
+			"persistent-large": {
+				TemplateName: "jenkins-persistent",
+				Parameters:   map[string]string{"VOLUME_CAPACITY": "100Gi"},
+			},
+			"other-namespace": {
+				TemplateNamespace: "infra",
+				TemplateName:      "jenkins-custom",
+			},
+		},
+	}
+
+	if _, ok := ConfigForProfile(conf, "no-such-profile"); ok {
+		t.Errorf("expected unknown profile to not resolve")
+	}
+
+	overridden, ok := ConfigForProfile(conf, "persistent-large")
+	if !ok {
+		t.Fatalf("expected profile to resolve")
+	}
+	if overridden.TemplateNamespace != "openshift" || overridden.TemplateName != "jenkins-persistent" {
+		t.Errorf("unexpected template reference: %s/%s", overridden.TemplateNamespace, overridden.TemplateName)
+	}
+	if overridden.Parameters["VOLUME_CAPACITY"] != "100Gi" || overridden.Parameters["MEMORY_LIMIT"] != "512Mi" {
+		t.Errorf("unexpected parameters: %#v", overridden.Parameters)
+	}
+	if conf.Parameters["VOLUME_CAPACITY"] != "" {
+		t.Errorf("original config parameters were mutated: %#v", conf.Parameters)
+	}
+
+	overridden, ok = ConfigForProfile(conf, "other-namespace")
+	if !ok {
+		t.Fatalf("expected profile to resolve")
+	}
+	if overridden.TemplateNamespace != "infra" || overridden.TemplateName != "jenkins-custom" {
+		t.Errorf("unexpected template reference: %s/%s", overridden.TemplateNamespace, overridden.TemplateName)
+	}
+	if overridden.Parameters["MEMORY_LIMIT"] != "512Mi" {
+		t.Errorf("unexpected parameters: %#v", overridden.Parameters)
+	}
+}
@@ -267,7 +267,13 @@ func setupAdditionalSecrets(pod *v1.Pod, container *v1.Container, secrets []buil
 
 // getPodLabels creates labels for the Build Pod
 func getPodLabels(build *buildapi.Build) map[string]string {
-	return map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)}
+	podLabels := map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)}
+	// carry the build config label so that pod anti-affinity can spread
+	// concurrent builds of the same config across nodes
+	if configName, ok := build.Labels[buildapi.BuildConfigLabel]; ok {
+		podLabels[buildapi.BuildConfigLabel] = configName
+	}
+	return podLabels
 }
 
 func makeOwnerReference(build *buildapi.Build) metav1.OwnerReference {
@@ -2,11 +2,15 @@ package generator
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -24,6 +28,7 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset/typed/build/internalversion"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/git"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
 )
@@ -54,6 +59,13 @@ type BuildGenerator struct {
 	Client          GeneratorClient
 	ServiceAccounts kcoreclient.ServiceAccountsGetter
 	Secrets         kcoreclient.SecretsGetter
+
+	// GitRepositoryForEnv, if set, returns a git repository client whose
+	// subprocesses run with the given environment.  It is used to validate a
+	// requested source revision against the remote repository before a build
+	// is created, with any source credentials passed through the environment
+	// rather than the command line.  If nil, no remote validation takes place.
+	GitRepositoryForEnv func(env []string) git.Repository
 }
 
 // GeneratorClient is the API client used by the generator
@@ -240,6 +252,10 @@ func (g *BuildGenerator) instantiate(ctx apirequest.Context, request *buildapi.B
 		return nil, errors.NewBadRequest(err.Error())
 	}
 
+	if err := g.validateSourceRevision(bc, request.Revision); err != nil {
+		return nil, err
+	}
+
 	if err := g.updateImageTriggers(ctx, bc, request.From, request.TriggeredByImage); err != nil {
 		if _, ok := err.(errors.APIStatus); ok {
 			return nil, err
@@ -318,6 +334,162 @@ func (g *BuildGenerator) instantiate(ctx apirequest.Context, request *buildapi.B
 	return g.createBuild(ctx, newBuild)
 }
 
+// gitRevisionPattern limits requested source revisions to plausible git
+// revision specifiers before we hand them to a git subprocess.
+var gitRevisionPattern = regexp.MustCompile(`^[\w][\w\-\./]*$`)
+
+// gitFullSHAPattern matches a complete commit hash.
+var gitFullSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// remoteRevisionValidationTimeout bounds how long an instantiate request may
+// spend listing refs on the remote repository; git's own default is five
+// minutes, which is far too long to block an API request.
+const remoteRevisionValidationTimeout = 10 * time.Second
+
+// validateSourceRevision checks a requested source revision against the build
+// config's git repository before a build (and its pod) is created, so that a
+// build from a bad tag or branch fails fast with a useful error.  A full
+// commit hash cannot be resolved by ls-remote unless it happens to be a ref
+// tip, so only its format is checked.
+func (g *BuildGenerator) validateSourceRevision(bc *buildapi.BuildConfig, revision *buildapi.SourceRevision) error {
+	if revision == nil || revision.Git == nil || len(revision.Git.Commit) == 0 {
+		return nil
+	}
+
+	gitSource := bc.Spec.Source.Git
+	if gitSource == nil || len(gitSource.URI) == 0 {
+		return errors.NewBadRequest(fmt.Sprintf("cannot specify a source revision for BuildConfig %s/%s: it does not use a git source", bc.Namespace, bc.Name))
+	}
+
+	commit := revision.Git.Commit
+	if !gitRevisionPattern.MatchString(commit) {
+		return errors.NewBadRequest(fmt.Sprintf("invalid source revision %q", commit))
+	}
+	if gitFullSHAPattern.MatchString(commit) {
+		return nil
+	}
+
+	if g.GitRepositoryForEnv == nil {
+		return nil
+	}
+
+	uri, env, cleanup, ok := g.repositoryCredentialEnv(bc, gitSource.URI)
+	if !ok {
+		// the repository requires credentials we cannot present from here
+		// (e.g. an ssh key); leave validation to the build pod.
+		glog.V(4).Infof("Skipping source revision validation for BuildConfig %s/%s: unsupported credential type", bc.Namespace, bc.Name)
+		return nil
+	}
+	defer cleanup()
+
+	out, _, err := g.GitRepositoryForEnv(env).TimedListRemote(remoteRevisionValidationTimeout, uri)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("could not validate source revision %q against repository %s: %v", commit, gitSource.URI, err))
+	}
+	if !remoteHasRevision(out, commit) {
+		return errors.NewBadRequest(fmt.Sprintf("source revision %q not found in repository %s", commit, gitSource.URI))
+	}
+	return nil
+}
+
+// repositoryCredentialEnv returns the repository URL to use for remote
+// validation and the git environment that supplies basic auth credentials
+// from the build config's source secret, if one is set.  Credentials are
+// handed to git through GIT_ASKPASS so they never appear on the command
+// line, where they would be visible in the process table.  The returned
+// cleanup function removes the askpass helper and must always be called on
+// success.  It returns false if the repository cannot be reached with the
+// credentials available to the generator.
+func (g *BuildGenerator) repositoryCredentialEnv(bc *buildapi.BuildConfig, uri string) (string, []string, func(), bool) {
+	parsed, err := url.Parse(uri)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", nil, nil, false
+	}
+
+	// git must never fall back to prompting on a server
+	env := []string{"GIT_TERMINAL_PROMPT=0"}
+	if bc.Spec.Source.SourceSecret == nil {
+		return parsed.String(), env, func() {}, true
+	}
+
+	secret, err := g.Secrets.Secrets(bc.Namespace).Get(bc.Spec.Source.SourceSecret.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", nil, nil, false
+	}
+	username := string(secret.Data[kapi.BasicAuthUsernameKey])
+	password := string(secret.Data[kapi.BasicAuthPasswordKey])
+	if len(password) == 0 {
+		return "", nil, nil, false
+	}
+	if len(username) == 0 {
+		username = "token"
+	}
+
+	askpass, err := writeAskPassHelper()
+	if err != nil {
+		glog.V(4).Infof("Unable to write git askpass helper: %v", err)
+		return "", nil, nil, false
+	}
+	env = append(env,
+		"GIT_ASKPASS="+askpass,
+		"GIT_ASKPASS_USERNAME="+username,
+		"GIT_ASKPASS_PASSWORD="+password,
+	)
+	return parsed.String(), env, func() { os.Remove(askpass) }, true
+}
+
+// writeAskPassHelper writes a short-lived GIT_ASKPASS helper that answers
+// git's credential prompts from the environment.  The caller is responsible
+// for removing the helper when the git command has finished.
+func writeAskPassHelper() (string, error) {
+	f, err := ioutil.TempFile("", "git-askpass-")
+	if err != nil {
+		return "", err
+	}
+	const helper = `#!/bin/sh
+case "$1" in
+*[Uu]sername*) printf '%s' "$GIT_ASKPASS_USERNAME" ;;
+*) printf '%s' "$GIT_ASKPASS_PASSWORD" ;;
+esac
+`
+	if _, err := f.WriteString(helper); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err := os.Chmod(f.Name(), 0700); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// remoteHasRevision returns whether git ls-remote output contains a ref
+// matching the given revision, either by ref name (full or abbreviated) or by
+// commit hash prefix.
+func remoteHasRevision(lsRemoteOut, revision string) bool {
+	for _, line := range strings.Split(lsRemoteOut, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		sha, ref := fields[0], fields[1]
+		if strings.HasPrefix(sha, revision) {
+			return true
+		}
+		if ref == revision ||
+			ref == "refs/heads/"+revision ||
+			ref == "refs/tags/"+revision {
+			return true
+		}
+	}
+	return false
+}
+
 // checkLastVersion will return an error if the BuildConfig's LastVersion doesn't match the passed in lastVersion
 // when lastVersion is not nil
 func (g *BuildGenerator) checkLastVersion(bc *buildapi.BuildConfig, lastVersion *int64) error {
@@ -907,6 +1079,9 @@ func setBuildAnnotationAndLabel(bcCopy *buildapi.BuildConfig, build *buildapi.Bu
 	//bcCopy.Status.LastVersion has been increased
 	build.Annotations[buildapi.BuildNumberAnnotation] = strconv.FormatInt(bcCopy.Status.LastVersion, 10)
 	build.Annotations[buildapi.BuildConfigAnnotation] = bcCopy.Name
+	if policy, ok := bcCopy.Annotations[buildapi.BuildSpreadPolicyAnnotation]; ok {
+		build.Annotations[buildapi.BuildSpreadPolicyAnnotation] = policy
+	}
 	if build.Labels == nil {
 		build.Labels = make(map[string]string)
 	}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -19,6 +20,7 @@ import (
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
 	"github.com/openshift/origin/pkg/build/apis/build/validation"
 	mocks "github.com/openshift/origin/pkg/build/generator/test"
+	"github.com/openshift/origin/pkg/git"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 )
 
@@ -2213,3 +2215,113 @@ func TestOverrideSourceStrategyIncrementalOption(t *testing.T) {
 		t.Errorf("Spec.Strategy.SourceStrategy.Incremental was overwritten by nil buildRequest option, but should not have been")
 	}
 }
+
+type fakeGitRepository struct {
+	git.Repository
+	refs string
+	err  error
+}
+
+func (f *fakeGitRepository) TimedListRemote(timeout time.Duration, url string, args ...string) (string, string, error) {
+	return f.refs, "", f.err
+}
+
+func TestValidateSourceRevision(t *testing.T) {
+	refs := "0000000000000000000000000000000000000001\trefs/heads/master\n" +
+		"0000000000000000000000000000000000000002\trefs/tags/v1.0\n"
+
+	tests := []struct {
+		name        string
+		commit      string
+		source      buildapi.BuildSource
+		repository  git.Repository
+		expectError bool
+	}{
+		{
+			name:       "no remote validation without a repository",
+			commit:     "some-branch",
+			source:     mocks.MockSource(),
+			repository: nil,
+		},
+		{
+			name:       "tag found",
+			commit:     "v1.0",
+			source:     mocks.MockSource(),
+			repository: &fakeGitRepository{refs: refs},
+		},
+		{
+			name:       "branch found",
+			commit:     "master",
+			source:     mocks.MockSource(),
+			repository: &fakeGitRepository{refs: refs},
+		},
+		{
+			name:       "abbreviated sha found",
+			commit:     "00000000",
+			source:     mocks.MockSource(),
+			repository: &fakeGitRepository{refs: refs},
+		},
+		{
+			name:       "full sha is not resolved remotely",
+			commit:     "ffffffffffffffffffffffffffffffffffffffff",
+			source:     mocks.MockSource(),
+			repository: &fakeGitRepository{refs: refs},
+		},
+		{
+			name:        "ref not found",
+			commit:      "does-not-exist",
+			source:      mocks.MockSource(),
+			repository:  &fakeGitRepository{refs: refs},
+			expectError: true,
+		},
+		{
+			name:        "ls-remote failure",
+			commit:      "master",
+			source:      mocks.MockSource(),
+			repository:  &fakeGitRepository{err: fmt.Errorf("connection refused")},
+			expectError: true,
+		},
+		{
+			name:        "malformed revision",
+			commit:      "--upload-pack=evil",
+			source:      mocks.MockSource(),
+			repository:  &fakeGitRepository{refs: refs},
+			expectError: true,
+		},
+		{
+			name:        "revision without git source",
+			commit:      "master",
+			source:      buildapi.BuildSource{Binary: &buildapi.BinaryBuildSource{}},
+			repository:  &fakeGitRepository{refs: refs},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		g := BuildGenerator{}
+		if test.repository != nil {
+			repository := test.repository
+			g.GitRepositoryForEnv = func(env []string) git.Repository { return repository }
+		}
+		bc := &buildapi.BuildConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "buildconfig", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				CommonSpec: buildapi.CommonSpec{
+					Source: test.source,
+				},
+			},
+		}
+		revision := &buildapi.SourceRevision{
+			Git: &buildapi.GitSourceRevision{
+				Commit: test.commit,
+			},
+		}
+		err := g.validateSourceRevision(bc, revision)
+		if test.expectError && err == nil {
+			t.Errorf("%s: expected error, got none", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
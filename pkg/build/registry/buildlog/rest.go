@@ -3,6 +3,9 @@ package buildlog
 import (
 	"fmt"
 	"io"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -34,6 +37,11 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kubeletclient.ConnectionInfoGetter
 	Timeout        time.Duration
+
+	// BuildLogSinkURL is the base URL the build controller archives completed
+	// build logs to. Archived log locations are only served when they point
+	// under this base.
+	BuildLogSinkURL string
 }
 
 // TODO these wrapers shouldb e removed
@@ -54,12 +62,13 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(buildClient buildtypedclient.BuildsGetter, pn kcoreclient.PodsGetter, connectionInfo kubeletclient.ConnectionInfoGetter) *REST {
+func NewREST(buildClient buildtypedclient.BuildsGetter, pn kcoreclient.PodsGetter, connectionInfo kubeletclient.ConnectionInfoGetter, buildLogSinkURL string) *REST {
 	return &REST{
-		BuildClient:    buildClient,
-		PodGetter:      &podGetter{pn},
-		ConnectionInfo: connectionInfo,
-		Timeout:        defaultTimeout,
+		BuildClient:     buildClient,
+		PodGetter:       &podGetter{pn},
+		ConnectionInfo:  connectionInfo,
+		Timeout:         defaultTimeout,
+		BuildLogSinkURL: buildLogSinkURL,
 	}
 }
 
@@ -127,6 +136,20 @@ func (r *REST) Get(ctx apirequest.Context, name string, opts runtime.Object) (ru
 	// error out now.
 	obj, err := r.PodGetter.Get(ctx, buildPodName, &metav1.GetOptions{})
 	if err != nil {
+		// The build pod may be long gone, but if the build controller archived the
+		// logs to an external sink, serve them from there.
+		if location := build.Annotations[buildapi.BuildLogsArchiveURLAnnotation]; errors.IsNotFound(err) && len(location) > 0 {
+			archiveURL, ok := r.archiveLogLocation(location)
+			if !ok {
+				glog.V(2).Infof("Refusing to serve archived logs for build %s/%s: location %q is not under the configured log sink", build.Namespace, build.Name, location)
+				return nil, errors.NewBadRequest(err.Error())
+			}
+			return &genericrest.LocationStreamer{
+				Location:        archiveURL,
+				ContentType:     "text/plain",
+				ResponseChecker: genericrest.NewGenericHttpResponseChecker(buildapi.Resource("buildlogs"), build.Name),
+			}, nil
+		}
 		return nil, errors.NewBadRequest(err.Error())
 	}
 
@@ -322,6 +345,34 @@ func (r *REST) Get(ctx apirequest.Context, name string, opts runtime.Object) (ru
 	return &pipeStreamer, nil
 }
 
+// archiveLogLocation parses an archived log location and ensures it points
+// under the configured log sink. The annotation recording the location is
+// writable by anyone with update access to the build, so serving it without
+// this check would let users read from URLs only the apiserver can reach.
+func (r *REST) archiveLogLocation(location string) (*url.URL, bool) {
+	if len(r.BuildLogSinkURL) == 0 {
+		return nil, false
+	}
+	base, err := url.Parse(r.BuildLogSinkURL)
+	if err != nil {
+		return nil, false
+	}
+	archiveURL, err := url.Parse(location)
+	if err != nil {
+		return nil, false
+	}
+	if archiveURL.Scheme != base.Scheme || archiveURL.Host != base.Host {
+		return nil, false
+	}
+	basePath := strings.TrimSuffix(path.Clean("/"+base.Path), "/")
+	archivePath := path.Clean("/" + archiveURL.Path)
+	if archivePath != basePath && !strings.HasPrefix(archivePath, basePath+"/") {
+		return nil, false
+	}
+	archiveURL.Path = archivePath
+	return archiveURL, true
+}
+
 // NewGetOptions returns a new options object for build logs
 func (r *REST) NewGetOptions() (runtime.Object, bool, string) {
 	return &buildapi.BuildLogOptions{}, false, ""
@@ -295,3 +295,63 @@ func TestPreviousBuildLogs(t *testing.T) {
 		t.Fatalf("expected location:\n\t%s\ngot location:\n\t%s\n", exp, got)
 	}
 }
+
+func TestArchiveLogLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		sinkURL  string
+		location string
+		expectOK bool
+		expected string
+	}{
+		{
+			name:     "no sink configured",
+			sinkURL:  "",
+			location: "https://logs.example.com/builds/ns/build-1",
+			expectOK: false,
+		},
+		{
+			name:     "location under sink",
+			sinkURL:  "https://logs.example.com/builds",
+			location: "https://logs.example.com/builds/ns/build-1",
+			expectOK: true,
+			expected: "https://logs.example.com/builds/ns/build-1",
+		},
+		{
+			name:     "different host",
+			sinkURL:  "https://logs.example.com/builds",
+			location: "https://169.254.169.254/latest/meta-data",
+			expectOK: false,
+		},
+		{
+			name:     "different scheme",
+			sinkURL:  "https://logs.example.com/builds",
+			location: "http://logs.example.com/builds/ns/build-1",
+			expectOK: false,
+		},
+		{
+			name:     "path escape",
+			sinkURL:  "https://logs.example.com/builds",
+			location: "https://logs.example.com/builds/../secrets",
+			expectOK: false,
+		},
+		{
+			name:     "path prefix but different directory",
+			sinkURL:  "https://logs.example.com/builds",
+			location: "https://logs.example.com/builds-other/ns/build-1",
+			expectOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		storage := &REST{BuildLogSinkURL: test.sinkURL}
+		archiveURL, ok := storage.archiveLogLocation(test.location)
+		if ok != test.expectOK {
+			t.Errorf("%s: expected ok %t, got %t", test.name, test.expectOK, ok)
+			continue
+		}
+		if ok && archiveURL.String() != test.expected {
+			t.Errorf("%s: expected location %q, got %q", test.name, test.expected, archiveURL.String())
+		}
+	}
+}
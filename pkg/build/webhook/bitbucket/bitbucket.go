@@ -101,6 +101,12 @@ func (p *WebHookPlugin) Extract(buildCfg *buildapi.BuildConfig, trigger *buildap
 		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, branch)
 		return revision, envvars, dockerStrategyOptions, false, err
 	}
+	// The Bitbucket payloads parsed above do not carry per-file change lists, so only the
+	// ref allowlist can be enforced here.
+	if !webhook.GitRefAllowed(buildCfg, branch) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference '%s' is not in the allowed refs", buildCfg.Namespace, buildCfg.Name, branch)
+		return revision, envvars, dockerStrategyOptions, false, err
+	}
 
 	return revision, envvars, dockerStrategyOptions, true, err
 }
@@ -28,12 +28,27 @@ type commit struct {
 	Author    buildapi.SourceControlUser `json:"author,omitempty"`
 	Committer buildapi.SourceControlUser `json:"committer,omitempty"`
 	Message   string                     `json:"message,omitempty"`
+	Added     []string                   `json:"added,omitempty"`
+	Removed   []string                   `json:"removed,omitempty"`
+	Modified  []string                   `json:"modified,omitempty"`
 }
 
 type pushEvent struct {
-	Ref        string `json:"ref,omitempty"`
-	After      string `json:"after,omitempty"`
-	HeadCommit commit `json:"head_commit,omitempty"`
+	Ref        string   `json:"ref,omitempty"`
+	After      string   `json:"after,omitempty"`
+	HeadCommit commit   `json:"head_commit,omitempty"`
+	Commits    []commit `json:"commits,omitempty"`
+}
+
+// changedPaths flattens the file lists of all commits in the event.
+func (event pushEvent) changedPaths() []string {
+	var paths []string
+	for _, c := range event.Commits {
+		paths = append(paths, c.Added...)
+		paths = append(paths, c.Removed...)
+		paths = append(paths, c.Modified...)
+	}
+	return paths
 }
 
 // Extract services webhooks from github.com
@@ -61,6 +76,14 @@ func (p *WebHookPlugin) Extract(buildCfg *buildapi.BuildConfig, trigger *buildap
 		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event)
 		return revision, envvars, dockerStrategyOptions, proceed, err
 	}
+	if !webhook.GitRefAllowed(buildCfg, event.Ref) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference '%s' is not in the allowed refs", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return revision, envvars, dockerStrategyOptions, proceed, err
+	}
+	if !webhook.PathsAllowed(buildCfg, event.changedPaths()) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No changed file is in the allowed paths", buildCfg.Namespace, buildCfg.Name)
+		return revision, envvars, dockerStrategyOptions, proceed, err
+	}
 
 	revision = &buildapi.SourceRevision{
 		Git: &buildapi.GitSourceRevision{
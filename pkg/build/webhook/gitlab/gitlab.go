@@ -25,9 +25,12 @@ func New() *WebHookPlugin {
 
 // NOTE - unlike github, there is no separate commiter, just the author
 type commit struct {
-	ID      string                     `json:"id,omitempty"`
-	Author  buildapi.SourceControlUser `json:"author,omitempty"`
-	Message string                     `json:"message,omitempty"`
+	ID       string                     `json:"id,omitempty"`
+	Author   buildapi.SourceControlUser `json:"author,omitempty"`
+	Message  string                     `json:"message,omitempty"`
+	Added    []string                   `json:"added,omitempty"`
+	Removed  []string                   `json:"removed,omitempty"`
+	Modified []string                   `json:"modified,omitempty"`
 }
 
 // NOTE - unlike github, the head commit is not highlighted ... only the commit array is provided,
@@ -38,6 +41,17 @@ type pushEvent struct {
 	Commits []commit `json:"commits,omitempty"`
 }
 
+// changedPaths flattens the file lists of all commits in the event.
+func (event pushEvent) changedPaths() []string {
+	var paths []string
+	for _, c := range event.Commits {
+		paths = append(paths, c.Added...)
+		paths = append(paths, c.Removed...)
+		paths = append(paths, c.Modified...)
+	}
+	return paths
+}
+
 // Extract services webhooks from GitLab server
 func (p *WebHookPlugin) Extract(buildCfg *buildapi.BuildConfig, trigger *buildapi.WebHookTrigger, req *http.Request) (revision *buildapi.SourceRevision, envvars []kapi.EnvVar, dockerStrategyOptions *buildapi.DockerStrategyOptions, proceed bool, err error) {
 	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
@@ -60,6 +74,14 @@ func (p *WebHookPlugin) Extract(buildCfg *buildapi.BuildConfig, trigger *buildap
 		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event)
 		return revision, envvars, dockerStrategyOptions, proceed, err
 	}
+	if !webhook.GitRefAllowed(buildCfg, event.Ref) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference '%s' is not in the allowed refs", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return revision, envvars, dockerStrategyOptions, proceed, err
+	}
+	if !webhook.PathsAllowed(buildCfg, event.changedPaths()) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No changed file is in the allowed paths", buildCfg.Namespace, buildCfg.Name)
+		return revision, envvars, dockerStrategyOptions, proceed, err
+	}
 
 	lastCommit := event.Commits[len(event.Commits)-1]
 
@@ -4,6 +4,7 @@ import (
 	"crypto/hmac"
 	"errors"
 	"net/http"
+	"path"
 	"strings"
 
 	"github.com/golang/glog"
@@ -51,6 +52,60 @@ func GitRefMatches(eventRef, configRef string, buildSource *buildapi.BuildSource
 	return configRef == eventRef
 }
 
+// GitRefAllowed determines if the ref from a webhook event matches the allowed-refs
+// annotation on the build configuration. Refs are matched against the comma separated
+// glob patterns from the annotation; an absent or empty annotation allows every ref.
+func GitRefAllowed(buildCfg *buildapi.BuildConfig, eventRef string) bool {
+	value, ok := buildCfg.Annotations[buildapi.WebHookAllowedRefsAnnotation]
+	if !ok || len(strings.TrimSpace(value)) == 0 {
+		return true
+	}
+	eventRef = strings.TrimPrefix(eventRef, refPrefix)
+	for _, pattern := range strings.Split(value, ",") {
+		pattern = strings.TrimPrefix(strings.TrimSpace(pattern), refPrefix)
+		if len(pattern) == 0 {
+			continue
+		}
+		if ok, err := path.Match(pattern, eventRef); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// PathsAllowed determines if the changed files from a webhook event match the allowed-paths
+// annotation on the build configuration. An absent or empty annotation allows every push,
+// as does an event that carries no file information at all (the payload cannot prove the
+// push is irrelevant).
+func PathsAllowed(buildCfg *buildapi.BuildConfig, changedPaths []string) bool {
+	value, ok := buildCfg.Annotations[buildapi.WebHookAllowedPathsAnnotation]
+	if !ok || len(strings.TrimSpace(value)) == 0 {
+		return true
+	}
+	if len(changedPaths) == 0 {
+		return true
+	}
+	var prefixes []string
+	for _, prefix := range strings.Split(value, ",") {
+		prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+		if len(prefix) > 0 {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, changed := range changedPaths {
+		changed = strings.Trim(changed, "/")
+		for _, prefix := range prefixes {
+			if changed == prefix || strings.HasPrefix(changed, prefix+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // NewWarning returns an StatusError object with a http.StatusOK (200) code.
 func NewWarning(message string) *kerrors.StatusError {
 	return &kerrors.StatusError{ErrStatus: metav1.Status{
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+)
+
+func buildConfigWithAnnotation(key, value string) *buildapi.BuildConfig {
+	return &buildapi.BuildConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "buildcfg",
+			Namespace:   "default",
+			Annotations: map[string]string{key: value},
+		},
+	}
+}
+
+func TestGitRefAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns string
+		ref      string
+		allowed  bool
+	}{
+		{
+			name:     "no annotation allows everything",
+			patterns: "",
+			ref:      "refs/heads/master",
+			allowed:  true,
+		},
+		{
+			name:     "exact match",
+			patterns: "master",
+			ref:      "refs/heads/master",
+			allowed:  true,
+		},
+		{
+			name:     "glob match",
+			patterns: "release-*",
+			ref:      "refs/heads/release-3.9",
+			allowed:  true,
+		},
+		{
+			name:     "one of several patterns",
+			patterns: "master, release-*",
+			ref:      "refs/heads/release-3.9",
+			allowed:  true,
+		},
+		{
+			name:     "no pattern matches",
+			patterns: "master,release-*",
+			ref:      "refs/heads/feature-1",
+			allowed:  false,
+		},
+		{
+			name:     "pattern with refs prefix",
+			patterns: "refs/heads/master",
+			ref:      "master",
+			allowed:  true,
+		},
+	}
+	for _, test := range tests {
+		buildCfg := buildConfigWithAnnotation(buildapi.WebHookAllowedRefsAnnotation, test.patterns)
+		if allowed := GitRefAllowed(buildCfg, test.ref); allowed != test.allowed {
+			t.Errorf("%s: expected allowed=%t, got %t", test.name, test.allowed, allowed)
+		}
+	}
+}
+
+func TestPathsAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes string
+		changed  []string
+		allowed  bool
+	}{
+		{
+			name:     "no annotation allows everything",
+			prefixes: "",
+			changed:  []string{"docs/README.md"},
+			allowed:  true,
+		},
+		{
+			name:     "no file information allows the push",
+			prefixes: "src",
+			changed:  nil,
+			allowed:  true,
+		},
+		{
+			name:     "changed file under prefix",
+			prefixes: "src",
+			changed:  []string{"docs/README.md", "src/main.go"},
+			allowed:  true,
+		},
+		{
+			name:     "changed file equals prefix",
+			prefixes: "Makefile",
+			changed:  []string{"Makefile"},
+			allowed:  true,
+		},
+		{
+			name:     "no changed file under any prefix",
+			prefixes: "src, pkg",
+			changed:  []string{"docs/README.md", "hack/build.sh"},
+			allowed:  false,
+		},
+		{
+			name:     "prefix does not match partial path segment",
+			prefixes: "src",
+			changed:  []string{"src2/main.go"},
+			allowed:  false,
+		},
+		{
+			name:     "leading slashes are ignored",
+			prefixes: "/src/",
+			changed:  []string{"src/main.go"},
+			allowed:  true,
+		},
+	}
+	for _, test := range tests {
+		buildCfg := buildConfigWithAnnotation(buildapi.WebHookAllowedPathsAnnotation, test.prefixes)
+		if allowed := PathsAllowed(buildCfg, test.changed); allowed != test.allowed {
+			t.Errorf("%s: expected allowed=%t, got %t", test.name, test.allowed, allowed)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/golang/glog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	routeclient "github.com/openshift/origin/pkg/route/generated/internalclientset"
+)
+
+// PublishAnnotation opts an image stream or route in to the public catalog.
+// Only the value "true" publishes the object.
+const PublishAnnotation = "catalog.openshift.io/publish"
+
+// Catalog is the document returned by the catalog endpoint.
+type Catalog struct {
+	// Images are the published image streams across all namespaces.
+	Images []ImageEntry `json:"images"`
+	// Routes are the published routes across all namespaces.
+	Routes []RouteEntry `json:"routes"`
+}
+
+// ImageEntry describes a published image stream.
+type ImageEntry struct {
+	Namespace   string   `json:"namespace"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// RouteEntry describes a published route.
+type RouteEntry struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Host        string `json:"host"`
+	Path        string `json:"path,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Handler serves the catalog document. It lists with its own privileged
+// clients, so callers only need access to the endpoint itself - access is
+// authorized against the endpoint's nonResourceURL, not the underlying
+// resources.
+type Handler struct {
+	imageClient imageclient.Interface
+	routeClient routeclient.Interface
+}
+
+// NewHandler returns a handler serving the catalog from the provided clients.
+func NewHandler(imageClient imageclient.Interface, routeClient routeclient.Interface) *Handler {
+	return &Handler{
+		imageClient: imageClient,
+		routeClient: routeClient,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	catalog := Catalog{Images: []ImageEntry{}, Routes: []RouteEntry{}}
+
+	streams, err := h.imageClient.Image().ImageStreams(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		glog.Errorf("catalog: unable to list image streams: %v", err)
+		http.Error(w, "unable to list image streams", http.StatusInternalServerError)
+		return
+	}
+	for i := range streams.Items {
+		if entry, ok := imageEntry(&streams.Items[i]); ok {
+			catalog.Images = append(catalog.Images, entry)
+		}
+	}
+
+	routes, err := h.routeClient.Route().Routes(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		glog.Errorf("catalog: unable to list routes: %v", err)
+		http.Error(w, "unable to list routes", http.StatusInternalServerError)
+		return
+	}
+	for i := range routes.Items {
+		if entry, ok := routeEntry(&routes.Items[i]); ok {
+			catalog.Routes = append(catalog.Routes, entry)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&catalog); err != nil {
+		glog.Errorf("catalog: unable to write response: %v", err)
+	}
+}
+
+// imageEntry converts a published image stream into its catalog entry, or
+// returns false if the stream is not published.
+func imageEntry(stream *imageapi.ImageStream) (ImageEntry, bool) {
+	if stream.Annotations[PublishAnnotation] != "true" {
+		return ImageEntry{}, false
+	}
+	entry := ImageEntry{
+		Namespace:   stream.Namespace,
+		Name:        stream.Name,
+		DisplayName: stream.Annotations[oapi.OpenShiftDisplayName],
+		Description: stream.Annotations[oapi.OpenShiftDescription],
+	}
+	for tag := range stream.Status.Tags {
+		entry.Tags = append(entry.Tags, tag)
+	}
+	sort.Strings(entry.Tags)
+	return entry, true
+}
+
+// routeEntry converts a published route into its catalog entry, or returns
+// false if the route is not published.
+func routeEntry(route *routeapi.Route) (RouteEntry, bool) {
+	if route.Annotations[PublishAnnotation] != "true" {
+		return RouteEntry{}, false
+	}
+	return RouteEntry{
+		Namespace:   route.Namespace,
+		Name:        route.Name,
+		Host:        route.Spec.Host,
+		Path:        route.Spec.Path,
+		Description: route.Annotations[oapi.OpenShiftDescription],
+	}, true
+}
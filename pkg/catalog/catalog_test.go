@@ -0,0 +1,74 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+func TestImageEntry(t *testing.T) {
+	stream := &imageapi.ImageStream{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared",
+			Name:      "ruby",
+			Annotations: map[string]string{
+				PublishAnnotation:         "true",
+				oapi.OpenShiftDisplayName: "Ruby",
+				oapi.OpenShiftDescription: "Ruby builder images",
+			},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {},
+				"2.4":    {},
+			},
+		},
+	}
+	entry, ok := imageEntry(stream)
+	if !ok {
+		t.Fatalf("expected published stream to produce an entry")
+	}
+	expected := ImageEntry{
+		Namespace:   "shared",
+		Name:        "ruby",
+		DisplayName: "Ruby",
+		Description: "Ruby builder images",
+		Tags:        []string{"2.4", "latest"},
+	}
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("unexpected entry: %#v", entry)
+	}
+
+	stream.Annotations[PublishAnnotation] = "false"
+	if _, ok := imageEntry(stream); ok {
+		t.Errorf("expected unpublished stream to be skipped")
+	}
+}
+
+func TestRouteEntry(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "frontend",
+			Name:        "web",
+			Annotations: map[string]string{PublishAnnotation: "true"},
+		},
+		Spec: routeapi.RouteSpec{Host: "www.example.com", Path: "/app"},
+	}
+	entry, ok := routeEntry(route)
+	if !ok {
+		t.Fatalf("expected published route to produce an entry")
+	}
+	if entry.Host != "www.example.com" || entry.Path != "/app" {
+		t.Errorf("unexpected entry: %#v", entry)
+	}
+
+	delete(route.Annotations, PublishAnnotation)
+	if _, ok := routeEntry(route); ok {
+		t.Errorf("expected unpublished route to be skipped")
+	}
+}
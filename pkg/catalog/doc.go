@@ -0,0 +1,4 @@
+// Package catalog serves a read-only, cluster-wide listing of image streams
+// and routes whose owners opted in to publication. It powers marketplace style
+// views without granting callers list permissions on the underlying resources.
+package catalog
@@ -235,6 +235,8 @@ func (o *F5RouterOptions) Run() error {
 		recorder = status
 		plugin = status
 	}
+	// report BIG-IP programming failures back into route status
+	f5Plugin.RejectionRecorder = recorder
 	if o.ExtendedValidation {
 		plugin = controller.NewExtendedValidator(plugin, recorder)
 	}
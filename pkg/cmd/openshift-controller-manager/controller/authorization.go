@@ -2,6 +2,7 @@ package controller
 
 import (
 	"github.com/openshift/origin/pkg/authorization/controller/defaultrolebindings"
+	"github.com/openshift/origin/pkg/authorization/controller/expiredrolebindings"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 )
 
@@ -19,3 +20,17 @@ func RunDefaultRoleBindingController(ctx ControllerContext) (bool, error) {
 
 	return true, nil
 }
+
+func RunExpiredRoleBindingsController(ctx ControllerContext) (bool, error) {
+	kubeClient, err := ctx.ClientBuilder.Client(bootstrappolicy.InfraExpiredRoleBindingsControllerServiceAccountName)
+	if err != nil {
+		return true, err
+	}
+
+	go expiredrolebindings.NewExpiredRoleBindingsController(
+		ctx.ExternalKubeInformers.Rbac().V1().RoleBindings(),
+		kubeClient.RbacV1(),
+	).Run(5, ctx.Stop)
+
+	return true, nil
+}
@@ -17,6 +17,8 @@ type BuildControllerConfig struct {
 	DockerImage           string
 	S2IImage              string
 	AdmissionPluginConfig map[string]*configapi.AdmissionPluginConfig
+	// BuildLogSinkURL is the base URL completed build logs are archived to, if set.
+	BuildLogSinkURL string
 
 	Codec runtime.Codec
 }
@@ -44,6 +46,8 @@ func (c *BuildControllerConfig) RunController(ctx ControllerContext) (bool, erro
 	externalKubeClient := ctx.ClientBuilder.ClientOrDie(bootstrappolicy.InfraBuildControllerServiceAccountName)
 	securityClient := ctx.ClientBuilder.OpenshiftInternalSecurityClientOrDie(bootstrappolicy.InfraBuildControllerServiceAccountName)
 
+	buildDefaults.Client = externalKubeClient.CoreV1()
+
 	buildInformer := ctx.BuildInformers.Build().InternalVersion().Builds()
 	buildConfigInformer := ctx.BuildInformers.Build().InternalVersion().BuildConfigs()
 	imageStreamInformer := ctx.ImageInformers.Image().InternalVersion().ImageStreams()
@@ -74,8 +78,9 @@ func (c *BuildControllerConfig) RunController(ctx ControllerContext) (bool, erro
 			// TODO: this will be set to --storage-version (the internal schema we use)
 			Codec: c.Codec,
 		},
-		BuildDefaults:  buildDefaults,
-		BuildOverrides: buildOverrides,
+		BuildDefaults:   buildDefaults,
+		BuildOverrides:  buildOverrides,
+		BuildLogSinkURL: c.BuildLogSinkURL,
 	}
 
 	go buildcontroller.NewBuildController(buildControllerParams).Run(5, ctx.Stop)
@@ -61,6 +61,10 @@ type OpenshiftControllerConfig struct {
 	ImageTriggerControllerConfig         ImageTriggerControllerConfig
 	ImageSignatureImportControllerConfig ImageSignatureImportControllerConfig
 	ImageImportControllerConfig          ImageImportControllerConfig
+	ImagePrunerControllerConfig          ImagePrunerControllerConfig
+
+	RouteStatusCleanupControllerConfig RouteStatusCleanupControllerConfig
+	AcmeControllerConfig               AcmeControllerConfig
 
 	ServiceServingCertsControllerOptions ServiceServingCertsControllerOptions
 
@@ -79,9 +83,11 @@ func (c *OpenshiftControllerConfig) GetControllerInitializers() (map[string]Init
 	ret["openshift.io/serviceaccount"] = c.ServiceAccountControllerOptions.RunController
 
 	ret["openshift.io/default-rolebindings"] = RunDefaultRoleBindingController
+	ret["openshift.io/expired-rolebindings"] = RunExpiredRoleBindingsController
 
 	ret["openshift.io/serviceaccount-pull-secrets"] = RunServiceAccountPullSecretsController
 	ret["openshift.io/origin-namespace"] = RunOriginNamespaceController
+	ret["openshift.io/project-trash"] = RunProjectTrashController
 	ret["openshift.io/service-serving-cert"] = c.ServiceServingCertsControllerOptions.RunController
 
 	ret["openshift.io/build"] = c.BuildControllerConfig.RunController
@@ -93,9 +99,13 @@ func (c *OpenshiftControllerConfig) GetControllerInitializers() (map[string]Init
 	ret["openshift.io/image-trigger"] = c.ImageTriggerControllerConfig.RunController
 	ret["openshift.io/image-import"] = c.ImageImportControllerConfig.RunController
 	ret["openshift.io/image-signature-import"] = c.ImageSignatureImportControllerConfig.RunController
+	ret["openshift.io/image-pruner"] = c.ImagePrunerControllerConfig.RunController
 
 	ret["openshift.io/templateinstance"] = RunTemplateInstanceController
 
+	ret["openshift.io/route-status-cleanup"] = c.RouteStatusCleanupControllerConfig.RunController
+	ret["openshift.io/acme"] = c.AcmeControllerConfig.RunController
+
 	ret["openshift.io/sdn"] = c.SDNControllerConfig.RunController
 	ret["openshift.io/unidling"] = c.UnidlingControllerConfig.RunController
 	ret["openshift.io/ingress-ip"] = c.IngressIPControllerConfig.RunController
@@ -130,6 +140,7 @@ func BuildOpenshiftControllerConfig(options configapi.MasterConfig) (*OpenshiftC
 		DockerImage:           imageTemplate.ExpandOrDie("docker-builder"),
 		S2IImage:              imageTemplate.ExpandOrDie("sti-builder"),
 		AdmissionPluginConfig: options.AdmissionConfig.PluginConfig,
+		BuildLogSinkURL:       options.ControllerConfig.BuildLogSink.URL,
 		Codec: annotationCodec,
 	}
 
@@ -153,6 +164,19 @@ func BuildOpenshiftControllerConfig(options configapi.MasterConfig) (*OpenshiftC
 		HasDaemonSetsEnabled:   options.DisabledFeatures.Has("triggers.image.openshift.io/daemonsets"),
 		HasStatefulSetsEnabled: options.DisabledFeatures.Has("triggers.image.openshift.io/statefulsets"),
 		HasCronJobsEnabled:     options.DisabledFeatures.Has("triggers.image.openshift.io/cronjobs"),
+		NamespaceSelector:      options.ControllerConfig.ImageTrigger.NamespaceSelector,
+		ObjectSelector:         options.ControllerConfig.ImageTrigger.ObjectSelector,
+	}
+
+	ret.RouteStatusCleanupControllerConfig = RouteStatusCleanupControllerConfig{
+		ActiveRouterNames: options.RoutingConfig.ActiveRouterNames,
+	}
+	ret.AcmeControllerConfig = AcmeControllerConfig{
+		DirectoryURL:              options.ControllerConfig.Acme.DirectoryURL,
+		AccountKeyFile:            options.ControllerConfig.Acme.AccountKeyFile,
+		ChallengeServiceNamespace: options.ControllerConfig.Acme.ChallengeServiceNamespace,
+		ChallengeServiceName:      options.ControllerConfig.Acme.ChallengeServiceName,
+		ChallengeBindAddress:      options.ControllerConfig.Acme.ChallengeBindAddress,
 	}
 	ret.ImageImportControllerConfig = ImageImportControllerConfig{
 		MaxScheduledImageImportsPerMinute:          options.ImagePolicyConfig.MaxScheduledImageImportsPerMinute,
@@ -3,8 +3,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/golang/glog"
+
 	kappsv1beta1 "k8s.io/api/apps/v1beta1"
 	kappsv1beta2 "k8s.io/api/apps/v1beta2"
 	kbatchv1 "k8s.io/api/batch/v1"
@@ -12,14 +15,19 @@ import (
 	kbatchv2alpha1 "k8s.io/api/batch/v2alpha1"
 	kapiv1 "k8s.io/api/core/v1"
 	kextensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kclientsetexternal "k8s.io/client-go/kubernetes"
 	kv1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
 
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imageprunecontroller "github.com/openshift/origin/pkg/image/controller/prune"
 	imagesignaturecontroller "github.com/openshift/origin/pkg/image/controller/signature"
 	imagetriggercontroller "github.com/openshift/origin/pkg/image/controller/trigger"
 	triggerannotations "github.com/openshift/origin/pkg/image/trigger/annotations"
@@ -33,6 +41,20 @@ type ImageTriggerControllerConfig struct {
 	HasDaemonSetsEnabled   bool
 	HasStatefulSetsEnabled bool
 	HasCronJobsEnabled     bool
+
+	// NamespaceSelector and ObjectSelector restrict which workloads this
+	// controller instance manages. Empty selectors place no restriction.
+	NamespaceSelector string
+	ObjectSelector    string
+
+	// Shards and Shard split trigger processing across controller instances by
+	// namespace hash. Shards of zero or one disables sharding.
+	Shards int
+	Shard  int
+
+	// LeaderElect runs the controller only while holding a shard specific lease so
+	// that failover between instances cannot fire duplicate triggers.
+	LeaderElect bool
 }
 
 func (c *ImageTriggerControllerConfig) RunController(ctx ControllerContext) (bool, error) {
@@ -50,6 +72,8 @@ func (c *ImageTriggerControllerConfig) RunController(ctx ControllerContext) (boo
 	}
 	kclient := ctx.ClientBuilder.ClientOrDie(bootstrappolicy.InfraImageTriggerControllerServiceAccountName)
 
+	imageClient := ctx.ClientBuilder.OpenshiftInternalImageClientOrDie(bootstrappolicy.InfraImageTriggerControllerServiceAccountName)
+
 	updater := podSpecUpdater{kclient}
 	bcInstantiator := buildclient.NewClientBuildConfigInstantiatorClient(buildClient)
 	broadcaster := imagetriggercontroller.NewTriggerEventBroadcaster(kv1core.New(kclient.CoreV1().RESTClient()))
@@ -69,7 +93,7 @@ func (c *ImageTriggerControllerConfig) RunController(ctx ControllerContext) (boo
 			Informer:  ctx.BuildInformers.Build().InternalVersion().BuildConfigs().Informer(),
 			Store:     ctx.BuildInformers.Build().InternalVersion().BuildConfigs().Informer().GetIndexer(),
 			TriggerFn: triggerbuildconfigs.NewBuildConfigTriggerIndexer,
-			Reactor:   triggerbuildconfigs.NewBuildConfigReactor(bcInstantiator, kclient.Core().RESTClient()),
+			Reactor:   triggerbuildconfigs.NewBuildConfigReactor(bcInstantiator, imageClient.Image(), kclient.Core().RESTClient()),
 		})
 	}
 	if !c.HasDeploymentsEnabled {
@@ -109,11 +133,76 @@ func (c *ImageTriggerControllerConfig) RunController(ctx ControllerContext) (boo
 		})
 	}
 
-	go imagetriggercontroller.NewTriggerController(
+	restrictions := imagetriggercontroller.TriggerRestrictions{}
+	if len(c.NamespaceSelector) > 0 {
+		selector, err := labels.Parse(c.NamespaceSelector)
+		if err != nil {
+			return true, fmt.Errorf("unable to parse image trigger namespace selector: %v", err)
+		}
+		restrictions.NamespaceSelector = selector
+		restrictions.Namespaces = ctx.ExternalKubeInformers.Core().V1().Namespaces().Lister()
+	}
+	if len(c.ObjectSelector) > 0 {
+		selector, err := labels.Parse(c.ObjectSelector)
+		if err != nil {
+			return true, fmt.Errorf("unable to parse image trigger object selector: %v", err)
+		}
+		restrictions.ObjectSelector = selector
+	}
+	if c.Shards > 1 {
+		if c.Shard < 0 || c.Shard >= c.Shards {
+			return true, fmt.Errorf("image trigger shard index %d must be between 0 and %d", c.Shard, c.Shards-1)
+		}
+		restrictions.Shards = c.Shards
+		restrictions.Shard = c.Shard
+	}
+
+	controller := imagetriggercontroller.NewTriggerController(
 		broadcaster,
 		informer,
+		restrictions,
 		sources...,
-	).Run(5, ctx.Stop)
+	)
+
+	if !c.LeaderElect {
+		go controller.Run(5, ctx.Stop)
+		return true, nil
+	}
+
+	id, err := os.Hostname()
+	if err != nil {
+		return true, err
+	}
+	lockName := "openshift-image-trigger-controller"
+	if c.Shards > 1 {
+		// each shard is an independent controller and holds its own lease
+		lockName = fmt.Sprintf("%s-shard-%d", lockName, c.Shard)
+	}
+	rl, err := resourcelock.New(resourcelock.ConfigMapsResourceLock,
+		"kube-system",
+		lockName,
+		kclient.CoreV1(),
+		resourcelock.ResourceLockConfig{
+			Identity:      id,
+			EventRecorder: broadcaster.NewRecorder(legacyscheme.Scheme, kapiv1.EventSource{Component: lockName}),
+		})
+	if err != nil {
+		return true, err
+	}
+	go leaderelection.RunOrDie(leaderelection.LeaderElectionConfig{
+		Lock:          rl,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   3 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(stopCh <-chan struct{}) {
+				controller.Run(5, stopCh)
+			},
+			OnStoppedLeading: func() {
+				glog.Fatalf("image trigger controller lost leader lease")
+			},
+		},
+	})
 
 	return true, nil
 }
@@ -214,3 +303,16 @@ func (c *ImageImportControllerConfig) RunController(ctx ControllerContext) (bool
 
 	return true, nil
 }
+
+type ImagePrunerControllerConfig struct{}
+
+func (c *ImagePrunerControllerConfig) RunController(ctx ControllerContext) (bool, error) {
+	controller := imageprunecontroller.NewImagePrunerController(
+		ctx.ClientBuilder.KubeInternalClientOrDie(bootstrappolicy.InfraImagePrunerControllerServiceAccountName),
+		ctx.ClientBuilder.OpenshiftInternalImageClientOrDie(bootstrappolicy.InfraImagePrunerControllerServiceAccountName),
+		ctx.ClientBuilder.OpenshiftInternalAppsClientOrDie(bootstrappolicy.InfraImagePrunerControllerServiceAccountName),
+		ctx.ClientBuilder.OpenshiftInternalBuildClientOrDie(bootstrappolicy.InfraImagePrunerControllerServiceAccountName),
+	)
+	go controller.Run(ctx.Stop)
+	return true, nil
+}
@@ -22,6 +22,7 @@ import (
 	networkclientinternal "github.com/openshift/origin/pkg/network/generated/internalclientset"
 	quotainformer "github.com/openshift/origin/pkg/quota/generated/informers/internalversion"
 	quotaclient "github.com/openshift/origin/pkg/quota/generated/internalclientset"
+	routeclientinternal "github.com/openshift/origin/pkg/route/generated/internalclientset"
 	securityinformer "github.com/openshift/origin/pkg/security/generated/informers/internalversion"
 	securityclient "github.com/openshift/origin/pkg/security/generated/internalclientset"
 	templateinformer "github.com/openshift/origin/pkg/template/generated/informers/internalversion"
@@ -111,6 +112,9 @@ type ControllerClientBuilder interface {
 	OpenshiftInternalNetworkClient(name string) (networkclientinternal.Interface, error)
 	OpenshiftInternalNetworkClientOrDie(name string) networkclientinternal.Interface
 
+	OpenshiftInternalRouteClient(name string) (routeclientinternal.Interface, error)
+	OpenshiftInternalRouteClientOrDie(name string) routeclientinternal.Interface
+
 	OpenshiftInternalSecurityClient(name string) (securityclient.Interface, error)
 	OpenshiftInternalSecurityClientOrDie(name string) securityclient.Interface
 }
@@ -269,6 +273,28 @@ func (b OpenshiftControllerClientBuilder) OpenshiftInternalNetworkClientOrDie(na
 	return client
 }
 
+// OpenshiftInternalRouteClient provides a REST client for the route API.
+// If the client cannot be created because of configuration error, this function
+// will error.
+func (b OpenshiftControllerClientBuilder) OpenshiftInternalRouteClient(name string) (routeclientinternal.Interface, error) {
+	clientConfig, err := b.Config(name)
+	if err != nil {
+		return nil, err
+	}
+	return routeclientinternal.NewForConfig(clientConfig)
+}
+
+// OpenshiftInternalRouteClientOrDie provides a REST client for the route API.
+// If the client cannot be created because of configuration error, this function
+// will panic.
+func (b OpenshiftControllerClientBuilder) OpenshiftInternalRouteClientOrDie(name string) routeclientinternal.Interface {
+	client, err := b.OpenshiftInternalRouteClient(name)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	return client
+}
+
 // OpenshiftInternalSecurityClient provides a REST client for the security API.
 // If the client cannot be created because of configuration error, this function
 // will error.
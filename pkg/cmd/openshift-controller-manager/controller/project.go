@@ -13,3 +13,12 @@ func RunOriginNamespaceController(ctx ControllerContext) (bool, error) {
 	go controller.Run(ctx.Stop, 5)
 	return true, nil
 }
+
+func RunProjectTrashController(ctx ControllerContext) (bool, error) {
+	controller := projectcontroller.NewProjectTrashController(
+		ctx.ExternalKubeInformers.Core().V1().Namespaces(),
+		ctx.ClientBuilder.ClientOrDie(bootstrappolicy.InfraProjectTrashControllerServiceAccountName),
+	)
+	go controller.Run(ctx.Stop, 5)
+	return true, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	"github.com/openshift/origin/pkg/quota/controller/clusterquotamapping"
 	"github.com/openshift/origin/pkg/quota/controller/clusterquotareconciliation"
+	"github.com/openshift/origin/pkg/quota/count"
 	"github.com/openshift/origin/pkg/quota/image"
 	"k8s.io/kubernetes/pkg/controller"
 	kresourcequota "k8s.io/kubernetes/pkg/controller/resourcequota"
@@ -27,6 +28,9 @@ func RunResourceQuotaManager(ctx ControllerContext) (bool, error) {
 		ctx.ImageInformers.Image().InternalVersion().ImageStreams(),
 		ctx.ClientBuilder.OpenshiftInternalImageClientOrDie(saName).Image())
 	resourceQuotaRegistry := generic.NewRegistry(imageEvaluators)
+	for _, evaluator := range count.NewReplenishmentEvaluators(listerFuncForResource) {
+		resourceQuotaRegistry.Add(evaluator)
+	}
 
 	resourceQuotaControllerOptions := &kresourcequota.ResourceQuotaControllerOptions{
 		QuotaClient:               ctx.ClientBuilder.ClientOrDie(saName).Core(),
@@ -72,6 +76,9 @@ func (c *ClusterQuotaReconciliationControllerConfig) RunController(ctx Controlle
 	for i := range imageEvaluators {
 		resourceQuotaRegistry.Add(imageEvaluators[i])
 	}
+	for _, evaluator := range count.NewReplenishmentEvaluators(listerFuncForResource) {
+		resourceQuotaRegistry.Add(evaluator)
+	}
 
 	options := clusterquotareconciliation.ClusterQuotaReconcilationControllerOptions{
 		ClusterQuotaInformer: ctx.QuotaInformers.Quota().InternalVersion().ClusterResourceQuotas(),
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/route/controller/acme"
+	routestatus "github.com/openshift/origin/pkg/route/controller/status"
+)
+
+type RouteStatusCleanupControllerConfig struct {
+	// ActiveRouterNames is the set of router (shard) names whose status
+	// ingress entries are kept. Entries from any other router are pruned.
+	ActiveRouterNames []string
+}
+
+func (c *RouteStatusCleanupControllerConfig) RunController(ctx ControllerContext) (bool, error) {
+	if len(c.ActiveRouterNames) == 0 {
+		// without a list of live routers nothing can be pruned safely.
+		return false, nil
+	}
+
+	routeClient, err := ctx.ClientBuilder.OpenshiftInternalRouteClient(bootstrappolicy.InfraRouteStatusCleanupControllerServiceAccountName)
+	if err != nil {
+		return true, err
+	}
+
+	go routestatus.NewStatusCleanupController(
+		routeClient.Route(),
+		routeClient.Route().Routes(metav1.NamespaceAll),
+		c.ActiveRouterNames,
+		10*time.Minute,
+	).Run(1, ctx.Stop)
+
+	return true, nil
+}
+
+type AcmeControllerConfig struct {
+	// DirectoryURL is the ACME directory endpoint; empty disables the controller.
+	DirectoryURL string
+	// AccountKeyFile is a PEM encoded RSA private key identifying the ACME account.
+	AccountKeyFile string
+	// ChallengeServiceNamespace and ChallengeServiceName locate the service the
+	// challenge routes point at.
+	ChallengeServiceNamespace string
+	ChallengeServiceName      string
+	// ChallengeBindAddress is the address the challenge responder listens on.
+	ChallengeBindAddress string
+}
+
+func (c *AcmeControllerConfig) RunController(ctx ControllerContext) (bool, error) {
+	if len(c.DirectoryURL) == 0 {
+		return false, nil
+	}
+	if len(c.AccountKeyFile) == 0 || len(c.ChallengeServiceNamespace) == 0 || len(c.ChallengeServiceName) == 0 || len(c.ChallengeBindAddress) == 0 {
+		return true, fmt.Errorf("the ACME controller requires an account key file, a challenge service and a challenge bind address")
+	}
+
+	keyPEM, err := ioutil.ReadFile(c.AccountKeyFile)
+	if err != nil {
+		return true, fmt.Errorf("unable to read ACME account key file: %v", err)
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return true, fmt.Errorf("no private key found in ACME account key file %s", c.AccountKeyFile)
+	}
+	accountKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return true, fmt.Errorf("unable to parse ACME account key file %s: %v", c.AccountKeyFile, err)
+	}
+
+	routeClient, err := ctx.ClientBuilder.OpenshiftInternalRouteClient(bootstrappolicy.InfraAcmeControllerServiceAccountName)
+	if err != nil {
+		return true, err
+	}
+
+	responder := acme.NewChallengeResponder()
+	go func() {
+		if err := http.ListenAndServe(c.ChallengeBindAddress, responder); err != nil {
+			utilruntime.HandleError(fmt.Errorf("ACME challenge responder failed: %v", err))
+		}
+	}()
+
+	go acme.NewAcmeController(
+		routeClient.Route(),
+		routeClient.Route().Routes(metav1.NamespaceAll),
+		acme.NewIssuer(c.DirectoryURL, accountKey),
+		acme.NewRouteSolver(routeClient.Route(), c.ChallengeServiceNamespace, c.ChallengeServiceName, responder),
+		30*time.Minute,
+	).Run(1, ctx.Stop)
+
+	return true, nil
+}
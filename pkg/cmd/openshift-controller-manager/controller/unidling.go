@@ -24,13 +24,16 @@ func (c *UnidlingControllerConfig) RunController(ctx ControllerContext) (bool, e
 	scaleNamespacer := appsv1client.NewDelegatingScaleNamespacer(appsClient,
 		ctx.ClientBuilder.ClientOrDie(bootstrappolicy.InfraUnidlingControllerServiceAccountName).ExtensionsV1beta1())
 
-	coreClient := ctx.ClientBuilder.KubeInternalClientOrDie(bootstrappolicy.InfraUnidlingControllerServiceAccountName).Core()
+	kubeInternalClient := ctx.ClientBuilder.KubeInternalClientOrDie(bootstrappolicy.InfraUnidlingControllerServiceAccountName)
+	coreClient := kubeInternalClient.Core()
 	controller := unidlingcontroller.NewUnidlingController(
 		scaleNamespacer,
 		coreClient,
 		coreClient,
 		appsclient.NewForConfigOrDie(ctx.ClientBuilder.ConfigOrDie(bootstrappolicy.InfraUnidlingControllerServiceAccountName)),
 		coreClient,
+		kubeInternalClient.Extensions(),
+		kubeInternalClient.Apps(),
 		c.ResyncPeriod,
 	)
 
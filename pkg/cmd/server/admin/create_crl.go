@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const CreateCRLCommandName = "create-crl"
+
+type CreateCRLOptions struct {
+	SignerCertOptions *SignerCertOptions
+
+	CRLFile     string
+	ExpireDays  int
+	Serials     []string
+	RevokeCerts []string
+	Output      io.Writer
+}
+
+func BindCreateCRLOptions(options *CreateCRLOptions, flags *pflag.FlagSet, prefix string) {
+	flags.StringVar(&options.CRLFile, prefix+"crl", "openshift.local.config/master/ca.crl", "The file to write the certificate revocation list to.")
+	flags.StringSliceVar(&options.Serials, prefix+"revoke-serial", options.Serials, "Serial number (hexadecimal) of a certificate to revoke. May be specified multiple times.")
+	flags.StringSliceVar(&options.RevokeCerts, prefix+"revoke-cert", options.RevokeCerts, "Path to a certificate file to revoke. May be specified multiple times.")
+	flags.IntVar(&options.ExpireDays, "expire-days", options.ExpireDays, "Validity of the revocation list in days.")
+
+	// autocompletion hints
+	cobra.MarkFlagFilename(flags, prefix+"crl")
+	cobra.MarkFlagFilename(flags, prefix+"revoke-cert")
+}
+
+var createCRLLong = templates.LongDesc(`
+	Create a certificate revocation list signed by a CA
+
+	Certificates can be revoked either by serial number or by passing the certificate
+	files themselves. The resulting PEM encoded CRL can be served alongside the CA
+	bundle so that the router, registry and other clients reject revoked certificates.`)
+
+func NewCommandCreateCRL(commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &CreateCRLOptions{
+		SignerCertOptions: NewDefaultSignerCertOptions(),
+		ExpireDays:        30,
+		Output:            out,
+	}
+
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Short: "Create a certificate revocation list signed by a CA",
+		Long:  createCRLLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Validate(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(cmd, err.Error()))
+			}
+
+			if err := options.CreateCRL(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	BindCreateCRLOptions(options, cmd.Flags(), "")
+	BindSignerCertOptions(options.SignerCertOptions, cmd.Flags(), "")
+
+	return cmd
+}
+
+func (o CreateCRLOptions) Validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are supported")
+	}
+	if len(o.CRLFile) == 0 {
+		return errors.New("crl must be provided")
+	}
+	if o.ExpireDays <= 0 {
+		return errors.New("expire-days must be valid number of days")
+	}
+	if o.SignerCertOptions == nil {
+		return errors.New("signer options are required")
+	}
+	if err := o.SignerCertOptions.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o CreateCRLOptions) CreateCRL() error {
+	glog.V(4).Infof("Creating a CRL with: %#v", o)
+	ca, err := o.SignerCertOptions.CA()
+	if err != nil {
+		return err
+	}
+
+	revoked, err := o.revokedCertificates()
+	if err != nil {
+		return err
+	}
+
+	crl, err := ca.MakeCRL(revoked, o.ExpireDays)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(o.CRLFile, crl, 0644); err != nil {
+		return err
+	}
+	glog.V(3).Infof("Generated CRL with %d revoked certificates in %s\n", len(revoked), o.CRLFile)
+	fmt.Fprintf(o.Output, "Wrote revocation list with %d revoked certificates to %s\n", len(revoked), o.CRLFile)
+	return nil
+}
+
+// revokedCertificates builds the revocation entries from the serial numbers and
+// certificate files given on the command line.
+func (o CreateCRLOptions) revokedCertificates() ([]pkix.RevokedCertificate, error) {
+	now := time.Now()
+	revoked := []pkix.RevokedCertificate{}
+	for _, serial := range o.Serials {
+		serialNumber, ok := new(big.Int).SetString(strings.TrimSpace(serial), 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number %q: must be hexadecimal", serial)
+		}
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: serialNumber, RevocationTime: now})
+	}
+	for _, certFile := range o.RevokeCerts {
+		certData, err := ioutil.ReadFile(certFile)
+		if err != nil {
+			return nil, err
+		}
+		certs, err := crypto.CertsFromPEM(certData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate file %q: %v", certFile, err)
+		}
+		for _, cert := range certs {
+			revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: cert.SerialNumber, RevocationTime: now})
+		}
+	}
+	return revoked, nil
+}
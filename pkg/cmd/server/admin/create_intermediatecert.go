@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"errors"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const CreateIntermediateSignerCertCommandName = "create-intermediate-signer-cert"
+
+type CreateIntermediateSignerCertOptions struct {
+	SignerCertOptions *SignerCertOptions
+
+	CertFile   string
+	KeyFile    string
+	SerialFile string
+	ExpireDays int
+	Name       string
+	Output     io.Writer
+
+	Overwrite bool
+}
+
+func BindCreateIntermediateSignerCertOptions(options *CreateIntermediateSignerCertOptions, flags *pflag.FlagSet, prefix string) {
+	flags.StringVar(&options.CertFile, prefix+"cert", "openshift.local.config/master/intermediate-ca.crt", "The certificate file. Contains the intermediate certificate followed by the signer chain, usable directly as a CA bundle.")
+	flags.StringVar(&options.KeyFile, prefix+"key", "openshift.local.config/master/intermediate-ca.key", "The key file.")
+	flags.StringVar(&options.SerialFile, prefix+"serial", "openshift.local.config/master/intermediate-ca.serial.txt", "The serial file that keeps track of how many certs have been signed.")
+	flags.StringVar(&options.Name, prefix+"name", DefaultSignerName(), "The name of the intermediate signer.")
+	flags.BoolVar(&options.Overwrite, prefix+"overwrite", options.Overwrite, "Overwrite existing cert files if found.  If false, any existing file will be left as-is.")
+
+	flags.IntVar(&options.ExpireDays, "expire-days", options.ExpireDays, "Validity of the certificate in days (defaults to 5 years). WARNING: extending this above default value is highly discouraged.")
+
+	// set dynamic value annotation - allows man pages  to be generated and verified
+	flags.SetAnnotation(prefix+"name", "manpage-def-value", []string{"openshift-signer@<current_timestamp>"})
+
+	// autocompletion hints
+	cobra.MarkFlagFilename(flags, prefix+"cert")
+	cobra.MarkFlagFilename(flags, prefix+"key")
+	cobra.MarkFlagFilename(flags, prefix+"serial")
+}
+
+var createIntermediateSignerLong = templates.LongDesc(`
+	Create an intermediate CA key/cert signed by an existing CA, for signing certificates
+	used by server components.
+
+	The generated certificate file contains the intermediate certificate followed by the
+	signing CA's chain, so it can be used directly as a CA bundle by the router and the
+	registry. The intermediate CA can then be used with the other certificate commands via
+	the --signer-cert, --signer-key and --signer-serial flags.`)
+
+func NewCommandCreateIntermediateSignerCert(commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &CreateIntermediateSignerCertOptions{
+		SignerCertOptions: NewDefaultSignerCertOptions(),
+		ExpireDays:        crypto.DefaultCACertificateLifetimeInDays,
+		Output:            out,
+		Overwrite:         true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Short: "Create an intermediate signer (certificate authority/CA) certificate and key",
+		Long:  createIntermediateSignerLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Validate(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(cmd, err.Error()))
+			}
+
+			if _, err := options.CreateIntermediateSignerCert(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	BindCreateIntermediateSignerCertOptions(options, cmd.Flags(), "")
+	BindSignerCertOptions(options.SignerCertOptions, cmd.Flags(), "")
+
+	return cmd
+}
+
+func (o CreateIntermediateSignerCertOptions) Validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are supported")
+	}
+	if len(o.CertFile) == 0 {
+		return errors.New("cert must be provided")
+	}
+	if len(o.KeyFile) == 0 {
+		return errors.New("key must be provided")
+	}
+	if o.ExpireDays <= 0 {
+		return errors.New("expire-days must be valid number of days")
+	}
+	if len(o.Name) == 0 {
+		return errors.New("name must be provided")
+	}
+	if o.SignerCertOptions == nil {
+		return errors.New("signer options are required")
+	}
+	if err := o.SignerCertOptions.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o CreateIntermediateSignerCertOptions) CreateIntermediateSignerCert() (*crypto.CA, error) {
+	glog.V(4).Infof("Creating an intermediate signer cert with: %#v", o)
+	signer, err := o.SignerCertOptions.CA()
+	if err != nil {
+		return nil, err
+	}
+
+	var ca *crypto.CA
+	written := true
+	if o.Overwrite {
+		ca, err = crypto.MakeIntermediateCA(o.CertFile, o.KeyFile, o.SerialFile, o.Name, o.ExpireDays, signer)
+	} else {
+		ca, written, err = crypto.EnsureIntermediateCA(o.CertFile, o.KeyFile, o.SerialFile, o.Name, o.ExpireDays, signer)
+	}
+	if written {
+		glog.V(3).Infof("Generated new intermediate CA for %s: cert in %s and key in %s\n", o.Name, o.CertFile, o.KeyFile)
+	} else {
+		glog.V(3).Infof("Keeping existing intermediate CA cert at %s and key at %s\n", o.CertFile, o.KeyFile)
+	}
+	return ca, err
+}
@@ -522,6 +522,38 @@ type AuditConfig struct {
 	WebHookKubeConfig string
 	// Strategy for sending audit events (block or batch).
 	WebHookMode WebHookModeType
+
+	// Rules maps users, groups, or namespaces to audit levels. Rules are evaluated in order
+	// before any rules from PolicyFile or PolicyConfiguration, the first matching rule wins.
+	Rules []AuditPolicyRule
+}
+
+// AuditRuleLevel defines the amount of information logged for requests matching an audit rule
+type AuditRuleLevel string
+
+const (
+	// AuditRuleLevelNone disables logging of matching requests.
+	AuditRuleLevelNone AuditRuleLevel = "None"
+	// AuditRuleLevelMetadata logs request metadata (user, timestamp, resource, verb, etc.) but no bodies.
+	AuditRuleLevelMetadata AuditRuleLevel = "Metadata"
+	// AuditRuleLevelRequest logs metadata and the request body.
+	AuditRuleLevelRequest AuditRuleLevel = "Request"
+	// AuditRuleLevelRequestResponse logs metadata and both request and response bodies.
+	AuditRuleLevelRequestResponse AuditRuleLevel = "RequestResponse"
+)
+
+// AuditPolicyRule applies an audit level to requests from specific users or groups,
+// or to requests against specific namespaces
+type AuditPolicyRule struct {
+	// Level is the audit level to apply to matching requests
+	Level AuditRuleLevel
+	// Users is a list of usernames this rule applies to. An empty list matches all users.
+	Users []string
+	// Groups is a list of group names this rule applies to. An empty list matches all groups.
+	Groups []string
+	// Namespaces is a list of namespaces this rule applies to. An empty list matches
+	// requests in all namespaces, including cluster scoped requests.
+	Namespaces []string
 }
 
 // JenkinsPipelineConfig holds configuration for the Jenkins pipeline strategy
@@ -540,6 +572,24 @@ type JenkinsPipelineConfig struct {
 	ServiceName string
 	// Parameters specifies a set of optional parameters to the Jenkins template.
 	Parameters map[string]string
+	// TemplateProfiles maps profile names (for example ephemeral, persistent-small or
+	// persistent-large) to alternate Jenkins templates. A project may select the profile
+	// for its auto-provisioned Jenkins with the pipeline.build.openshift.io/jenkins-profile
+	// namespace annotation.
+	TemplateProfiles map[string]JenkinsPipelineTemplateProfile
+}
+
+// JenkinsPipelineTemplateProfile describes an alternate Jenkins template used when a
+// project selects the profile by annotation.
+type JenkinsPipelineTemplateProfile struct {
+	// TemplateNamespace contains the namespace name where the profile template is stored.
+	// Defaults to the configured TemplateNamespace.
+	TemplateNamespace string
+	// TemplateName is the name of the Jenkins template for this profile
+	TemplateName string
+	// Parameters specifies a set of optional parameters applied on top of the configured
+	// Parameters, for example VOLUME_CAPACITY.
+	Parameters map[string]string
 }
 
 type ImagePolicyConfig struct {
@@ -601,13 +651,37 @@ type ProjectConfig struct {
 	// If it is not specified, a default template is used.
 	ProjectRequestTemplate string
 
+	// ProjectRequestTemplateSelectors is an ordered list of rules that select the project request
+	// template based on the requesting user's groups. The first rule that matches one of the user's
+	// groups wins; if no rule matches, ProjectRequestTemplate is used.
+	ProjectRequestTemplateSelectors []ProjectRequestTemplateSelector
+
+	// ProjectTrashGracePeriodMinutes is the number of minutes a deleted project is held in a
+	// restorable trash state before the namespace is finally removed. While trashed, creation of
+	// new content in the project is forbidden and removing the delete-after annotation restores
+	// the project. If zero, projects are deleted immediately.
+	ProjectTrashGracePeriodMinutes int
+
 	// SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.
 	SecurityAllocator *SecurityAllocator
 }
 
+// ProjectRequestTemplateSelector selects a project request template for users that belong to any of
+// the listed groups.
+type ProjectRequestTemplateSelector struct {
+	// Groups is the list of groups the rule applies to. A user matching any entry matches the rule.
+	Groups []string
+	// Template is the template to use for matching users, in the format namespace/template.
+	Template string
+}
+
 type RoutingConfig struct {
 	// Subdomain is the suffix appended to $service.$namespace. to form the default route hostname
 	Subdomain string
+	// ActiveRouterNames is the set of router (shard) names currently deployed in the
+	// cluster. When non-empty, the route status cleanup controller removes status
+	// ingress entries written by routers not in this list.
+	ActiveRouterNames []string
 }
 
 type SecurityAllocator struct {
@@ -1162,6 +1236,14 @@ type OpenIDIdentityProvider struct {
 
 	// Claims mappings
 	Claims OpenIDClaims
+
+	// GroupsPrefix is prepended to group names from the groups claims when
+	// synchronizing groups at login
+	GroupsPrefix string
+
+	// PruneGroups removes the user from previously synchronized groups that are
+	// no longer asserted by the groups claims
+	PruneGroups bool
 }
 
 type OpenIDURLs struct {
@@ -1188,6 +1270,9 @@ type OpenIDClaims struct {
 	// Email is the list of claims whose values should be used as the email address. Optional.
 	// If unspecified, no email is set for the identity
 	Email []string
+	// Groups is the list of claims whose values should be used as the group names. Optional.
+	// If unspecified, no groups are synchronized for the identity
+	Groups []string
 }
 
 type GrantConfig struct {
@@ -1501,6 +1586,56 @@ type ControllerConfig struct {
 	// ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for
 	// pods fulfilling a service to serve with.
 	ServiceServingCert ServiceServingCert
+	// ImageTrigger holds configuration restricting which workloads the image trigger
+	// controller manages.
+	ImageTrigger ImageTriggerConfig
+	// BuildLogSink holds configuration for archiving completed build logs to an
+	// external sink so they remain retrievable after the build pod is deleted.
+	BuildLogSink BuildLogSinkConfig
+	// Acme holds configuration for the ACME certificate controller which issues
+	// and renews certificates for annotated edge routes.
+	Acme AcmeConfig
+}
+
+// ImageTriggerConfig restricts the set of workloads the image trigger controller
+// manages so trigger processing can be sharded across controller instances or
+// system namespaces can be excluded. Empty selectors place no restriction.
+type ImageTriggerConfig struct {
+	// NamespaceSelector is a label selector matched against namespaces. Image
+	// triggers on objects in namespaces that do not match the selector are
+	// ignored by this controller instance.
+	NamespaceSelector string
+	// ObjectSelector is a label selector matched against the triggered objects
+	// themselves. Objects that do not match the selector are ignored by this
+	// controller instance.
+	ObjectSelector string
+}
+
+// BuildLogSinkConfig configures archival of completed build logs to an external
+// HTTP sink. S3 and Swift object stores are addressed through their HTTP APIs
+// using pre-signed or temp-url style base URLs. An empty URL disables archival.
+type BuildLogSinkConfig struct {
+	// URL is the base URL completed build logs are uploaded to. The build namespace
+	// and name are appended as path segments.
+	URL string
+}
+
+// AcmeConfig configures the ACME certificate controller for edge routes. An
+// empty directory URL disables the controller.
+type AcmeConfig struct {
+	// DirectoryURL is the ACME v2 directory endpoint certificates are requested
+	// from.
+	DirectoryURL string
+	// AccountKeyFile is the path to a PEM encoded RSA private key identifying
+	// the ACME account.
+	AccountKeyFile string
+	// ChallengeServiceNamespace and ChallengeServiceName locate the service the
+	// router forwards HTTP-01 challenge requests to. The service must front the
+	// controller's challenge responder.
+	ChallengeServiceNamespace string
+	ChallengeServiceName      string
+	// ChallengeBindAddress is the host:port the challenge responder listens on.
+	ChallengeBindAddress string
 }
 
 // ControllerElectionConfig contains configuration values for deciding how a controller
@@ -65,12 +65,25 @@ var map_AuditConfig = map[string]string{
 	"logFormat":                "Format of saved audits (legacy or json).",
 	"webHookKubeConfig":        "Path to a .kubeconfig formatted file that defines the audit webhook configuration.",
 	"webHookMode":              "Strategy for sending audit events (block or batch).",
+	"rules":                    "Rules maps users, groups, or namespaces to audit levels. Rules are evaluated in order before any rules from PolicyFile or PolicyConfiguration, the first matching rule wins.",
 }
 
 func (AuditConfig) SwaggerDoc() map[string]string {
 	return map_AuditConfig
 }
 
+var map_AuditPolicyRule = map[string]string{
+	"":           "AuditPolicyRule applies an audit level to requests from specific users or groups, or to requests against specific namespaces",
+	"level":      "Level is the audit level to apply to matching requests",
+	"users":      "Users is a list of usernames this rule applies to. An empty list matches all users.",
+	"groups":     "Groups is a list of group names this rule applies to. An empty list matches all groups.",
+	"namespaces": "Namespaces is a list of namespaces this rule applies to. An empty list matches requests in all namespaces, including cluster scoped requests.",
+}
+
+func (AuditPolicyRule) SwaggerDoc() map[string]string {
+	return map_AuditPolicyRule
+}
+
 var map_AugmentedActiveDirectoryConfig = map[string]string{
 	"":                          "AugmentedActiveDirectoryConfig holds the necessary configuration options to define how an LDAP group sync interacts with an LDAP server using the augmented Active Directory schema",
 	"usersQuery":                "AllUsersQuery holds the template for an LDAP query that returns user entries.",
@@ -129,12 +142,46 @@ var map_ControllerConfig = map[string]string{
 	"":                   "ControllerConfig holds configuration values for controllers",
 	"election":           "Election defines the configuration for electing a controller instance to make changes to the cluster. If unspecified, the ControllerTTL value is checked to determine whether the legacy direct etcd election code will be used.",
 	"serviceServingCert": "ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for pods fulfilling a service to serve with.",
+	"imageTrigger":       "ImageTrigger holds configuration restricting which workloads the image trigger controller manages.",
+	"buildLogSink":       "BuildLogSink holds configuration for archiving completed build logs to an external sink so they remain retrievable after the build pod is deleted.",
+	"acme":               "Acme holds configuration for the ACME certificate controller which issues and renews certificates for annotated edge routes.",
 }
 
 func (ControllerConfig) SwaggerDoc() map[string]string {
 	return map_ControllerConfig
 }
 
+var map_ImageTriggerConfig = map[string]string{
+	"":                  "ImageTriggerConfig restricts the set of workloads the image trigger controller manages so trigger processing can be sharded across controller instances or system namespaces can be excluded. Empty selectors place no restriction.",
+	"namespaceSelector": "NamespaceSelector is a label selector matched against namespaces. Image triggers on objects in namespaces that do not match the selector are ignored by this controller instance.",
+	"objectSelector":    "ObjectSelector is a label selector matched against the triggered objects themselves. Objects that do not match the selector are ignored by this controller instance.",
+}
+
+func (ImageTriggerConfig) SwaggerDoc() map[string]string {
+	return map_ImageTriggerConfig
+}
+
+var map_BuildLogSinkConfig = map[string]string{
+	"":    "BuildLogSinkConfig configures archival of completed build logs to an external HTTP sink. S3 and Swift object stores are addressed through their HTTP APIs using pre-signed or temp-url style base URLs. An empty URL disables archival.",
+	"url": "URL is the base URL completed build logs are uploaded to. The build namespace and name are appended as path segments.",
+}
+
+func (BuildLogSinkConfig) SwaggerDoc() map[string]string {
+	return map_BuildLogSinkConfig
+}
+
+var map_AcmeConfig = map[string]string{
+	"":                          "AcmeConfig configures the ACME certificate controller for edge routes. An empty directory URL disables the controller.",
+	"directoryURL":              "DirectoryURL is the ACME v2 directory endpoint certificates are requested from.",
+	"accountKeyFile":            "AccountKeyFile is the path to a PEM encoded RSA private key identifying the ACME account.",
+	"challengeServiceNamespace": "ChallengeServiceNamespace and ChallengeServiceName locate the service the router forwards HTTP-01 challenge requests to. The service must front the controller's challenge responder.",
+	"challengeBindAddress":      "ChallengeBindAddress is the host:port the challenge responder listens on.",
+}
+
+func (AcmeConfig) SwaggerDoc() map[string]string {
+	return map_AcmeConfig
+}
+
 var map_ControllerElectionConfig = map[string]string{
 	"":              "ControllerElectionConfig contains configuration values for deciding how a controller will be elected to act as leader.",
 	"lockName":      "LockName is the resource name used to act as the lock for determining which controller instance should lead.",
@@ -339,12 +386,24 @@ var map_JenkinsPipelineConfig = map[string]string{
 	"templateName":         "TemplateName is the name of the default Jenkins template",
 	"serviceName":          "ServiceName is the name of the Jenkins service OpenShift uses to detect whether a Jenkins pipeline handler has already been installed in a project. This value *must* match a service name in the provided template.",
 	"parameters":           "Parameters specifies a set of optional parameters to the Jenkins template.",
+	"templateProfiles":     "TemplateProfiles maps profile names (for example ephemeral, persistent-small or persistent-large) to alternate Jenkins templates. A project may select the profile for its auto-provisioned Jenkins with the pipeline.build.openshift.io/jenkins-profile namespace annotation.",
 }
 
 func (JenkinsPipelineConfig) SwaggerDoc() map[string]string {
 	return map_JenkinsPipelineConfig
 }
 
+var map_JenkinsPipelineTemplateProfile = map[string]string{
+	"":                  "JenkinsPipelineTemplateProfile describes an alternate Jenkins template used when a project selects the profile by annotation.",
+	"templateNamespace": "TemplateNamespace contains the namespace name where the profile template is stored. Defaults to the configured TemplateNamespace.",
+	"templateName":      "TemplateName is the name of the Jenkins template for this profile",
+	"parameters":        "Parameters specifies a set of optional parameters applied on top of the configured Parameters, for example VOLUME_CAPACITY.",
+}
+
+func (JenkinsPipelineTemplateProfile) SwaggerDoc() map[string]string {
+	return map_JenkinsPipelineTemplateProfile
+}
+
 var map_KeystonePasswordIdentityProvider = map[string]string{
 	"":           "KeystonePasswordIdentityProvider provides identities for users authenticating using keystone password credentials",
 	"domainName": "Domain Name is required for keystone v3",
@@ -643,6 +702,7 @@ var map_OpenIDClaims = map[string]string{
 	"preferredUsername": "PreferredUsername is the list of claims whose values should be used as the preferred username. If unspecified, the preferred username is determined from the value of the id claim",
 	"name":              "Name is the list of claims whose values should be used as the display name. Optional. If unspecified, no display name is set for the identity",
 	"email":             "Email is the list of claims whose values should be used as the email address. Optional. If unspecified, no email is set for the identity",
+	"groups":            "Groups is the list of claims whose values should be used as the group names. Optional. If unspecified, no groups are synchronized for the identity",
 }
 
 func (OpenIDClaims) SwaggerDoc() map[string]string {
@@ -656,8 +716,10 @@ var map_OpenIDIdentityProvider = map[string]string{
 	"clientSecret":             "ClientSecret is the oauth client secret",
 	"extraScopes":              "ExtraScopes are any scopes to request in addition to the standard \"openid\" scope.",
 	"extraAuthorizeParameters": "ExtraAuthorizeParameters are any custom parameters to add to the authorize request.",
-	"urls":   "URLs to use to authenticate",
-	"claims": "Claims mappings",
+	"urls":         "URLs to use to authenticate",
+	"claims":       "Claims mappings",
+	"groupsPrefix": "GroupsPrefix is prepended to group names from the groups claims when synchronizing groups at login",
+	"pruneGroups":  "PruneGroups removes the user from previously synchronized groups that are no longer asserted by the groups claims",
 }
 
 func (OpenIDIdentityProvider) SwaggerDoc() map[string]string {
@@ -702,13 +764,25 @@ var map_ProjectConfig = map[string]string{
 	"defaultNodeSelector":    "DefaultNodeSelector holds default project node label selector",
 	"projectRequestMessage":  "ProjectRequestMessage is the string presented to a user if they are unable to request a project via the projectrequest api endpoint",
 	"projectRequestTemplate": "ProjectRequestTemplate is the template to use for creating projects in response to projectrequest. It is in the format namespace/template and it is optional. If it is not specified, a default template is used.",
-	"securityAllocator":      "SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.",
+	"projectRequestTemplateSelectors": "ProjectRequestTemplateSelectors is an ordered list of rules that select the project request template based on the requesting user's groups. The first rule that matches one of the user's groups wins; if no rule matches, projectRequestTemplate is used.",
+	"projectTrashGracePeriodMinutes":  "ProjectTrashGracePeriodMinutes is the number of minutes a deleted project is held in a restorable trash state before the namespace is finally removed. While trashed, creation of new content in the project is forbidden and removing the delete-after annotation restores the project. If zero, projects are deleted immediately.",
+	"securityAllocator":               "SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.",
 }
 
 func (ProjectConfig) SwaggerDoc() map[string]string {
 	return map_ProjectConfig
 }
 
+var map_ProjectRequestTemplateSelector = map[string]string{
+	"":         "ProjectRequestTemplateSelector selects a project request template for users that belong to any of the listed groups.",
+	"groups":   "Groups is the list of groups the rule applies to. A user matching any entry matches the rule.",
+	"template": "Template is the template to use for matching users, in the format namespace/template.",
+}
+
+func (ProjectRequestTemplateSelector) SwaggerDoc() map[string]string {
+	return map_ProjectRequestTemplateSelector
+}
+
 var map_RFC2307Config = map[string]string{
 	"":                               "RFC2307Config holds the necessary configuration options to define how an LDAP group sync interacts with an LDAP server using the RFC2307 schema",
 	"groupsQuery":                    "AllGroupsQuery holds the template for an LDAP query that returns group entries.",
@@ -777,7 +851,8 @@ func (RequestHeaderIdentityProvider) SwaggerDoc() map[string]string {
 
 var map_RoutingConfig = map[string]string{
 	"":          "RoutingConfig holds the necessary configuration options for routing to subdomains",
-	"subdomain": "Subdomain is the suffix appended to $service.$namespace. to form the default route hostname DEPRECATED: This field is being replaced by routers setting their own defaults. This is the \"default\" route.",
+	"subdomain":         "Subdomain is the suffix appended to $service.$namespace. to form the default route hostname DEPRECATED: This field is being replaced by routers setting their own defaults. This is the \"default\" route.",
+	"activeRouterNames": "ActiveRouterNames is the set of router (shard) names currently deployed in the cluster. When non-empty, the route status cleanup controller removes status ingress entries written by routers not in this list.",
 }
 
 func (RoutingConfig) SwaggerDoc() map[string]string {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	knet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kuval "k8s.io/apimachinery/pkg/util/validation"
@@ -249,6 +250,19 @@ func ValidateAuditConfig(config configapi.AuditConfig, fldPath *field.Path) Vali
 		validationResults.AddErrors(field.Invalid(fldPath.Child("maximumFileSizeMegabytes"), config.MaximumFileSizeMegabytes, "must be greater than or equal to 0"))
 	}
 
+	for i, rule := range config.Rules {
+		switch rule.Level {
+		case configapi.AuditRuleLevelNone, configapi.AuditRuleLevelMetadata, configapi.AuditRuleLevelRequest, configapi.AuditRuleLevelRequestResponse:
+			// ok
+		default:
+			validationResults.AddErrors(field.NotSupported(fldPath.Child("rules").Index(i).Child("level"), rule.Level,
+				[]string{string(configapi.AuditRuleLevelNone), string(configapi.AuditRuleLevelMetadata), string(configapi.AuditRuleLevelRequest), string(configapi.AuditRuleLevelRequestResponse)}))
+		}
+		if len(rule.Users) == 0 && len(rule.Groups) == 0 && len(rule.Namespaces) == 0 {
+			validationResults.AddErrors(field.Required(fldPath.Child("rules").Index(i), "must specify at least one of users, groups, or namespaces"))
+		}
+	}
+
 	// setting policy file will turn the advanced auditing on
 	if config.PolicyConfiguration != nil && len(config.PolicyFile) > 0 {
 		validationResults.AddErrors(field.Forbidden(fldPath.Child("policyFile"), "both policyFile and policyConfiguration cannot be specified"))
@@ -332,6 +346,16 @@ func ValidateControllerConfig(config configapi.ControllerConfig, fldPath *field.
 	} else {
 		validationResults.AddErrors(ValidateCertInfo(*config.ServiceServingCert.Signer, true, fldPath.Child("serviceServingCert.signer"))...)
 	}
+	if len(config.ImageTrigger.NamespaceSelector) > 0 {
+		if _, err := labels.Parse(config.ImageTrigger.NamespaceSelector); err != nil {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("imageTrigger", "namespaceSelector"), config.ImageTrigger.NamespaceSelector, err.Error()))
+		}
+	}
+	if len(config.ImageTrigger.ObjectSelector) > 0 {
+		if _, err := labels.Parse(config.ImageTrigger.ObjectSelector); err != nil {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("imageTrigger", "objectSelector"), config.ImageTrigger.ObjectSelector, err.Error()))
+		}
+	}
 
 	return validationResults
 }
@@ -620,10 +644,27 @@ func ValidateProjectConfig(config configapi.ProjectConfig, fldPath *field.Path)
 		validationResults.AddErrors(field.Invalid(fldPath.Child("projectRequestTemplate"), config.ProjectRequestTemplate, "must be in the form: namespace/templateName"))
 	}
 
+	for i, selector := range config.ProjectRequestTemplateSelectors {
+		selectorPath := fldPath.Child("projectRequestTemplateSelectors").Index(i)
+		if len(selector.Groups) == 0 {
+			validationResults.AddErrors(field.Required(selectorPath.Child("groups"), ""))
+		}
+		if namespace, name, err := configapi.ParseNamespaceAndName(selector.Template); err != nil || len(namespace) == 0 || len(name) == 0 {
+			validationResults.AddErrors(field.Invalid(selectorPath.Child("template"), selector.Template, "must be in the form: namespace/templateName"))
+		}
+	}
+
+	if config.ProjectTrashGracePeriodMinutes < 0 {
+		validationResults.AddErrors(field.Invalid(fldPath.Child("projectTrashGracePeriodMinutes"), config.ProjectTrashGracePeriodMinutes, "must be greater than or equal to 0"))
+	}
+
 	if len(config.DefaultNodeSelector) > 0 {
 		_, err := labelselector.Parse(config.DefaultNodeSelector)
 		if err != nil {
-			validationResults.AddErrors(field.Invalid(fldPath.Child("defaultNodeSelector"), config.DefaultNodeSelector, "must be a valid label selector"))
+			// the selector may use set-based expressions (In, NotIn, Exists)
+			if _, exprErr := labels.Parse(config.DefaultNodeSelector); exprErr != nil {
+				validationResults.AddErrors(field.Invalid(fldPath.Child("defaultNodeSelector"), config.DefaultNodeSelector, "must be a valid label selector"))
+			}
 		}
 	}
 
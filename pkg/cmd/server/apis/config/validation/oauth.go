@@ -417,6 +417,16 @@ func ValidateOpenIDIdentityProvider(provider *configapi.OpenIDIdentityProvider,
 		allErrs = append(allErrs, field.Invalid(providerPath.Child("claims", "id"), "[]", "at least one id claim is required (OpenID standard identity claim is 'sub')"))
 	}
 
+	// Group sync policy only makes sense with groups claims to synchronize
+	if len(provider.Claims.Groups) == 0 {
+		if len(provider.GroupsPrefix) != 0 {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("groupsPrefix"), provider.GroupsPrefix, "requires at least one groups claim"))
+		}
+		if provider.PruneGroups {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("pruneGroups"), provider.PruneGroups, "requires at least one groups claim"))
+		}
+	}
+
 	if len(provider.CA) != 0 {
 		allErrs = append(allErrs, ValidateFile(provider.CA, providerPath.Child("ca"))...)
 	}
@@ -10,6 +10,22 @@ import (
 	unsafe "unsafe"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcmeConfig) DeepCopyInto(out *AcmeConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcmeConfig.
+func (in *AcmeConfig) DeepCopy() *AcmeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AcmeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActiveDirectoryConfig) DeepCopyInto(out *ActiveDirectoryConfig) {
 	*out = *in
@@ -163,6 +179,13 @@ func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 	} else {
 		out.PolicyConfiguration = in.PolicyConfiguration.DeepCopyObject()
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuditPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -176,6 +199,37 @@ func (in *AuditConfig) DeepCopy() *AuditConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicyRule) DeepCopyInto(out *AuditPolicyRule) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicyRule.
+func (in *AuditPolicyRule) DeepCopy() *AuditPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AugmentedActiveDirectoryConfig) DeepCopyInto(out *AugmentedActiveDirectoryConfig) {
 	*out = *in
@@ -236,6 +290,22 @@ func (in *BasicAuthPasswordIdentityProvider) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildLogSinkConfig) DeepCopyInto(out *BuildLogSinkConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildLogSinkConfig.
+func (in *BuildLogSinkConfig) DeepCopy() *BuildLogSinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildLogSinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertInfo) DeepCopyInto(out *CertInfo) {
 	*out = *in
@@ -302,6 +372,9 @@ func (in *ControllerConfig) DeepCopyInto(out *ControllerConfig) {
 		}
 	}
 	in.ServiceServingCert.DeepCopyInto(&out.ServiceServingCert)
+	out.ImageTrigger = in.ImageTrigger
+	out.BuildLogSink = in.BuildLogSink
+	out.Acme = in.Acme
 	return
 }
 
@@ -789,6 +862,22 @@ func (in *ImagePolicyConfig) DeepCopy() *ImagePolicyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageTriggerConfig) DeepCopyInto(out *ImageTriggerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageTriggerConfig.
+func (in *ImageTriggerConfig) DeepCopy() *ImageTriggerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageTriggerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JenkinsPipelineConfig) DeepCopyInto(out *JenkinsPipelineConfig) {
 	*out = *in
@@ -808,6 +897,15 @@ func (in *JenkinsPipelineConfig) DeepCopyInto(out *JenkinsPipelineConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.TemplateProfiles != nil {
+		in, out := &in.TemplateProfiles, &out.TemplateProfiles
+		*out = make(map[string]JenkinsPipelineTemplateProfile, len(*in))
+		for key, val := range *in {
+			newVal := new(JenkinsPipelineTemplateProfile)
+			val.DeepCopyInto(newVal)
+			(*out)[key] = *newVal
+		}
+	}
 	return
 }
 
@@ -821,6 +919,29 @@ func (in *JenkinsPipelineConfig) DeepCopy() *JenkinsPipelineConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsPipelineTemplateProfile) DeepCopyInto(out *JenkinsPipelineTemplateProfile) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsPipelineTemplateProfile.
+func (in *JenkinsPipelineTemplateProfile) DeepCopy() *JenkinsPipelineTemplateProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsPipelineTemplateProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeystonePasswordIdentityProvider) DeepCopyInto(out *KeystonePasswordIdentityProvider) {
 	*out = *in
@@ -1255,7 +1376,7 @@ func (in *MasterConfig) DeepCopyInto(out *MasterConfig) {
 	in.ImagePolicyConfig.DeepCopyInto(&out.ImagePolicyConfig)
 	in.PolicyConfig.DeepCopyInto(&out.PolicyConfig)
 	in.ProjectConfig.DeepCopyInto(&out.ProjectConfig)
-	out.RoutingConfig = in.RoutingConfig
+	in.RoutingConfig.DeepCopyInto(&out.RoutingConfig)
 	in.NetworkConfig.DeepCopyInto(&out.NetworkConfig)
 	out.VolumeConfig = in.VolumeConfig
 	in.JenkinsPipelineConfig.DeepCopyInto(&out.JenkinsPipelineConfig)
@@ -1565,6 +1686,11 @@ func (in *OpenIDClaims) DeepCopyInto(out *OpenIDClaims) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1671,6 +1797,13 @@ func (in *PolicyConfig) DeepCopy() *PolicyConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectConfig) DeepCopyInto(out *ProjectConfig) {
 	*out = *in
+	if in.ProjectRequestTemplateSelectors != nil {
+		in, out := &in.ProjectRequestTemplateSelectors, &out.ProjectRequestTemplateSelectors
+		*out = make([]ProjectRequestTemplateSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SecurityAllocator != nil {
 		in, out := &in.SecurityAllocator, &out.SecurityAllocator
 		if *in == nil {
@@ -1693,6 +1826,27 @@ func (in *ProjectConfig) DeepCopy() *ProjectConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRequestTemplateSelector) DeepCopyInto(out *ProjectRequestTemplateSelector) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRequestTemplateSelector.
+func (in *ProjectRequestTemplateSelector) DeepCopy() *ProjectRequestTemplateSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRequestTemplateSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RFC2307Config) DeepCopyInto(out *RFC2307Config) {
 	*out = *in
@@ -1849,6 +2003,11 @@ func (in *RequestHeaderIdentityProvider) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoutingConfig) DeepCopyInto(out *RoutingConfig) {
 	*out = *in
+	if in.ActiveRouterNames != nil {
+		in, out := &in.ActiveRouterNames, &out.ActiveRouterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
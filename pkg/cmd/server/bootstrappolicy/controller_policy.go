@@ -18,6 +18,7 @@ const saRolePrefix = "system:openshift:controller:"
 
 const (
 	InfraOriginNamespaceServiceAccountName                      = "origin-namespace-controller"
+	InfraProjectTrashControllerServiceAccountName               = "project-trash-controller"
 	InfraServiceAccountControllerServiceAccountName             = "serviceaccount-controller"
 	InfraServiceAccountPullSecretsControllerServiceAccountName  = "serviceaccount-pull-secrets-controller"
 	InfraServiceAccountTokensControllerServiceAccountName       = "serviceaccount-tokens-controller"
@@ -28,13 +29,17 @@ const (
 	InfraDeployerControllerServiceAccountName                   = "deployer-controller"
 	InfraImageTriggerControllerServiceAccountName               = "image-trigger-controller"
 	InfraImageImportControllerServiceAccountName                = "image-import-controller"
+	InfraImagePrunerControllerServiceAccountName                = "image-pruner-controller"
 	InfraSDNControllerServiceAccountName                        = "sdn-controller"
 	InfraClusterQuotaReconciliationControllerServiceAccountName = "cluster-quota-reconciliation-controller"
 	InfraUnidlingControllerServiceAccountName                   = "unidling-controller"
 	InfraServiceIngressIPControllerServiceAccountName           = "service-ingress-ip-controller"
 	InfraPersistentVolumeRecyclerControllerServiceAccountName   = "pv-recycler-controller"
 	InfraResourceQuotaControllerServiceAccountName              = "resourcequota-controller"
+	InfraRouteStatusCleanupControllerServiceAccountName         = "route-status-cleanup-controller"
+	InfraAcmeControllerServiceAccountName                       = "acme-controller"
 	InfraDefaultRoleBindingsControllerServiceAccountName        = "default-rolebindings-controller"
+	InfraExpiredRoleBindingsControllerServiceAccountName        = "expired-rolebindings-controller"
 
 	// template instance controller watches for TemplateInstance object creation
 	// and instantiates templates as a result.
@@ -108,6 +113,7 @@ func init() {
 			rbac.NewRule("get", "list").Groups(kapiGroup).Resources("secrets").RuleOrDie(),
 			rbac.NewRule("get", "list").Groups(kapiGroup).Resources("configmaps").RuleOrDie(),
 			rbac.NewRule("get", "list", "create", "delete").Groups(kapiGroup).Resources("pods").RuleOrDie(),
+			rbac.NewRule("create").Groups(networkingGroup).Resources("networkpolicies").RuleOrDie(),
 			rbac.NewRule("get").Groups(kapiGroup).Resources("namespaces").RuleOrDie(),
 			rbac.NewRule("get", "list").Groups(kapiGroup).Resources("serviceaccounts").RuleOrDie(),
 			rbac.NewRule("create").Groups(securityGroup, legacySecurityGroup).Resources("podsecuritypolicysubjectreviews").RuleOrDie(),
@@ -178,6 +184,15 @@ func init() {
 		},
 	})
 
+	// project-trash-controller
+	addControllerRole(rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraProjectTrashControllerServiceAccountName},
+		Rules: []rbac.PolicyRule{
+			rbac.NewRule("get", "list", "watch", "delete").Groups(kapiGroup).Resources("namespaces").RuleOrDie(),
+			eventsRule(),
+		},
+	})
+
 	// serviceaccount-controller
 	addControllerRole(rbac.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraServiceAccountControllerServiceAccountName},
@@ -244,6 +259,21 @@ func init() {
 		},
 	})
 
+	// image-pruner-controller
+	addControllerRole(rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraImagePrunerControllerServiceAccountName},
+		Rules: []rbac.PolicyRule{
+			rbac.NewRule("list", "delete").Groups(imageGroup, legacyImageGroup).Resources("images").RuleOrDie(),
+			rbac.NewRule("get", "list", "update").Groups(imageGroup, legacyImageGroup).Resources("imagestreams").RuleOrDie(),
+			rbac.NewRule("list").Groups(kapiGroup).Resources("pods", "replicationcontrollers", "limitranges").RuleOrDie(),
+			rbac.NewRule("get", "update").Groups(kapiGroup).Resources("configmaps").RuleOrDie(),
+			rbac.NewRule("list").Groups(buildGroup, legacyBuildGroup).Resources("buildconfigs", "builds").RuleOrDie(),
+			rbac.NewRule("list").Groups(extensionsGroup).Resources("daemonsets", "deployments", "replicasets").RuleOrDie(),
+			rbac.NewRule("list").Groups(deployGroup, legacyDeployGroup).Resources("deploymentconfigs").RuleOrDie(),
+			eventsRule(),
+		},
+	})
+
 	// sdn-controller
 	addControllerRole(rbac.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraSDNControllerServiceAccountName},
@@ -350,6 +380,26 @@ func init() {
 		},
 	})
 
+	// route-status-cleanup-controller
+	addControllerRole(rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraRouteStatusCleanupControllerServiceAccountName},
+		Rules: []rbac.PolicyRule{
+			rbac.NewRule("get", "list", "watch").Groups(routeGroup, legacyRouteGroup).Resources("routes").RuleOrDie(),
+			rbac.NewRule("update").Groups(routeGroup, legacyRouteGroup).Resources("routes/status").RuleOrDie(),
+			eventsRule(),
+		},
+	})
+
+	// acme-controller
+	addControllerRole(rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraAcmeControllerServiceAccountName},
+		Rules: []rbac.PolicyRule{
+			rbac.NewRule("get", "list", "watch", "create", "update", "delete").Groups(routeGroup, legacyRouteGroup).Resources("routes").RuleOrDie(),
+			rbac.NewRule("create").Groups(routeGroup, legacyRouteGroup).Resources("routes/custom-host").RuleOrDie(),
+			eventsRule(),
+		},
+	})
+
 	// the controller needs to be bound to the roles it is going to try to create
 	bindControllerRole(InfraDefaultRoleBindingsControllerServiceAccountName, ImagePullerRoleName)
 	bindControllerRole(InfraDefaultRoleBindingsControllerServiceAccountName, ImageBuilderRoleName)
@@ -363,6 +413,15 @@ func init() {
 			eventsRule(),
 		},
 	})
+
+	// expired-rolebindings-controller
+	addControllerRole(rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: saRolePrefix + InfraExpiredRoleBindingsControllerServiceAccountName},
+		Rules: []rbac.PolicyRule{
+			rbac.NewRule("get", "list", "watch", "delete").Groups(rbacGroup).Resources("rolebindings").RuleOrDie(),
+			eventsRule(),
+		},
+	})
 }
 
 // ControllerRoles returns the cluster roles used by controllers
@@ -478,6 +478,72 @@ func MakeCA(certFile, keyFile, serialFile, name string, expireDays int) (*CA, er
 	}, nil
 }
 
+// EnsureIntermediateCA returns an intermediate CA signed by the given signer, whether it was
+// created (as opposed to pre-existing), and any error.
+// if serialFile is empty, a RandomSerialGenerator will be used
+func EnsureIntermediateCA(certFile, keyFile, serialFile, name string, expireDays int, signer *CA) (*CA, bool, error) {
+	if ca, err := GetCA(certFile, keyFile, serialFile); err == nil {
+		return ca, false, err
+	}
+	ca, err := MakeIntermediateCA(certFile, keyFile, serialFile, name, expireDays, signer)
+	return ca, true, err
+}
+
+// MakeIntermediateCA creates a new CA whose signing certificate is issued by the given signer
+// rather than self-signed.  The cert file contains the full chain (the intermediate followed
+// by the signer's chain) so the result can be used directly as a CA bundle.
+// if serialFile is empty, a RandomSerialGenerator will be used
+func MakeIntermediateCA(certFile, keyFile, serialFile, name string, expireDays int, signer *CA) (*CA, error) {
+	glog.V(2).Infof("Generating new intermediate CA for %s cert, and key in %s, %s", name, certFile, keyFile)
+	intermediatePublicKey, intermediatePrivateKey, err := NewKeyPair()
+	if err != nil {
+		return nil, err
+	}
+	intermediateTemplate := newSigningCertificateTemplate(pkix.Name{CommonName: name}, expireDays, time.Now)
+	intermediateCert, err := signer.signCertificate(intermediateTemplate, intermediatePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	caConfig := &TLSCertificateConfig{
+		Certs: append([]*x509.Certificate{intermediateCert}, signer.Config.Certs...),
+		Key:   intermediatePrivateKey,
+	}
+	if err := caConfig.writeCertConfig(certFile, keyFile); err != nil {
+		return nil, err
+	}
+
+	var serialGenerator SerialGenerator
+	if len(serialFile) > 0 {
+		// create / overwrite the serial file with a zero padded hex value (ending in a newline to have a valid file)
+		if err := ioutil.WriteFile(serialFile, []byte("00\n"), 0644); err != nil {
+			return nil, err
+		}
+		serialGenerator, err = NewSerialFileGenerator(serialFile)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		serialGenerator = &RandomSerialGenerator{}
+	}
+
+	return &CA{
+		SerialGenerator: serialGenerator,
+		Config:          caConfig,
+	}, nil
+}
+
+// MakeCRL creates a PEM encoded certificate revocation list for the given revoked
+// certificates, signed by this CA and valid for expireDays.
+func (ca *CA) MakeCRL(revoked []pkix.RevokedCertificate, expireDays int) ([]byte, error) {
+	now := time.Now()
+	lifetime := time.Duration(expireDays) * 24 * time.Hour
+	crlBytes, err := ca.Config.Certs[0].CreateCRL(rand.Reader, ca.Config.Key, revoked, now, now.Add(lifetime))
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlBytes}), nil
+}
+
 func (ca *CA) EnsureServerCert(certFile, keyFile string, hostnames sets.String, expireDays int) (*TLSCertificateConfig, bool, error) {
 	certConfig, err := GetServerCert(certFile, keyFile, hostnames)
 	if err != nil {
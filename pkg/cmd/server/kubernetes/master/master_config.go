@@ -794,16 +794,24 @@ func GetAuditConfig(auditConfig configapi.AuditConfig) (audit.Backend, auditpoli
 		Rules: []auditinternal.PolicyRule{{Level: auditinternal.LevelMetadata}},
 	})
 
-	// when a policy file is defined we enable the advanced auditing
-	if auditConfig.PolicyConfiguration != nil || len(auditConfig.PolicyFile) > 0 {
+	// when a policy file or config rules are defined we enable the advanced auditing
+	if auditConfig.PolicyConfiguration != nil || len(auditConfig.PolicyFile) > 0 || len(auditConfig.Rules) > 0 {
 		// policy configuration
+		policy := &auditinternal.Policy{
+			Rules: []auditinternal.PolicyRule{{Level: auditinternal.LevelMetadata}},
+		}
 		if auditConfig.PolicyConfiguration != nil {
-			p := auditConfig.PolicyConfiguration.(*auditinternal.Policy)
-			policyChecker = auditpolicy.NewChecker(p)
+			policy = auditConfig.PolicyConfiguration.(*auditinternal.Policy)
 		} else if len(auditConfig.PolicyFile) > 0 {
-			p, _ := auditpolicy.LoadPolicyFromFile(auditConfig.PolicyFile)
-			policyChecker = auditpolicy.NewChecker(p)
+			policy, _ = auditpolicy.LoadPolicyFromFile(auditConfig.PolicyFile)
+		}
+		if len(auditConfig.Rules) > 0 && policy != nil {
+			// config rules are evaluated before the policy rules so specific users,
+			// groups, and namespaces keep their configured level
+			policy = policy.DeepCopy()
+			policy.Rules = append(convertAuditRules(auditConfig.Rules), policy.Rules...)
 		}
+		policyChecker = auditpolicy.NewChecker(policy)
 
 		// log configuration, only when file path was provided
 		if len(auditConfig.AuditFilePath) > 0 {
@@ -822,3 +830,18 @@ func GetAuditConfig(auditConfig configapi.AuditConfig) (audit.Backend, auditpoli
 
 	return backend, policyChecker, nil
 }
+
+// convertAuditRules translates the simplified rules from the master config into
+// advanced audit policy rules targeting users, groups, and namespaces
+func convertAuditRules(rules []configapi.AuditPolicyRule) []auditinternal.PolicyRule {
+	converted := make([]auditinternal.PolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		converted = append(converted, auditinternal.PolicyRule{
+			Level:      auditinternal.Level(rule.Level),
+			Users:      rule.Users,
+			UserGroups: rule.Groups,
+			Namespaces: rule.Namespaces,
+		})
+	}
+	return converted
+}
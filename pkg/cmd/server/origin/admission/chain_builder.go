@@ -23,6 +23,7 @@ import (
 	imagepolicy "github.com/openshift/origin/pkg/image/admission/apis/imagepolicy"
 	ingressadmission "github.com/openshift/origin/pkg/ingress/admission"
 	overrideapi "github.com/openshift/origin/pkg/quota/admission/apis/clusterresourceoverride"
+	routehostallowlist "github.com/openshift/origin/pkg/route/admission/hostallowlist"
 	sccadmission "github.com/openshift/origin/pkg/security/admission"
 	serviceadmit "github.com/openshift/origin/pkg/service/admission"
 )
@@ -53,6 +54,7 @@ var (
 		"openshift.io/BuildConfigSecretInjector",
 		"BuildByStrategy",
 		imageadmission.PluginName,
+		routehostallowlist.PluginName,
 		"PodNodeConstraints",
 		"OwnerReferencesPermissionEnforcement",
 		"Initializers",
@@ -126,6 +128,7 @@ var (
 		"openshift.io/BuildConfigSecretInjector",
 		"BuildByStrategy",
 		imageadmission.PluginName,
+		routehostallowlist.PluginName,
 		"RunOnceDuration",
 		"PodNodeConstraints",
 		"OriginPodNodeEnvironment",
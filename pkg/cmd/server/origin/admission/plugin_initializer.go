@@ -20,6 +20,7 @@ import (
 	"github.com/openshift/origin/pkg/quota/controller/clusterquotamapping"
 	quotainformer "github.com/openshift/origin/pkg/quota/generated/informers/internalversion"
 	quotaclient "github.com/openshift/origin/pkg/quota/generated/internalclientset"
+	"github.com/openshift/origin/pkg/quota/count"
 	"github.com/openshift/origin/pkg/quota/image"
 	securityinformer "github.com/openshift/origin/pkg/security/generated/informers/internalversion"
 	"github.com/openshift/origin/pkg/service"
@@ -109,6 +110,9 @@ func NewPluginInitializer(
 	for i := range imageEvaluators {
 		quotaRegistry.Add(imageEvaluators[i])
 	}
+	for _, evaluator := range count.NewReplenishmentEvaluatorsForAdmission() {
+		quotaRegistry.Add(evaluator)
+	}
 
 	defaultRegistry := env("OPENSHIFT_DEFAULT_REGISTRY", "${DOCKER_REGISTRY_SERVICE_HOST}:${DOCKER_REGISTRY_SERVICE_PORT}")
 	svcCache := service.NewServiceResolverCache(kubeInternalClient.Core().Services(metav1.NamespaceDefault).Get)
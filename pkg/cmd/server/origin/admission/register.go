@@ -24,6 +24,7 @@ import (
 	quotaclusterresourceoverride "github.com/openshift/origin/pkg/quota/admission/clusterresourceoverride"
 	quotaclusterresourcequota "github.com/openshift/origin/pkg/quota/admission/clusterresourcequota"
 	quotarunonceduration "github.com/openshift/origin/pkg/quota/admission/runonceduration"
+	routehostallowlist "github.com/openshift/origin/pkg/route/admission/hostallowlist"
 	schedulerpodnodeconstraints "github.com/openshift/origin/pkg/scheduler/admission/podnodeconstraints"
 	securityadmission "github.com/openshift/origin/pkg/security/admission"
 	serviceadmit "github.com/openshift/origin/pkg/service/admission"
@@ -67,6 +68,7 @@ func registerOpenshiftAdmissionPlugins(plugins *admission.Plugins) {
 	quotaclusterresourceoverride.Register(plugins)
 	quotaclusterresourcequota.Register(plugins)
 	quotarunonceduration.Register(plugins)
+	routehostallowlist.Register(plugins)
 	schedulerpodnodeconstraints.Register(plugins)
 	securityadmission.Register(plugins)
 	securityadmission.RegisterSCCExecRestrictions(plugins)
@@ -99,6 +101,7 @@ var (
 		"ResourceQuota",
 		"openshift.io/ClusterResourceQuota",
 		"openshift.io/IngressAdmission",
+		routehostallowlist.PluginName,
 	)
 
 	// DefaultOffPlugins includes plugins which require explicit configuration to run
@@ -18,6 +18,7 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	webhooktoken "k8s.io/apiserver/plugin/pkg/authenticator/token/webhook"
 	kclientsetexternal "k8s.io/client-go/kubernetes"
+	kcorev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/cert"
 	sacontroller "k8s.io/kubernetes/pkg/controller/serviceaccount"
@@ -67,12 +68,13 @@ func NewAuthenticator(
 		informers.GetOauthInformers().Oauth().InternalVersion().OAuthClients().Lister(),
 		serviceAccountTokenGetter,
 		userClient.User().Users(),
+		kubeExternalClient.CoreV1(),
 		apiClientCAs,
 		usercache.NewGroupCache(informers.GetUserInformers().User().V1().Groups()),
 	)
 }
 
-func newAuthenticator(config configapi.MasterConfig, accessTokenGetter oauthclient.OAuthAccessTokenInterface, oauthClientLister oauthclientlister.OAuthClientLister, tokenGetter serviceaccount.ServiceAccountTokenGetter, userGetter usertypedclient.UserInterface, apiClientCAs *x509.CertPool, groupMapper internaloauth.UserToGroupMapper) (authenticator.Request, map[string]genericapiserver.PostStartHookFunc, error) {
+func newAuthenticator(config configapi.MasterConfig, accessTokenGetter oauthclient.OAuthAccessTokenInterface, oauthClientLister oauthclientlister.OAuthClientLister, tokenGetter serviceaccount.ServiceAccountTokenGetter, userGetter usertypedclient.UserInterface, serviceAccountGetter kcorev1client.ServiceAccountsGetter, apiClientCAs *x509.CertPool, groupMapper internaloauth.UserToGroupMapper) (authenticator.Request, map[string]genericapiserver.PostStartHookFunc, error) {
 	postStartHooks := map[string]genericapiserver.PostStartHookFunc{}
 	authenticators := []authenticator.Request{}
 	tokenAuthenticators := []authenticator.Token{}
@@ -93,7 +95,7 @@ func newAuthenticator(config configapi.MasterConfig, accessTokenGetter oauthclie
 
 	// OAuth token
 	if config.OAuthConfig != nil {
-		validators := []internaloauth.OAuthTokenValidator{internaloauth.NewExpirationValidator(), internaloauth.NewUIDValidator()}
+		validators := []internaloauth.OAuthTokenValidator{internaloauth.NewExpirationValidator(), internaloauth.NewUIDValidator(), internaloauth.NewDisabledUserValidator(), internaloauth.NewMaxAgeValidator(oauthClientLister)}
 		if inactivityTimeout := config.OAuthConfig.TokenConfig.AccessTokenInactivityTimeoutSeconds; inactivityTimeout != nil {
 			timeoutValidator := internaloauth.NewTimeoutValidator(accessTokenGetter, oauthClientLister, *inactivityTimeout, oauthvalidation.MinimumInactivityTimeoutSeconds)
 			validators = append(validators, timeoutValidator)
@@ -102,11 +104,22 @@ func newAuthenticator(config configapi.MasterConfig, accessTokenGetter oauthclie
 				return nil
 			}
 		}
-		oauthTokenAuthenticator := internaloauth.NewTokenAuthenticator(accessTokenGetter, userGetter, groupMapper, validators...)
+		// token paths below have no view of the request, so they must reject
+		// tokens that were bound to request attributes at issuance
+		oauthTokenAuthenticator := internaloauth.NewTokenAuthenticator(accessTokenGetter, userGetter, serviceAccountGetter, groupMapper, append(validators, internaloauth.NewUnboundTokenValidator())...)
 		tokenAuthenticators = append(tokenAuthenticators,
 			// if you have a bearer token, you're a human (usually)
 			// if you change this, have a look at the impersonationFilter where we attach groups to the impersonated user
 			group.NewTokenGroupAdder(oauthTokenAuthenticator, []string{bootstrappolicy.AuthenticatedOAuthGroup}))
+
+		// bearer requests are tried against the bound authenticator first so that
+		// tokens carrying source CIDR or client certificate bindings are checked
+		// against the attributes of the request; unrecognized tokens fall through
+		// to the rest of the chain
+		authenticators = append(authenticators,
+			group.NewGroupAdder(
+				internaloauth.NewBoundTokenAuthenticator(accessTokenGetter, userGetter, serviceAccountGetter, groupMapper, validators...),
+				[]string{bootstrappolicy.AuthenticatedOAuthGroup}))
 	}
 
 	for _, wta := range config.AuthConfig.WebhookTokenAuthenticators {
@@ -64,10 +64,14 @@ func (c *MasterConfig) newOpenshiftAPIConfig(kubeAPIServerConfig apiserver.Confi
 			ProjectAuthorizationCache:          c.ProjectAuthorizationCache,
 			ProjectCache:                       c.ProjectCache,
 			ProjectRequestTemplate:             c.Options.ProjectConfig.ProjectRequestTemplate,
+			ProjectRequestTemplateSelectors:    c.Options.ProjectConfig.ProjectRequestTemplateSelectors,
+			ProjectTrashGracePeriodMinutes:     c.Options.ProjectConfig.ProjectTrashGracePeriodMinutes,
 			ProjectRequestMessage:              c.Options.ProjectConfig.ProjectRequestMessage,
 			EnableBuilds:                       configapi.IsBuildEnabled(&c.Options),
+			BuildLogSinkURL:                    c.Options.ControllerConfig.BuildLogSink.URL,
 			ClusterQuotaMappingController:      c.ClusterQuotaMappingController,
 			SCCStorage:                         sccStorage,
+			AdmissionPluginRegistry:            c.AdmissionPluginRegistry,
 		},
 	}
 	if c.Options.OAuthConfig != nil {
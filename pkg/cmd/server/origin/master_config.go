@@ -5,6 +5,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift/origin/pkg/admission/namespaceconditions"
+	"github.com/openshift/origin/pkg/admission/preview"
 
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -91,6 +92,10 @@ type MasterConfig struct {
 
 	AuditBackend audit.Backend
 
+	// AdmissionPluginRegistry records the named admission plugins in chain
+	// order for the admission preview endpoint.
+	AdmissionPluginRegistry *preview.PluginRegistry
+
 	// TODO inspect uses to eliminate them
 	InternalKubeInformers  kinternalinformers.SharedInformerFactory
 	ClientGoKubeInformers  kubeclientgoinformers.SharedInformerFactory
@@ -169,7 +174,8 @@ func BuildMasterConfig(
 		SkipLevelZeroNames: originadmission.SkipRunLevelZeroPlugins,
 		SkipLevelOneNames:  originadmission.SkipRunLevelOnePlugins,
 	}
-	admission, err := originadmission.NewAdmissionChains(options, admissionInitializer, admissionDecorator.WithNamespaceLabelConditions)
+	admissionPluginRegistry := preview.NewPluginRegistry()
+	admission, err := originadmission.NewAdmissionChains(options, admissionInitializer, admissionPluginRegistry.Decorate(admissionDecorator.WithNamespaceLabelConditions))
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +217,8 @@ func BuildMasterConfig(
 		ProjectCache:                  projectCache,
 		ClusterQuotaMappingController: clusterQuotaMappingController,
 
+		AdmissionPluginRegistry: admissionPluginRegistry,
+
 		RegistryHostnameRetriever: imageapi.DefaultRegistryHostnameRetriever(defaultRegistryFunc, options.ImagePolicyConfig.ExternalRegistryHostname, options.ImagePolicyConfig.InternalRegistryHostname),
 
 		KubeletClientConfig: kubeletClientConfig,
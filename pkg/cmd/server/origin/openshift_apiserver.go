@@ -30,16 +30,19 @@ import (
 	rbacauthorizer "k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
 
 	oappsapiv1 "github.com/openshift/api/apps/v1"
+	"github.com/openshift/origin/pkg/admission/preview"
 	"github.com/openshift/origin/pkg/api"
 	"github.com/openshift/origin/pkg/api/v1"
 	oappsapiserver "github.com/openshift/origin/pkg/apps/apiserver"
 	authorizationapiserver "github.com/openshift/origin/pkg/authorization/apiserver"
 	buildapiserver "github.com/openshift/origin/pkg/build/apiserver"
+	"github.com/openshift/origin/pkg/catalog"
 	configapi "github.com/openshift/origin/pkg/cmd/server/apis/config"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	imageadmission "github.com/openshift/origin/pkg/image/admission"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	imageapiserver "github.com/openshift/origin/pkg/image/apiserver"
+	imageclientinternal "github.com/openshift/origin/pkg/image/generated/internalclientset"
 	networkapiserver "github.com/openshift/origin/pkg/network/apiserver"
 	oauthapiserver "github.com/openshift/origin/pkg/oauth/apiserver"
 	projectapiserver "github.com/openshift/origin/pkg/project/apiserver"
@@ -50,6 +53,7 @@ import (
 	quotainformer "github.com/openshift/origin/pkg/quota/generated/informers/internalversion"
 	routeapiserver "github.com/openshift/origin/pkg/route/apiserver"
 	routeallocationcontroller "github.com/openshift/origin/pkg/route/controller/allocation"
+	routeclientinternal "github.com/openshift/origin/pkg/route/generated/internalclientset"
 	securityapiserver "github.com/openshift/origin/pkg/security/apiserver"
 	securityinformer "github.com/openshift/origin/pkg/security/generated/informers/internalversion"
 	securityclient "github.com/openshift/origin/pkg/security/generated/internalclientset"
@@ -97,12 +101,17 @@ type OpenshiftAPIExtraConfig struct {
 
 	RouteAllocator *routeallocationcontroller.RouteAllocationController
 
-	ProjectAuthorizationCache *projectauth.AuthorizationCache
-	ProjectCache              *projectcache.ProjectCache
-	ProjectRequestTemplate    string
-	ProjectRequestMessage     string
+	ProjectAuthorizationCache       *projectauth.AuthorizationCache
+	ProjectCache                    *projectcache.ProjectCache
+	ProjectRequestTemplate          string
+	ProjectRequestTemplateSelectors []configapi.ProjectRequestTemplateSelector
+	ProjectRequestMessage           string
+	ProjectTrashGracePeriodMinutes  int
 
 	EnableBuilds bool
+	// BuildLogSinkURL is the base URL the build controller archives completed
+	// build logs to, if configured.
+	BuildLogSinkURL string
 
 	// oauth API server
 	ServiceAccountMethod configapi.GrantHandlerType
@@ -112,6 +121,9 @@ type OpenshiftAPIExtraConfig struct {
 	// SCCStorage is actually created with a kubernetes restmapper options to have the correct prefix,
 	// so we have to have it special cased here to point to the right spot.
 	SCCStorage *sccstorage.REST
+
+	// AdmissionPluginRegistry, if set, backs the admission preview endpoint.
+	AdmissionPluginRegistry *preview.PluginRegistry
 }
 
 // Validate helps ensure that we build this config correctly, because there are lots of bits to remember for now
@@ -283,6 +295,7 @@ func (c *completedConfig) withBuildAPIServer(delegateAPIServer genericapiserver.
 		ExtraConfig: buildapiserver.ExtraConfig{
 			KubeAPIServerClientConfig: c.ExtraConfig.KubeAPIServerClientConfig,
 			KubeletClientConfig:       c.ExtraConfig.KubeletClientConfig,
+			BuildLogSinkURL:           c.ExtraConfig.BuildLogSinkURL,
 			Codecs:                    legacyscheme.Codecs,
 			Registry:                  legacyscheme.Registry,
 			Scheme:                    legacyscheme.Scheme,
@@ -386,8 +399,10 @@ func (c *completedConfig) withProjectAPIServer(delegateAPIServer genericapiserve
 			KubeInternalInformers:     c.ExtraConfig.KubeInternalInformers,
 			ProjectAuthorizationCache: c.ExtraConfig.ProjectAuthorizationCache,
 			ProjectCache:              c.ExtraConfig.ProjectCache,
-			ProjectRequestTemplate:    c.ExtraConfig.ProjectRequestTemplate,
-			ProjectRequestMessage:     c.ExtraConfig.ProjectRequestMessage,
+			ProjectRequestTemplate:          c.ExtraConfig.ProjectRequestTemplate,
+			ProjectRequestTemplateSelectors: c.ExtraConfig.ProjectRequestTemplateSelectors,
+			ProjectRequestMessage:           c.ExtraConfig.ProjectRequestMessage,
+			ProjectTrashGracePeriodMinutes:  c.ExtraConfig.ProjectTrashGracePeriodMinutes,
 			Codecs:                    legacyscheme.Codecs,
 			Registry:                  legacyscheme.Registry,
 			Scheme:                    legacyscheme.Scheme,
@@ -595,6 +610,19 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	// this remains here and separate so that you can check both kube and openshift levels
 	addOpenshiftVersionRoute(s.GenericAPIServer.Handler.GoRestfulContainer, "/version/openshift")
 
+	// the catalog lists with privileged loopback clients, so callers only need
+	// access to the nonResourceURL /catalog rather than broad list permissions
+	// on the underlying resources.
+	if err := addCatalogRoute(s.GenericAPIServer.Handler.NonGoRestfulMux, "/catalog", genericServer.LoopbackClientConfig); err != nil {
+		return nil, fmt.Errorf("unable to initialize catalog route: %v", err)
+	}
+
+	// dry-run preview of the admission chain, so users can see how their
+	// objects are mutated before creating them for real.
+	if c.ExtraConfig.AdmissionPluginRegistry != nil {
+		s.GenericAPIServer.Handler.NonGoRestfulMux.Handle("/admissionpreview", preview.NewHandler(c.ExtraConfig.AdmissionPluginRegistry, c.GenericConfig.RequestContextMapper, c.GenericConfig.Authorizer))
+	}
+
 	// register our poststarthooks
 	s.GenericAPIServer.AddPostStartHookOrDie("project.openshift.io-projectcache", c.startProjectCache)
 	s.GenericAPIServer.AddPostStartHookOrDie("project.openshift.io-projectauthorizationcache", c.startProjectAuthorizationCache)
@@ -637,6 +665,21 @@ func addReadinessCheckRoute(mux *genericmux.PathRecorderMux, path string, readyF
 }
 
 // initVersionRoute initializes an HTTP endpoint for the server's version information.
+// addCatalogRoute initializes the read-only catalog of published image streams
+// and routes at the provided path.
+func addCatalogRoute(mux *genericmux.PathRecorderMux, path string, loopbackClientConfig *restclient.Config) error {
+	imageClient, err := imageclientinternal.NewForConfig(loopbackClientConfig)
+	if err != nil {
+		return err
+	}
+	routeClient, err := routeclientinternal.NewForConfig(loopbackClientConfig)
+	if err != nil {
+		return err
+	}
+	mux.Handle(path, catalog.NewHandler(imageClient, routeClient))
+	return nil
+}
+
 func addOpenshiftVersionRoute(container *restful.Container, path string) {
 	// Build version info once
 	versionInfo, err := json.MarshalIndent(version.Get(), "", "  ")
@@ -0,0 +1,229 @@
+package start
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	configapi "github.com/openshift/origin/pkg/cmd/server/apis/config"
+	configapilatest "github.com/openshift/origin/pkg/cmd/server/apis/config/latest"
+	"github.com/openshift/origin/pkg/cmd/server/apis/config/validation"
+)
+
+// configObserverInterval is how often the master configuration file and the CA
+// bundles referenced from it are checked for changes.
+const configObserverInterval = 10 * time.Second
+
+// dynamicConfigFields are the master configuration fields that can be reloaded
+// without restarting the API server. Changes to any other field are logged and
+// require a restart to take effect.
+var dynamicConfigFields = []string{
+	"corsAllowedOrigins",
+	"imagePolicyConfig",
+	"admissionConfig.pluginConfig",
+	"oauthConfig.identityProviders",
+}
+
+// ConfigChangeHandler is notified with the previous and the newly merged master
+// configuration whenever one of the dynamically reloadable fields changes.
+type ConfigChangeHandler interface {
+	HandleConfigChange(oldConfig, newConfig *configapi.MasterConfig)
+}
+
+// ConfigChangeHandlerFunc adapts a function to the ConfigChangeHandler interface.
+type ConfigChangeHandlerFunc func(oldConfig, newConfig *configapi.MasterConfig)
+
+func (fn ConfigChangeHandlerFunc) HandleConfigChange(oldConfig, newConfig *configapi.MasterConfig) {
+	fn(oldConfig, newConfig)
+}
+
+// ConfigObserver watches the master configuration file and applies changes to
+// the fields listed in dynamicConfigFields without a restart. Identity provider
+// CA bundles are tracked by content so that a rotated bundle is picked up even
+// when the configuration file itself is unchanged.
+type ConfigObserver struct {
+	configFile string
+
+	handlers []ConfigChangeHandler
+
+	current     *configapi.MasterConfig
+	lastModTime time.Time
+	// caBundleChecksums maps identity provider CA bundle paths to a checksum of
+	// their last observed content.
+	caBundleChecksums map[string][sha256.Size]byte
+}
+
+// NewConfigObserver returns an observer for the given configuration file. The
+// current configuration is used as the baseline for change detection.
+func NewConfigObserver(configFile string, current *configapi.MasterConfig) *ConfigObserver {
+	o := &ConfigObserver{
+		configFile:        configFile,
+		current:           current,
+		caBundleChecksums: checksumCABundles(current),
+	}
+	if fi, err := os.Stat(configFile); err == nil {
+		o.lastModTime = fi.ModTime()
+	}
+	return o
+}
+
+// AddHandler registers a handler that is invoked whenever a dynamic field
+// changes. Handlers must be registered before Run is called.
+func (o *ConfigObserver) AddHandler(handler ConfigChangeHandler) {
+	o.handlers = append(o.handlers, handler)
+}
+
+// Run watches the configuration until stopCh is closed.
+func (o *ConfigObserver) Run(stopCh <-chan struct{}) {
+	glog.V(2).Infof("Watching %s for changes to %s", o.configFile, strings.Join(dynamicConfigFields, ", "))
+	go wait.Until(func() {
+		if err := o.sync(); err != nil {
+			utilruntime.HandleError(fmt.Errorf("error observing master configuration: %v", err))
+		}
+	}, configObserverInterval, stopCh)
+}
+
+func (o *ConfigObserver) sync() error {
+	changed := false
+	updated := o.current
+
+	// pick up rotated CA bundles even when the configuration file is unchanged
+	if sums := checksumCABundles(o.current); !reflect.DeepEqual(sums, o.caBundleChecksums) {
+		glog.Infof("Identity provider CA bundle content changed, applying new bundles")
+		o.caBundleChecksums = sums
+		changed = true
+	}
+
+	fi, err := os.Stat(o.configFile)
+	if err != nil {
+		return err
+	}
+	if fi.ModTime().After(o.lastModTime) {
+		o.lastModTime = fi.ModTime()
+		newConfig, err := configapilatest.ReadAndResolveMasterConfig(o.configFile)
+		if err != nil {
+			return fmt.Errorf("unable to reload master configuration: %v", err)
+		}
+		if results := validation.ValidateMasterConfig(newConfig, nil); len(results.Errors) != 0 {
+			return fmt.Errorf("ignoring invalid master configuration change: %v", results.Errors.ToAggregate())
+		}
+		if fields := changedDynamicFields(o.current, newConfig); len(fields) > 0 {
+			glog.Infof("Applying dynamic master configuration change to %s", strings.Join(fields, ", "))
+			updated = mergeDynamicFields(o.current, newConfig)
+			o.caBundleChecksums = checksumCABundles(updated)
+			changed = true
+		}
+		// anything left over after merging the dynamic fields needs a restart
+		if !reflect.DeepEqual(mergeDynamicFields(o.current, newConfig), newConfig) {
+			glog.Warningf("Master configuration fields other than %s changed, a restart is required for those changes to take effect", strings.Join(dynamicConfigFields, ", "))
+		}
+	}
+
+	if changed {
+		old := o.current
+		o.current = updated
+		for _, handler := range o.handlers {
+			handler.HandleConfigChange(old, updated)
+		}
+	}
+	return nil
+}
+
+// CurrentConfig returns the most recently accepted configuration.
+func (o *ConfigObserver) CurrentConfig() *configapi.MasterConfig {
+	return o.current
+}
+
+// changedDynamicFields returns the names of the dynamic fields that differ
+// between the two configurations.
+func changedDynamicFields(oldConfig, newConfig *configapi.MasterConfig) []string {
+	changed := []string{}
+	if !reflect.DeepEqual(oldConfig.CORSAllowedOrigins, newConfig.CORSAllowedOrigins) {
+		changed = append(changed, "corsAllowedOrigins")
+	}
+	if !reflect.DeepEqual(oldConfig.ImagePolicyConfig, newConfig.ImagePolicyConfig) {
+		changed = append(changed, "imagePolicyConfig")
+	}
+	if !reflect.DeepEqual(oldConfig.AdmissionConfig.PluginConfig, newConfig.AdmissionConfig.PluginConfig) {
+		changed = append(changed, "admissionConfig.pluginConfig")
+	}
+	oldProviders := identityProviders(oldConfig)
+	newProviders := identityProviders(newConfig)
+	if !reflect.DeepEqual(oldProviders, newProviders) {
+		changed = append(changed, "oauthConfig.identityProviders")
+	}
+	return changed
+}
+
+// mergeDynamicFields returns a copy of current with the dynamic fields taken
+// from updated.
+func mergeDynamicFields(current, updated *configapi.MasterConfig) *configapi.MasterConfig {
+	merged := *current
+	merged.CORSAllowedOrigins = updated.CORSAllowedOrigins
+	merged.ImagePolicyConfig = updated.ImagePolicyConfig
+	merged.AdmissionConfig.PluginConfig = updated.AdmissionConfig.PluginConfig
+	if current.OAuthConfig != nil && updated.OAuthConfig != nil {
+		oauthConfig := *current.OAuthConfig
+		oauthConfig.IdentityProviders = updated.OAuthConfig.IdentityProviders
+		merged.OAuthConfig = &oauthConfig
+	}
+	return &merged
+}
+
+func identityProviders(config *configapi.MasterConfig) []configapi.IdentityProvider {
+	if config.OAuthConfig == nil {
+		return nil
+	}
+	return config.OAuthConfig.IdentityProviders
+}
+
+// identityProviderCAFiles returns the CA bundle files referenced by the
+// configured identity providers.
+func identityProviderCAFiles(config *configapi.MasterConfig) []string {
+	files := []string{}
+	for _, idp := range identityProviders(config) {
+		caFile := ""
+		switch provider := idp.Provider.(type) {
+		case *configapi.BasicAuthPasswordIdentityProvider:
+			caFile = provider.RemoteConnectionInfo.CA
+		case *configapi.KeystonePasswordIdentityProvider:
+			caFile = provider.RemoteConnectionInfo.CA
+		case *configapi.LDAPPasswordIdentityProvider:
+			caFile = provider.CA
+		case *configapi.RequestHeaderIdentityProvider:
+			caFile = provider.ClientCA
+		case *configapi.GitLabIdentityProvider:
+			caFile = provider.CA
+		case *configapi.OpenIDIdentityProvider:
+			caFile = provider.CA
+		}
+		if len(caFile) > 0 {
+			files = append(files, caFile)
+		}
+	}
+	return files
+}
+
+// checksumCABundles returns a checksum of the content of every identity
+// provider CA bundle. Unreadable bundles are skipped - validation reports them
+// separately.
+func checksumCABundles(config *configapi.MasterConfig) map[string][sha256.Size]byte {
+	sums := map[string][sha256.Size]byte{}
+	for _, caFile := range identityProviderCAFiles(config) {
+		data, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			continue
+		}
+		sums[caFile] = sha256.Sum256(data)
+	}
+	return sums
+}
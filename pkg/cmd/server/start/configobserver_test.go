@@ -0,0 +1,116 @@
+package start
+
+import (
+	"reflect"
+	"testing"
+
+	configapi "github.com/openshift/origin/pkg/cmd/server/apis/config"
+)
+
+func TestChangedDynamicFields(t *testing.T) {
+	base := func() *configapi.MasterConfig {
+		return &configapi.MasterConfig{
+			CORSAllowedOrigins: []string{"localhost"},
+			ImagePolicyConfig: configapi.ImagePolicyConfig{
+				MaxImagesBulkImportedPerRepository: 5,
+			},
+			OAuthConfig: &configapi.OAuthConfig{
+				IdentityProviders: []configapi.IdentityProvider{
+					{Name: "anypassword", Provider: &configapi.AllowAllPasswordIdentityProvider{}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(*configapi.MasterConfig)
+		expected []string
+	}{
+		{
+			name:     "no change",
+			mutate:   func(*configapi.MasterConfig) {},
+			expected: []string{},
+		},
+		{
+			name: "cors change",
+			mutate: func(config *configapi.MasterConfig) {
+				config.CORSAllowedOrigins = append(config.CORSAllowedOrigins, "example.com")
+			},
+			expected: []string{"corsAllowedOrigins"},
+		},
+		{
+			name: "image policy change",
+			mutate: func(config *configapi.MasterConfig) {
+				config.ImagePolicyConfig.MaxImagesBulkImportedPerRepository = 10
+			},
+			expected: []string{"imagePolicyConfig"},
+		},
+		{
+			name: "admission plugin config change",
+			mutate: func(config *configapi.MasterConfig) {
+				config.AdmissionConfig.PluginConfig = map[string]*configapi.AdmissionPluginConfig{
+					"TestPlugin": {Location: "/dev/null"},
+				}
+			},
+			expected: []string{"admissionConfig.pluginConfig"},
+		},
+		{
+			name: "identity provider change",
+			mutate: func(config *configapi.MasterConfig) {
+				config.OAuthConfig.IdentityProviders[0].Name = "renamed"
+			},
+			expected: []string{"oauthConfig.identityProviders"},
+		},
+		{
+			name: "static change is not dynamic",
+			mutate: func(config *configapi.MasterConfig) {
+				config.MasterPublicURL = "https://changed.example.com:8443"
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		updated := base()
+		test.mutate(updated)
+		changed := changedDynamicFields(base(), updated)
+		if !reflect.DeepEqual(changed, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, changed)
+		}
+	}
+}
+
+func TestMergeDynamicFields(t *testing.T) {
+	current := &configapi.MasterConfig{
+		MasterPublicURL:    "https://current.example.com:8443",
+		CORSAllowedOrigins: []string{"localhost"},
+		OAuthConfig: &configapi.OAuthConfig{
+			MasterPublicURL: "https://current.example.com:8443",
+		},
+	}
+	updated := &configapi.MasterConfig{
+		MasterPublicURL:    "https://updated.example.com:8443",
+		CORSAllowedOrigins: []string{"localhost", "example.com"},
+		OAuthConfig: &configapi.OAuthConfig{
+			MasterPublicURL: "https://updated.example.com:8443",
+			IdentityProviders: []configapi.IdentityProvider{
+				{Name: "anypassword", Provider: &configapi.AllowAllPasswordIdentityProvider{}},
+			},
+		},
+	}
+
+	merged := mergeDynamicFields(current, updated)
+	if merged.MasterPublicURL != current.MasterPublicURL {
+		t.Errorf("expected static masterPublicURL to be preserved, got %q", merged.MasterPublicURL)
+	}
+	if merged.OAuthConfig.MasterPublicURL != current.OAuthConfig.MasterPublicURL {
+		t.Errorf("expected static oauthConfig.masterPublicURL to be preserved, got %q", merged.OAuthConfig.MasterPublicURL)
+	}
+	if !reflect.DeepEqual(merged.CORSAllowedOrigins, updated.CORSAllowedOrigins) {
+		t.Errorf("expected corsAllowedOrigins to be updated, got %v", merged.CORSAllowedOrigins)
+	}
+	if !reflect.DeepEqual(merged.OAuthConfig.IdentityProviders, updated.OAuthConfig.IdentityProviders) {
+		t.Errorf("expected identity providers to be updated, got %v", merged.OAuthConfig.IdentityProviders)
+	}
+}
@@ -289,6 +289,9 @@ func (o MasterOptions) RunMaster() error {
 		api:         o.MasterArgs.StartAPI,
 		controllers: o.MasterArgs.StartControllers,
 	}
+	if startUsingConfigFile {
+		m.configFile = o.ConfigFile
+	}
 	return m.Start()
 }
 
@@ -333,6 +336,8 @@ type Master struct {
 	config      *configapi.MasterConfig
 	controllers bool
 	api         bool
+	// configFile, if set, is watched for dynamic configuration changes
+	configFile string
 }
 
 // NewMaster create a master launcher
@@ -466,6 +471,12 @@ func (m *Master) Start() error {
 		if err := StartAPI(openshiftConfig); err != nil {
 			return err
 		}
+
+		// when running from a config file, pick up changes to dynamic fields
+		// without a restart
+		if len(m.configFile) > 0 {
+			NewConfigObserver(m.configFile, m.config).Run(utilwait.NeverStop)
+		}
 	}
 
 	return nil
@@ -25,11 +25,14 @@ func SetDefaults_ImagePolicyConfig(obj *ImagePolicyConfig) {
 			{TargetResource: GroupResource{Resource: "pods"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "build.openshift.io", Resource: "builds"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "batch", Resource: "jobs"}, LocalNames: true},
+			{TargetResource: GroupResource{Group: "batch", Resource: "cronjobs"}, LocalNames: true},
+			{TargetResource: GroupResource{Group: "apps", Resource: "replicasets"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "extensions", Resource: "replicasets"}, LocalNames: true},
 			{TargetResource: GroupResource{Resource: "replicationcontrollers"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "apps", Resource: "deployments"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "extensions", Resource: "deployments"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "apps", Resource: "statefulsets"}, LocalNames: true},
+			{TargetResource: GroupResource{Group: "apps", Resource: "daemonsets"}, LocalNames: true},
 			{TargetResource: GroupResource{Group: "extensions", Resource: "daemonsets"}, LocalNames: true},
 		}
 		// default the resolution policy to the global default
@@ -42,6 +42,9 @@ var (
 	Attempt ImageResolutionType = "Attempt"
 	// don't attempt resolution
 	DoNotAttempt ImageResolutionType = "DoNotAttempt"
+	// attempt resolution and rewrite images that reference the integrated registry by tag
+	// into their current digest, leaving all other images untouched
+	PinDigest ImageResolutionType = "PinDigest"
 )
 
 // ImageResolutionPolicyRule describes resolution rules based on resource.
@@ -105,6 +108,14 @@ type ImageCondition struct {
 	// MatchDockerImageLabels checks against the resolved image for the presence of a Docker label. All
 	// conditions must match.
 	MatchDockerImageLabels []ValueCondition `json:"matchDockerImageLabels"`
+	// MatchMissingDockerImageLabels matches images that do not satisfy one or more of the provided
+	// Docker label conditions. Combined with reject, this requires labels (for example
+	// security-scan=passed) on every image.
+	MatchMissingDockerImageLabels []ValueCondition `json:"matchMissingDockerImageLabels"`
+	// MatchImageOlderThan matches images whose Docker image metadata records a creation time older
+	// than the provided duration. Images without a creation time never match. Combined with reject,
+	// this enforces a maximum image age.
+	MatchImageOlderThan *metav1.Duration `json:"matchImageOlderThan"`
 	// MatchImageLabels checks against the resolved image for a label. All conditions must match.
 	MatchImageLabels []metav1.LabelSelector `json:"matchImageLabels"`
 	// MatchImageAnnotations checks against the resolved image for an annotation. All conditions must match.
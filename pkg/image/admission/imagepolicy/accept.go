@@ -18,6 +18,7 @@ import (
 )
 
 var errRejectByPolicy = fmt.Errorf("this image is prohibited by policy")
+var errRejectByProjectPolicy = fmt.Errorf("this image's registry is prohibited by project policy")
 
 type policyDecisions map[kapi.ObjectReference]policyDecision
 
@@ -27,13 +28,12 @@ type policyDecision struct {
 	resolutionErr error
 }
 
-func accept(accepter rules.Accepter, policy imageResolutionPolicy, resolver imageResolver, m meta.ImageReferenceMutator, annotations meta.AnnotationAccessor, attr admission.Attributes, excludedRules sets.String) error {
+func accept(accepter rules.Accepter, policy imageResolutionPolicy, resolver imageResolver, m meta.ImageReferenceMutator, annotations meta.AnnotationAccessor, attr admission.Attributes, excludedRules sets.String, resolveAllNames bool, restrictions registryRestrictions) error {
 	decisions := policyDecisions{}
 
 	gr := attr.GetResource().GroupResource()
 
-	var resolveAllNames bool
-	if annotations != nil {
+	if annotations != nil && !resolveAllNames {
 		if a, ok := annotations.TemplateAnnotations(); ok {
 			resolveAllNames = a[imagepolicy.ResolveNamesAnnotation] == "*"
 		}
@@ -92,6 +92,17 @@ func accept(accepter rules.Accepter, policy imageResolutionPolicy, resolver imag
 
 		// we only need to test a given input once for acceptance
 		if !decision.tested {
+			// the project may restrict which registries its workloads can reference,
+			// independent of the cluster wide execution rules
+			if !restrictions.Empty() && len(decision.attrs.Name.Name) > 0 {
+				if registry := decision.attrs.Name.DockerClientDefaults().Registry; !restrictions.Allows(registry) {
+					glog.V(5).Infof("registry %q for %v is prohibited by project policy", registry, ref)
+					decision.tested = true
+					decisions[*ref] = decision
+					return errRejectByProjectPolicy
+				}
+			}
+
 			accepted := accepter.Accepts(decision.attrs)
 			glog.V(5).Infof("Made decision for %v (as: %v, resolution err: %v): accept=%t", ref, decision.attrs.Name, decision.resolutionErr, accepted)
 
@@ -118,7 +129,7 @@ func accept(accepter rules.Accepter, policy imageResolutionPolicy, resolver imag
 
 	for i := range errs {
 		errs[i].Type = field.ErrorTypeForbidden
-		if errs[i].Detail != errRejectByPolicy.Error() {
+		if errs[i].Detail != errRejectByPolicy.Error() && errs[i].Detail != errRejectByProjectPolicy.Error() {
 			errs[i].Detail = fmt.Sprintf("this image is prohibited by policy: %s", errs[i].Detail)
 		}
 	}
@@ -215,17 +215,24 @@ func (a *imagePolicyPlugin) admit(attr admission.Attributes, mutationAllowed boo
 
 	annotations, _ := meta.GetAnnotationAccessor(attr.GetObject())
 
-	// load exclusion rules from the namespace cache
+	// load exclusion rules, the local name resolution default, and any registry
+	// restrictions from the namespace cache
 	var excluded sets.String
+	var resolveAllNames bool
+	var restrictions registryRestrictions
 	if ns := attr.GetNamespace(); len(ns) > 0 {
 		if ns, err := a.projectCache.GetNamespace(ns); err == nil {
 			if value := ns.Annotations[imagepolicy.IgnorePolicyRulesAnnotation]; len(value) > 0 {
 				excluded = sets.NewString(strings.Split(value, ",")...)
 			}
+			// a namespace may opt every workload it contains into local name resolution so
+			// that individual objects do not have to carry the annotation themselves
+			resolveAllNames = ns.Annotations[imagepolicy.ResolveNamesAnnotation] == "*"
+			restrictions = registryRestrictionsForNamespace(ns)
 		}
 	}
 
-	if err := accept(a.accepter, policy, a.resolver, m, annotations, attr, excluded); err != nil {
+	if err := accept(a.accepter, policy, a.resolver, m, annotations, attr, excluded, resolveAllNames, restrictions); err != nil {
 		return err
 	}
 
@@ -292,7 +299,9 @@ func (c *imageResolutionCache) ResolveObjectReference(ref *kapi.ObjectReference,
 		if !ok {
 			return &rules.ImagePolicyAttributes{IntegratedRegistry: true}, fmt.Errorf("references of kind ImageStreamTag must be of the form NAME:TAG")
 		}
-		return c.resolveImageStreamTag(ns, name, tag, false, false)
+		attrs, err := c.resolveImageStreamTag(ns, name, tag, false, false)
+		attrs.ReferencedByTag = true
+		return attrs, err
 
 	case "ImageStreamImage":
 		ns := ref.Namespace
@@ -358,7 +367,9 @@ func (c *imageResolutionCache) resolveImageReference(ref imageapi.DockerImageRef
 		ref.Namespace = defaultNamespace
 	}
 
-	return c.resolveImageStreamTag(ref.Namespace, ref.Name, tag, partialReference, forceResolveLocalNames)
+	attrs, err := c.resolveImageStreamTag(ref.Namespace, ref.Name, tag, partialReference, forceResolveLocalNames)
+	attrs.ReferencedByTag = true
+	return attrs, err
 }
 
 // resolveImageStreamTag loads an image stream tag and creates a fully qualified image stream image reference,
@@ -461,6 +472,9 @@ func (config resolutionConfig) Covers(gr schema.GroupResource) bool {
 
 // RequestsResolution is true if the policy demands it or if any rule covers it.
 func (config resolutionConfig) RequestsResolution(gr schema.GroupResource) bool {
+	if config.config.ResolveImages == imagepolicy.PinDigest {
+		return true
+	}
 	if imagepolicy.RequestsResolution(config.config.ResolveImages) {
 		return true
 	}
@@ -474,6 +488,10 @@ func (config resolutionConfig) RequestsResolution(gr schema.GroupResource) bool
 
 // FailOnResolutionFailure does not depend on the nested rules.
 func (config resolutionConfig) FailOnResolutionFailure(gr schema.GroupResource) bool {
+	// PinDigest only rewrites what it can resolve and never rejects the object
+	if config.config.ResolveImages == imagepolicy.PinDigest {
+		return false
+	}
 	return imagepolicy.FailOnResolutionFailure(config.config.ResolveImages)
 }
 
@@ -505,6 +523,13 @@ func (config resolutionConfig) RewriteImagePullSpec(attr *rules.ImagePolicyAttri
 		if rule.LocalNames && attr.LocalRewrite {
 			return true
 		}
+		if rule.Policy == imagepolicy.PinDigest {
+			if pinsDigest(attr) {
+				return true
+			}
+			hasMatchingRule = true
+			continue
+		}
 		if imagepolicy.RewriteImagePullSpec(rule.Policy) {
 			return true
 		}
@@ -513,9 +538,18 @@ func (config resolutionConfig) RewriteImagePullSpec(attr *rules.ImagePolicyAttri
 	if hasMatchingRule {
 		return false
 	}
+	if config.config.ResolveImages == imagepolicy.PinDigest {
+		return pinsDigest(attr)
+	}
 	return imagepolicy.RewriteImagePullSpec(config.config.ResolveImages)
 }
 
+// pinsDigest returns true if the resolved attributes describe an image that was referenced
+// by tag in the integrated registry and resolved to an exact digest.
+func pinsDigest(attr *rules.ImagePolicyAttributes) bool {
+	return attr.ReferencedByTag && attr.IntegratedRegistry && len(attr.Name.ID) > 0
+}
+
 // resolutionRuleCoversResource implements wildcard checking on Resource names
 func resolutionRuleCoversResource(rule metav1.GroupResource, gr schema.GroupResource) bool {
 	return rule.Group == gr.Group && (rule.Resource == gr.Resource || rule.Resource == "*")
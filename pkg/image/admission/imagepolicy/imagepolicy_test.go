@@ -1466,6 +1466,94 @@ func TestResolutionConfig(t *testing.T) {
 			resolve:  true,
 			rewrite:  false,
 		},
+		// global PinDigest rewrites integrated registry tag references that resolved to a digest
+		{
+			attrs: rules.ImagePolicyAttributes{
+				ReferencedByTag:    true,
+				IntegratedRegistry: true,
+				Name:               imageapi.DockerImageReference{Namespace: "test", Name: "mysql", ID: "sha256:a0123456789"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.PinDigest,
+			},
+			resolve: true,
+			fail:    false,
+			rewrite: true,
+		},
+		// global PinDigest leaves non-integrated registry references untouched
+		{
+			attrs: rules.ImagePolicyAttributes{
+				ReferencedByTag: true,
+				Name:            imageapi.DockerImageReference{Registry: "docker.io", Name: "mysql", ID: "sha256:a0123456789"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.PinDigest,
+			},
+			resolve: true,
+			fail:    false,
+			rewrite: false,
+		},
+		// global PinDigest leaves references that did not resolve to a digest untouched
+		{
+			attrs: rules.ImagePolicyAttributes{
+				ReferencedByTag:    true,
+				IntegratedRegistry: true,
+				Name:               imageapi.DockerImageReference{Namespace: "test", Name: "mysql", Tag: "latest"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.PinDigest,
+			},
+			resolve: true,
+			fail:    false,
+			rewrite: false,
+		},
+		// global PinDigest does not rewrite references that were already by digest
+		{
+			attrs: rules.ImagePolicyAttributes{
+				IntegratedRegistry: true,
+				Name:               imageapi.DockerImageReference{Namespace: "test", Name: "mysql", ID: "sha256:a0123456789"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.PinDigest,
+			},
+			resolve: true,
+			fail:    false,
+			rewrite: false,
+		},
+		// rule level PinDigest rewrites covered resources only
+		{
+			attrs: rules.ImagePolicyAttributes{
+				ReferencedByTag:    true,
+				IntegratedRegistry: true,
+				Name:               imageapi.DockerImageReference{Namespace: "test", Name: "mysql", ID: "sha256:a0123456789"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.DoNotAttempt,
+				ResolutionRules: []imagepolicy.ImageResolutionPolicyRule{
+					{Policy: imagepolicy.PinDigest, TargetResource: metav1.GroupResource{Resource: "pods"}},
+				},
+			},
+			resource: schema.GroupResource{Resource: "pods"},
+			resolve:  true,
+			rewrite:  true,
+		},
+		// rule level PinDigest does not rewrite unresolved references
+		{
+			attrs: rules.ImagePolicyAttributes{
+				ReferencedByTag:    true,
+				IntegratedRegistry: true,
+				Name:               imageapi.DockerImageReference{Namespace: "test", Name: "mysql", Tag: "latest"},
+			},
+			config: &imagepolicy.ImagePolicyConfig{
+				ResolveImages: imagepolicy.DoNotAttempt,
+				ResolutionRules: []imagepolicy.ImageResolutionPolicyRule{
+					{Policy: imagepolicy.PinDigest, TargetResource: metav1.GroupResource{Resource: "pods"}},
+				},
+			},
+			resource: schema.GroupResource{Resource: "pods"},
+			resolve:  true,
+			rewrite:  false,
+		},
 	}
 
 	for i, test := range testCases {
@@ -0,0 +1,70 @@
+package imagepolicy
+
+import (
+	"path"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+)
+
+const (
+	// AllowedRegistriesAnnotation may be set on a namespace to a comma separated
+	// list of registry host patterns (path.Match syntax) that workloads in the
+	// namespace may pull from. An empty or absent annotation allows all
+	// registries permitted by the cluster wide policy.
+	AllowedRegistriesAnnotation = "images.openshift.io/allowed-registries"
+	// BlockedRegistriesAnnotation may be set on a namespace to a comma separated
+	// list of registry host patterns (path.Match syntax) that workloads in the
+	// namespace may not pull from. It is applied after the allowed list.
+	BlockedRegistriesAnnotation = "images.openshift.io/blocked-registries"
+)
+
+// registryRestrictions holds the per project registry rules loaded from
+// namespace annotations. The zero value allows every registry.
+type registryRestrictions struct {
+	allowed []string
+	blocked []string
+}
+
+// registryRestrictionsForNamespace parses the registry restriction annotations
+// of the given namespace.
+func registryRestrictionsForNamespace(ns *kapi.Namespace) registryRestrictions {
+	return registryRestrictions{
+		allowed: splitRegistryPatterns(ns.Annotations[AllowedRegistriesAnnotation]),
+		blocked: splitRegistryPatterns(ns.Annotations[BlockedRegistriesAnnotation]),
+	}
+}
+
+// Allows returns true if the registry host is admitted by the allowed patterns
+// and not rejected by the blocked patterns.
+func (r registryRestrictions) Allows(registry string) bool {
+	if len(r.allowed) > 0 && !matchesAnyRegistryPattern(r.allowed, registry) {
+		return false
+	}
+	return !matchesAnyRegistryPattern(r.blocked, registry)
+}
+
+// Empty returns true if no per project rules are set.
+func (r registryRestrictions) Empty() bool {
+	return len(r.allowed) == 0 && len(r.blocked) == 0
+}
+
+func splitRegistryPatterns(value string) []string {
+	patterns := []string{}
+	for _, pattern := range strings.Split(value, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) > 0 {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+func matchesAnyRegistryPattern(patterns []string, registry string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, registry); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
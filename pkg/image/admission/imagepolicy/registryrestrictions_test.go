@@ -0,0 +1,75 @@
+package imagepolicy
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+)
+
+func TestRegistryRestrictions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		empty       bool
+		allowed     []string
+		denied      []string
+	}{
+		{
+			name:    "no annotations allow everything",
+			empty:   true,
+			allowed: []string{"docker.io", "myregistry.com:5000", "172.30.0.1:5000"},
+		},
+		{
+			name:        "allowed list restricts to matching registries",
+			annotations: map[string]string{AllowedRegistriesAnnotation: "172.30.0.1:5000,registry.access.redhat.com"},
+			allowed:     []string{"172.30.0.1:5000", "registry.access.redhat.com"},
+			denied:      []string{"docker.io", "myregistry.com:5000"},
+		},
+		{
+			name:        "allowed list supports patterns",
+			annotations: map[string]string{AllowedRegistriesAnnotation: "*.redhat.com"},
+			allowed:     []string{"registry.redhat.com"},
+			denied:      []string{"docker.io"},
+		},
+		{
+			name:        "blocked list rejects matching registries",
+			annotations: map[string]string{BlockedRegistriesAnnotation: "docker.io"},
+			allowed:     []string{"172.30.0.1:5000", "myregistry.com:5000"},
+			denied:      []string{"docker.io"},
+		},
+		{
+			name: "blocked list is applied after allowed list",
+			annotations: map[string]string{
+				AllowedRegistriesAnnotation: "*.example.com",
+				BlockedRegistriesAnnotation: "evil.example.com",
+			},
+			allowed: []string{"good.example.com"},
+			denied:  []string{"evil.example.com", "docker.io"},
+		},
+		{
+			name:        "whitespace and empty entries are ignored",
+			annotations: map[string]string{AllowedRegistriesAnnotation: " docker.io , ,"},
+			allowed:     []string{"docker.io"},
+			denied:      []string{"myregistry.com:5000"},
+		},
+	}
+	for _, test := range testCases {
+		restrictions := registryRestrictionsForNamespace(&kapi.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: test.annotations},
+		})
+		if restrictions.Empty() != test.empty {
+			t.Errorf("%s: Empty() = %t, expected %t", test.name, restrictions.Empty(), test.empty)
+		}
+		for _, registry := range test.allowed {
+			if !restrictions.Allows(registry) {
+				t.Errorf("%s: expected %q to be allowed", test.name, registry)
+			}
+		}
+		for _, registry := range test.denied {
+			if restrictions.Allows(registry) {
+				t.Errorf("%s: expected %q to be denied", test.name, registry)
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -257,6 +258,29 @@ func TestAccept(t *testing.T) {
 				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Config: &imageapi.DockerConfig{Labels: map[string]string{"label2": "value1"}}}}}, false},
 			},
 		},
+		"rejects images missing required docker image labels": {
+			matcher: NewRegistryMatcher([]string{"myregistry.io:5000"}),
+			rules: []imagepolicy.ImageExecutionPolicyRule{
+				{Reject: true, ImageCondition: imagepolicy.ImageCondition{OnResources: []schema.GroupResource{podResource}, MatchMissingDockerImageLabels: []imagepolicy.ValueCondition{{Key: "security-scan", Value: "passed"}}}},
+			},
+			accepts: []acceptResult{
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Config: &imageapi.DockerConfig{Labels: map[string]string{"security-scan": "passed"}}}}}, true},
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Config: &imageapi.DockerConfig{Labels: map[string]string{"security-scan": "failed"}}}}}, false},
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{}}, false},
+			},
+		},
+		"rejects images older than the rule duration": {
+			matcher: NewRegistryMatcher([]string{"myregistry.io:5000"}),
+			rules: []imagepolicy.ImageExecutionPolicyRule{
+				{Reject: true, ImageCondition: imagepolicy.ImageCondition{OnResources: []schema.GroupResource{podResource}, MatchImageOlderThan: &metav1.Duration{Duration: 24 * time.Hour}}},
+			},
+			accepts: []acceptResult{
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Created: metav1.Time{Time: time.Now().Add(-48 * time.Hour)}}}}, false},
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Created: metav1.Time{Time: time.Now().Add(-time.Hour)}}}}, true},
+				// images with no creation time are never treated as old
+				{ImagePolicyAttributes{Resource: podResource, Image: &imageapi.Image{}}, true},
+			},
+		},
 		"covers calculations": {
 			rules: []imagepolicy.ImageExecutionPolicyRule{
 				{ImageCondition: imagepolicy.ImageCondition{OnResources: []schema.GroupResource{podResource, {Resource: "services"}}}},
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,6 +21,9 @@ type ImagePolicyAttributes struct {
 	ExcludedRules      sets.String
 	IntegratedRegistry bool
 	LocalRewrite       bool
+	// ReferencedByTag is true when the original reference pointed at a tag rather
+	// than a digest.
+	ReferencedByTag bool
 }
 
 type RegistryMatcher interface {
@@ -73,7 +78,9 @@ func requiresImage(rule *imagepolicy.ImageCondition) bool {
 	switch {
 	case len(rule.MatchImageLabels) > 0,
 		len(rule.MatchImageAnnotations) > 0,
-		len(rule.MatchDockerImageLabels) > 0:
+		len(rule.MatchDockerImageLabels) > 0,
+		len(rule.MatchMissingDockerImageLabels) > 0,
+		rule.MatchImageOlderThan != nil:
 		return true
 	}
 
@@ -129,6 +136,23 @@ func matchImageConditionValues(rule *imagepolicy.ImageCondition, integrated Regi
 			return false
 		}
 	}
+	if len(rule.MatchMissingDockerImageLabels) > 0 {
+		var dockerLabels map[string]string
+		if image.DockerImageMetadata.Config != nil {
+			dockerLabels = image.DockerImageMetadata.Config.Labels
+		}
+		if matchKeyValue(dockerLabels, rule.MatchMissingDockerImageLabels) {
+			glog.V(5).Infof("image labels %#v satisfy all required labels %#v", dockerLabels, rule.MatchMissingDockerImageLabels)
+			return false
+		}
+	}
+	if rule.MatchImageOlderThan != nil {
+		created := image.DockerImageMetadata.Created
+		if created.IsZero() || time.Since(created.Time) <= rule.MatchImageOlderThan.Duration {
+			glog.V(5).Infof("image created %v is not older than %v", created, rule.MatchImageOlderThan.Duration)
+			return false
+		}
+	}
 	if !matchKeyValue(image.Annotations, rule.MatchImageAnnotations) {
 		glog.V(5).Infof("image annotations %#v do not match rule annotations %#v", image.Annotations, rule.MatchImageAnnotations)
 		return false
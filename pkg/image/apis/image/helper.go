@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
@@ -477,6 +478,35 @@ func LatestTaggedImage(stream *ImageStream, tag string) *TagEvent {
 	return nil
 }
 
+// FilterPropagatedAnnotations returns the subset of annotations that the
+// propagation policy of stream accepts on incoming tags. An unset policy or
+// the value "all" accepts every annotation, "none" accepts no annotations,
+// and any other value is treated as a comma separated list of annotation key
+// patterns in path.Match syntax.
+func FilterPropagatedAnnotations(stream *ImageStream, annotations map[string]string) map[string]string {
+	policy := stream.Annotations[TagPropagationPolicyAnnotation]
+	switch policy {
+	case "", "all":
+		return annotations
+	case "none":
+		return nil
+	}
+	patterns := strings.Split(policy, ",")
+	filtered := map[string]string{}
+	for key, value := range annotations {
+		for _, pattern := range patterns {
+			if ok, err := path.Match(strings.TrimSpace(pattern), key); err == nil && ok {
+				filtered[key] = value
+				break
+			}
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
 // ResolveLatestTaggedImage returns the appropriate pull spec for a given tag in
 // the image stream, handling the tag's reference policy if necessary to return
 // a resolved image. Callers that transform an ImageStreamTag into a pull spec
@@ -1631,3 +1631,63 @@ func TestFollowTagReference(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterPropagatedAnnotations(t *testing.T) {
+	annotations := map[string]string{
+		"scan.example.com/result": "passed",
+		"scan.example.com/date":   "2018-02-20",
+		"build.example.com/ref":   "abc123",
+	}
+	tests := []struct {
+		name     string
+		policy   string
+		expected map[string]string
+	}{
+		{
+			name:     "no policy accepts everything",
+			policy:   "",
+			expected: annotations,
+		},
+		{
+			name:     "all accepts everything",
+			policy:   "all",
+			expected: annotations,
+		},
+		{
+			name:     "none rejects everything",
+			policy:   "none",
+			expected: nil,
+		},
+		{
+			name:   "pattern list filters keys",
+			policy: "scan.example.com/*",
+			expected: map[string]string{
+				"scan.example.com/result": "passed",
+				"scan.example.com/date":   "2018-02-20",
+			},
+		},
+		{
+			name:   "multiple patterns",
+			policy: "scan.example.com/result, build.example.com/*",
+			expected: map[string]string{
+				"scan.example.com/result": "passed",
+				"build.example.com/ref":   "abc123",
+			},
+		},
+		{
+			name:     "no pattern matches",
+			policy:   "provenance.example.com/*",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		stream := &ImageStream{}
+		if len(test.policy) > 0 {
+			stream.Annotations = map[string]string{TagPropagationPolicyAnnotation: test.policy}
+		}
+		filtered := FilterPropagatedAnnotations(stream, annotations)
+		if !reflect.DeepEqual(filtered, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, filtered)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package digest
+
+import godigest "github.com/opencontainers/go-digest"
+
+type Digest = godigest.Digest
+
+func ParseDigest(s string) (Digest, error) { return godigest.Parse(s) }
@@ -0,0 +1,21 @@
+package reference
+
+import distref "github.com/docker/distribution/reference"
+
+const NameTotalLengthMax = distref.NameTotalLengthMax
+
+type Named = distref.Named
+type NamedTagged = distref.NamedTagged
+type Canonical = distref.Canonical
+
+func ParseNamed(s string) (Named, error) {
+	ref, err := distref.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	named, ok := ref.(distref.Named)
+	if !ok {
+		return nil, distref.ErrReferenceInvalidFormat
+	}
+	return named, nil
+}
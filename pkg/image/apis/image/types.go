@@ -51,10 +51,45 @@ const (
 	// downconverted.
 	ImporterPreferOSAnnotation = "importer.image.openshift.io/prefer-os"
 
+	// ImporterImportFilterAnnotation may be set on an image stream to an RE2
+	// regular expression (e.g. ^v\d+\.\d+\.\d+$). When the whole upstream
+	// repository is imported, only tags matching the expression are imported,
+	// keeping prerelease or nightly upstream tags out of the stream.
+	ImporterImportFilterAnnotation = "importer.image.openshift.io/import-filter"
+
 	// ImageManifestBlobStoredAnnotation indicates that manifest and config blobs of image are stored in on
 	// storage of integrated Docker registry.
 	ImageManifestBlobStoredAnnotation = "image.openshift.io/manifestBlobStored"
 
+	// MovedToAnnotation is set on an image stream whose repository has been
+	// moved to a new namespace/name. While present, the integrated registry
+	// serves redirects for pulls of the old repository to the new location.
+	MovedToAnnotation = "image.openshift.io/moved-to"
+
+	// MovedFromAnnotation is set on an image stream that was created by moving
+	// a repository, and records the previous namespace/name.
+	MovedFromAnnotation = "image.openshift.io/moved-from"
+
+	// TagPropagationPolicyAnnotation may be set on an image stream to control
+	// which tag annotations are accepted when images are promoted into the
+	// stream with `oc tag --copy-annotations`. The value is "all" (the
+	// default when unset), "none", or a comma separated list of annotation
+	// key patterns in path.Match syntax.
+	TagPropagationPolicyAnnotation = "image.openshift.io/tag-propagation-policy"
+
+	// ImageStreamTagExpectedDigestAnnotation may be set on an image stream tag
+	// submitted for update to make the update conditional: it is compared to the
+	// digest the tag currently resolves to and the update is rejected with a
+	// conflict on mismatch. An empty value requires that the tag does not yet
+	// resolve to an image. The annotation is consumed by the API server and is
+	// not persisted.
+	ImageStreamTagExpectedDigestAnnotation = "image.openshift.io/expected-digest"
+
+	// MovedRedirectExpiresAnnotation holds the RFC3339 time after which the
+	// integrated registry stops serving redirects for a moved repository and
+	// the old image stream can be deleted.
+	MovedRedirectExpiresAnnotation = "image.openshift.io/moved-redirect-expires"
+
 	// DefaultImageTag is used when an image tag is needed and the configuration does not specify a tag to use.
 	DefaultImageTag = "latest"
 
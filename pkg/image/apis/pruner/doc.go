@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package,register
+
+// Package pruner is the internal version of the API.
+package pruner
@@ -0,0 +1,37 @@
+package pruner
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImagePrunerConfig configures the cluster image pruner controller. The controller replaces
+// manually running `oc adm prune images` for API objects: on every interval it removes images
+// and image stream tag revisions that are no longer referenced by any workload, honoring the
+// keep policies below. Registry blob data is not removed by the controller and should be
+// reclaimed with the registry's hard prune.
+type ImagePrunerConfig struct {
+	metav1.TypeMeta
+
+	// Suspend stops scheduled prune runs without discarding the configuration.
+	Suspend bool
+
+	// Interval is the amount of time between prune runs.
+	Interval metav1.Duration
+
+	// KeepYoungerThan is the minimum age an image must be to be considered for pruning.
+	KeepYoungerThan metav1.Duration
+
+	// KeepTagRevisions is the number of revisions per tag in an image stream's status.tags
+	// that are preserved and ineligible for pruning.
+	KeepTagRevisions int
+
+	// PruneOverSizeLimit prunes images exceeding the openshift.io/Image limits defined in
+	// their namespace. It may not be combined with the keep policies above.
+	PruneOverSizeLimit bool
+
+	// AllImages considers all images for pruning, not only those pushed directly to the
+	// integrated registry.
+	AllImages bool
+}
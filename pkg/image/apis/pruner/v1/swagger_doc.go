@@ -0,0 +1,20 @@
+package v1
+
+// This file contains methods that can be used by the go-restful package to generate Swagger
+// documentation for the object types found in 'types.go' This file is automatically generated
+// by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
+// ==== DO NOT EDIT THIS FILE MANUALLY ====
+
+var map_ImagePrunerConfig = map[string]string{
+	"":                   "ImagePrunerConfig configures the cluster image pruner controller. The controller replaces manually running `oc adm prune images` for API objects: on every interval it removes images and image stream tag revisions that are no longer referenced by any workload, honoring the keep policies below. Registry blob data is not removed by the controller and should be reclaimed with the registry's hard prune.",
+	"suspend":            "Suspend stops scheduled prune runs without discarding the configuration.",
+	"interval":           "Interval is the amount of time between prune runs.",
+	"keepYoungerThan":    "KeepYoungerThan is the minimum age an image must be to be considered for pruning.",
+	"keepTagRevisions":   "KeepTagRevisions is the number of revisions per tag in an image stream's status.tags that are preserved and ineligible for pruning.",
+	"pruneOverSizeLimit": "PruneOverSizeLimit prunes images exceeding the openshift.io/Image limits defined in their namespace. It may not be combined with the keep policies above.",
+	"allImages":          "AllImages considers all images for pruning, not only those pushed directly to the integrated registry.",
+}
+
+func (ImagePrunerConfig) SwaggerDoc() map[string]string {
+	return map_ImagePrunerConfig
+}
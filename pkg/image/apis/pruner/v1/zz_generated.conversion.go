@@ -0,0 +1,54 @@
+// +build !ignore_autogenerated_openshift
+
+// This file was autogenerated by conversion-gen. Do not edit it manually!
+
+package v1
+
+import (
+	pruner "github.com/openshift/origin/pkg/image/apis/pruner"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(scheme *runtime.Scheme) error {
+	return scheme.AddGeneratedConversionFuncs(
+		Convert_v1_ImagePrunerConfig_To_pruner_ImagePrunerConfig,
+		Convert_pruner_ImagePrunerConfig_To_v1_ImagePrunerConfig,
+	)
+}
+
+func autoConvert_v1_ImagePrunerConfig_To_pruner_ImagePrunerConfig(in *ImagePrunerConfig, out *pruner.ImagePrunerConfig, s conversion.Scope) error {
+	out.Suspend = in.Suspend
+	out.Interval = in.Interval
+	out.KeepYoungerThan = in.KeepYoungerThan
+	out.KeepTagRevisions = in.KeepTagRevisions
+	out.PruneOverSizeLimit = in.PruneOverSizeLimit
+	out.AllImages = in.AllImages
+	return nil
+}
+
+// Convert_v1_ImagePrunerConfig_To_pruner_ImagePrunerConfig is an autogenerated conversion function.
+func Convert_v1_ImagePrunerConfig_To_pruner_ImagePrunerConfig(in *ImagePrunerConfig, out *pruner.ImagePrunerConfig, s conversion.Scope) error {
+	return autoConvert_v1_ImagePrunerConfig_To_pruner_ImagePrunerConfig(in, out, s)
+}
+
+func autoConvert_pruner_ImagePrunerConfig_To_v1_ImagePrunerConfig(in *pruner.ImagePrunerConfig, out *ImagePrunerConfig, s conversion.Scope) error {
+	out.Suspend = in.Suspend
+	out.Interval = in.Interval
+	out.KeepYoungerThan = in.KeepYoungerThan
+	out.KeepTagRevisions = in.KeepTagRevisions
+	out.PruneOverSizeLimit = in.PruneOverSizeLimit
+	out.AllImages = in.AllImages
+	return nil
+}
+
+// Convert_pruner_ImagePrunerConfig_To_v1_ImagePrunerConfig is an autogenerated conversion function.
+func Convert_pruner_ImagePrunerConfig_To_v1_ImagePrunerConfig(in *pruner.ImagePrunerConfig, out *ImagePrunerConfig, s conversion.Scope) error {
+	return autoConvert_pruner_ImagePrunerConfig_To_v1_ImagePrunerConfig(in, out, s)
+}
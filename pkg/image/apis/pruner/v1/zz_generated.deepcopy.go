@@ -0,0 +1,36 @@
+// +build !ignore_autogenerated
+
+// This file was autogenerated by deepcopy-gen. Do not edit it manually!
+
+package v1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrunerConfig) DeepCopyInto(out *ImagePrunerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Interval = in.Interval
+	out.KeepYoungerThan = in.KeepYoungerThan
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrunerConfig.
+func (in *ImagePrunerConfig) DeepCopy() *ImagePrunerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrunerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrunerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
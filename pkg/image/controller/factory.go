@@ -90,6 +90,7 @@ func NewScheduledImageStreamController(client imageclient.Interface, informer im
 		client:       client.Image(),
 		lister:       informer.Lister(),
 		listerSynced: informer.Informer().HasSynced,
+		backoff:      newImportBackoff(importBackoffBase, importBackoffMax),
 	}
 
 	controller.scheduler = newScheduler(opts.Buckets(), bucketLimiter, controller.syncTimed)
@@ -0,0 +1,263 @@
+package prune
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset"
+	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset"
+	configlatest "github.com/openshift/origin/pkg/cmd/server/apis/config/latest"
+	prunerapi "github.com/openshift/origin/pkg/image/apis/pruner"
+	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset"
+	"github.com/openshift/origin/pkg/oc/admin/prune/imageprune"
+
+	_ "github.com/openshift/origin/pkg/image/apis/pruner/install"
+)
+
+const (
+	// PrunerConfigNamespace is the namespace holding the pruner configuration ConfigMap.
+	PrunerConfigNamespace = "openshift-infra"
+	// PrunerConfigName is the name of the ConfigMap holding the serialized ImagePrunerConfig.
+	PrunerConfigName = "cluster-image-pruner"
+	// PrunerConfigKey is the ConfigMap data key holding the configuration.
+	PrunerConfigKey = "config.yaml"
+
+	// LastRunAnnotation records the completion time of the most recent prune run on the
+	// configuration ConfigMap.
+	LastRunAnnotation = "pruner.image.openshift.io/last-run"
+	// LastRunErrorAnnotation records the failure message of the most recent prune run. It is
+	// removed when a run completes successfully.
+	LastRunErrorAnnotation = "pruner.image.openshift.io/last-run-error"
+	// LastRunDeletedImagesAnnotation records how many image API objects the most recent prune
+	// run removed.
+	LastRunDeletedImagesAnnotation = "pruner.image.openshift.io/last-run-deleted-images"
+
+	// pollInterval is how often the controller checks whether a prune run is due.
+	pollInterval = time.Minute
+	// defaultPruneInterval is used when the configuration does not specify an interval.
+	defaultPruneInterval = 24 * time.Hour
+)
+
+// ImagePrunerController runs cluster image pruning on a schedule. The controller is driven by
+// an ImagePrunerConfig serialized into the cluster-image-pruner ConfigMap in openshift-infra:
+// removing the ConfigMap or setting suspend stops pruning, and the last run outcome is
+// reported back as annotations on the ConfigMap. Only API objects are pruned; registry blob
+// data is left to the registry's hard prune.
+type ImagePrunerController struct {
+	kClient     kclientset.Interface
+	imageClient imageclient.Interface
+	appsClient  appsclient.Interface
+	buildClient buildclient.Interface
+}
+
+// NewImagePrunerController creates a new ImagePrunerController.
+func NewImagePrunerController(kClient kclientset.Interface, imageClient imageclient.Interface, appsClient appsclient.Interface, buildClient buildclient.Interface) *ImagePrunerController {
+	return &ImagePrunerController{
+		kClient:     kClient,
+		imageClient: imageClient,
+		appsClient:  appsClient,
+		buildClient: buildClient,
+	}
+}
+
+// Run begins checking for scheduled prune runs until stopCh is closed.
+func (c *ImagePrunerController) Run(stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	glog.Infof("Starting image pruner controller")
+	go wait.Until(c.sync, pollInterval, stopCh)
+	<-stopCh
+	glog.Infof("Shutting down image pruner controller")
+}
+
+// sync runs a prune if the configuration exists, is not suspended, and the configured interval
+// has elapsed since the last recorded run.
+func (c *ImagePrunerController) sync() {
+	config, cm, err := c.readConfig()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to read image pruner configuration: %v", err))
+		return
+	}
+	if config == nil {
+		return
+	}
+	if config.Suspend {
+		glog.V(4).Infof("Image pruner is suspended")
+		return
+	}
+	interval := config.Interval.Duration
+	if interval <= 0 {
+		interval = defaultPruneInterval
+	}
+	if value, ok := cm.Annotations[LastRunAnnotation]; ok {
+		if last, err := time.Parse(time.RFC3339, value); err == nil && time.Since(last) < interval {
+			return
+		}
+	}
+
+	glog.V(2).Infof("Starting scheduled image prune run")
+	summary, runErr := c.prune(config)
+	if runErr != nil {
+		utilruntime.HandleError(fmt.Errorf("image prune run failed: %v", runErr))
+	}
+	if summary != nil {
+		glog.V(2).Infof("Image prune run deleted %d images", summary.DeletedImages)
+	}
+	c.recordRun(cm, summary, runErr)
+}
+
+// readConfig loads the ImagePrunerConfig from the well known ConfigMap. A missing ConfigMap
+// disables the controller and is not an error.
+func (c *ImagePrunerController) readConfig() (*prunerapi.ImagePrunerConfig, *kapi.ConfigMap, error) {
+	cm, err := c.kClient.Core().ConfigMaps(PrunerConfigNamespace).Get(PrunerConfigName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	data, ok := cm.Data[PrunerConfigKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("config map %s/%s has no %s key", PrunerConfigNamespace, PrunerConfigName, PrunerConfigKey)
+	}
+	obj, err := configlatest.ReadYAML(bytes.NewBufferString(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	config, ok := obj.(*prunerapi.ImagePrunerConfig)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected config object %#v", obj)
+	}
+	return config, cm, nil
+}
+
+// recordRun reports the outcome of a prune run as annotations on the configuration ConfigMap.
+func (c *ImagePrunerController) recordRun(cm *kapi.ConfigMap, summary *imageprune.PruneSummary, runErr error) {
+	copied := cm.DeepCopy()
+	if copied.Annotations == nil {
+		copied.Annotations = map[string]string{}
+	}
+	copied.Annotations[LastRunAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if summary != nil {
+		copied.Annotations[LastRunDeletedImagesAnnotation] = fmt.Sprintf("%d", summary.DeletedImages)
+	}
+	if runErr != nil {
+		copied.Annotations[LastRunErrorAnnotation] = runErr.Error()
+	} else {
+		delete(copied.Annotations, LastRunErrorAnnotation)
+	}
+	if _, err := c.kClient.Core().ConfigMaps(copied.Namespace).Update(copied); err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to record prune run on config map %s/%s: %v", copied.Namespace, copied.Name, err))
+	}
+}
+
+// prune gathers the cluster state and removes unreferenced images and image stream tag
+// revisions according to the configured keep policies. It returns a summary of the run for
+// reporting, which may be non-nil even on error.
+func (c *ImagePrunerController) prune(config *prunerapi.ImagePrunerConfig) (*imageprune.PruneSummary, error) {
+	allImages, err := c.imageClient.Image().Images().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allStreams, err := c.imageClient.Image().ImageStreams(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allPods, err := c.kClient.Core().Pods(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allRCs, err := c.kClient.Core().ReplicationControllers(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allBCs, err := c.buildClient.Build().BuildConfigs(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allBuilds, err := c.buildClient.Build().Builds(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allDSs, err := c.kClient.Extensions().DaemonSets(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allDeployments, err := c.kClient.Extensions().Deployments(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allDCs, err := c.appsClient.Apps().DeploymentConfigs(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allRSs, err := c.kClient.Extensions().ReplicaSets(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	limitRangesList, err := c.kClient.Core().LimitRanges(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	limitRangesMap := make(map[string][]*kapi.LimitRange)
+	for i := range limitRangesList.Items {
+		limit := limitRangesList.Items[i]
+		limitRangesMap[limit.Namespace] = append(limitRangesMap[limit.Namespace], &limit)
+	}
+
+	// the controller never talks to the registry, so any reachable-looking URL will do
+	registryURL, err := (&imageprune.DryRunRegistryPinger{}).Ping("docker-registry.default.svc:5000")
+	if err != nil {
+		return nil, err
+	}
+
+	pruneRegistry := false
+	allImagesFlag := config.AllImages
+	options := imageprune.PrunerOptions{
+		AllImages:     &allImagesFlag,
+		PruneRegistry: &pruneRegistry,
+		Images:        allImages,
+		Streams:       allStreams,
+		Pods:          allPods,
+		RCs:           allRCs,
+		BCs:           allBCs,
+		Builds:        allBuilds,
+		DSs:           allDSs,
+		Deployments:   allDeployments,
+		DCs:           allDCs,
+		RSs:           allRSs,
+		LimitRanges:   limitRangesMap,
+		RegistryURL:   registryURL,
+	}
+	if config.PruneOverSizeLimit {
+		overSizeLimit := true
+		options.PruneOverSizeLimit = &overSizeLimit
+	} else {
+		keepYoungerThan := config.KeepYoungerThan.Duration
+		keepTagRevisions := config.KeepTagRevisions
+		options.KeepYoungerThan = &keepYoungerThan
+		options.KeepTagRevisions = &keepTagRevisions
+	}
+
+	pruner, errs := imageprune.NewPruner(options)
+	if errs != nil {
+		return nil, fmt.Errorf("failed to build graph - no changes made: %v", errs)
+	}
+
+	imageDeleter := imageprune.NewImageDeleter(c.imageClient.Image())
+	streamDeleter := imageprune.NewImageStreamDeleter(c.imageClient.Image())
+	layerLinkDeleter := imageprune.NewLayerLinkDeleter()
+	blobDeleter := imageprune.NewBlobDeleter()
+	manifestDeleter := imageprune.NewManifestDeleter()
+
+	return pruner.Prune(imageDeleter, streamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter)
+}
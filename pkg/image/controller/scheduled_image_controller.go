@@ -2,6 +2,8 @@ package controller
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -9,6 +11,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/flowcontrol"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
 
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
@@ -20,6 +23,73 @@ type uniqueItem struct {
 	resourceVersion string
 }
 
+const (
+	// importBackoffBase is the delay applied to a scheduled tag after its first failed import.
+	importBackoffBase = 5 * time.Minute
+	// importBackoffMax caps the exponentially increasing delay between imports of a failing tag.
+	importBackoffMax = 2 * time.Hour
+)
+
+// importBackoff tracks consecutive scheduled import failures per image stream tag and computes
+// an exponentially increasing delay before the tag is imported again. Tags that import
+// successfully are reset immediately.
+type importBackoff struct {
+	base time.Duration
+	max  time.Duration
+
+	lock     sync.Mutex
+	failures map[string]backoffEntry
+}
+
+type backoffEntry struct {
+	count       int
+	generation  int64
+	nextAttempt time.Time
+}
+
+func newImportBackoff(base, max time.Duration) *importBackoff {
+	return &importBackoff{
+		base:     base,
+		max:      max,
+		failures: make(map[string]backoffEntry),
+	}
+}
+
+// Ready returns true if the tag is not currently delayed by a previous failure.
+func (b *importBackoff) Ready(key string, now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	entry, ok := b.failures[key]
+	return !ok || !now.Before(entry.nextAttempt)
+}
+
+// Observe records the outcome of the most recent import of a tag. A failure at a generation
+// that has already been counted does not extend the delay.
+func (b *importBackoff) Observe(key string, failed bool, generation int64, now time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !failed {
+		delete(b.failures, key)
+		return
+	}
+	entry, ok := b.failures[key]
+	if ok && entry.generation == generation {
+		return
+	}
+	entry.count++
+	entry.generation = generation
+	delay := b.base << uint(entry.count-1)
+	if delay > b.max || delay < b.base {
+		delay = b.max
+	}
+	entry.nextAttempt = now.Add(delay)
+	b.failures[key] = entry
+}
+
+func importBackoffKey(stream *imageapi.ImageStream, tag string) string {
+	return stream.Namespace + "/" + stream.Name + ":" + tag
+}
+
 type ScheduledImageStreamController struct {
 	// boolean flag whether this controller is active
 	enabled bool
@@ -37,6 +107,9 @@ type ScheduledImageStreamController struct {
 
 	// scheduler for timely image re-imports
 	scheduler *scheduler
+
+	// backoff delays re-import of tags whose previous imports failed
+	backoff *importBackoff
 }
 
 // Importing is invoked when the controller decides to import a stream in order to push back
@@ -169,21 +242,62 @@ func (s *ScheduledImageStreamController) syncTimedByName(namespace, name string)
 	}
 
 	stream := sharedStream.DeepCopy()
-	resetScheduledTags(stream)
+	now := time.Now()
+	s.observeScheduledImports(sharedStream, now)
+	if resetScheduledTags(stream, s.backoff, now) == 0 {
+		// every scheduled tag is backing off after failed imports
+		return nil
+	}
 
 	glog.V(3).Infof("Scheduled import of stream %s/%s...", stream.Namespace, stream.Name)
 	return handleImageStream(stream, s.client, nil)
 }
 
-// resetScheduledTags artificially increments the generation on the tags that should be imported.
-func resetScheduledTags(stream *imageapi.ImageStream) {
+// observeScheduledImports records the last import outcome of each scheduled tag so that tags
+// which repeatedly fail to import back off exponentially instead of retrying at the full
+// scheduled rate.
+func (s *ScheduledImageStreamController) observeScheduledImports(stream *imageapi.ImageStream, now time.Time) {
+	if s.backoff == nil {
+		return
+	}
+	for tag, tagRef := range stream.Spec.Tags {
+		if !tagImportable(tagRef) || !tagRef.ImportPolicy.Scheduled {
+			continue
+		}
+		failed := false
+		var generation int64
+		for _, condition := range stream.Status.Tags[tag].Conditions {
+			if condition.Type != imageapi.ImportSuccess {
+				continue
+			}
+			if condition.Status == kapi.ConditionFalse {
+				failed = true
+				generation = condition.Generation
+			}
+			break
+		}
+		s.backoff.Observe(importBackoffKey(stream, tag), failed, generation, now)
+	}
+}
+
+// resetScheduledTags artificially increments the generation on the tags that should be imported,
+// skipping tags that are currently backing off after failed imports. It returns the number of
+// tags that will be imported.
+func resetScheduledTags(stream *imageapi.ImageStream, backoff *importBackoff, now time.Time) int {
+	count := 0
 	next := stream.Generation + 1
 	for tag, tagRef := range stream.Spec.Tags {
-		if tagImportable(tagRef) && tagRef.ImportPolicy.Scheduled {
-			tagRef.Generation = &next
-			stream.Spec.Tags[tag] = tagRef
+		if !tagImportable(tagRef) || !tagRef.ImportPolicy.Scheduled {
+			continue
+		}
+		if backoff != nil && !backoff.Ready(importBackoffKey(stream, tag), now) {
+			continue
 		}
+		tagRef.Generation = &next
+		stream.Spec.Tags[tag] = tagRef
+		count++
 	}
+	return count
 }
 
 // needsScheduling returns true if this image stream has any scheduled tags
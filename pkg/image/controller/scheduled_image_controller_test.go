@@ -103,3 +103,85 @@ func TestScheduledImport(t *testing.T) {
 		t.Fatalf("should have not added item to scheduler: %#v", sched.scheduler)
 	}
 }
+
+func TestImportBackoff(t *testing.T) {
+	backoff := newImportBackoff(time.Minute, 4*time.Minute)
+	now := time.Now()
+	key := "other/test:default"
+
+	if !backoff.Ready(key, now) {
+		t.Fatalf("expected tag with no failures to be ready")
+	}
+
+	// first failure delays by the base interval
+	backoff.Observe(key, true, 2, now)
+	if backoff.Ready(key, now) {
+		t.Fatalf("expected tag to back off after a failure")
+	}
+	if !backoff.Ready(key, now.Add(time.Minute)) {
+		t.Fatalf("expected tag to be ready after the base interval")
+	}
+
+	// a repeated failure at the same generation does not extend the delay
+	backoff.Observe(key, true, 2, now.Add(time.Minute))
+	if !backoff.Ready(key, now.Add(time.Minute)) {
+		t.Fatalf("expected repeated observation of the same failure to be a no-op")
+	}
+
+	// a failure at a new generation doubles the delay
+	backoff.Observe(key, true, 3, now)
+	if backoff.Ready(key, now.Add(time.Minute)) {
+		t.Fatalf("expected second failure to double the delay")
+	}
+	if !backoff.Ready(key, now.Add(2*time.Minute)) {
+		t.Fatalf("expected tag to be ready after the doubled interval")
+	}
+
+	// the delay is capped at the maximum
+	for i := int64(4); i < 10; i++ {
+		backoff.Observe(key, true, i, now)
+	}
+	if !backoff.Ready(key, now.Add(4*time.Minute)) {
+		t.Fatalf("expected delay to be capped at the maximum")
+	}
+
+	// success resets the backoff
+	backoff.Observe(key, false, 0, now)
+	if !backoff.Ready(key, now) {
+		t.Fatalf("expected success to reset the backoff")
+	}
+}
+
+func TestResetScheduledTagsSkipsBackoff(t *testing.T) {
+	one := int64(1)
+	stream := &imageapi.ImageStream{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "other", Generation: 1},
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{
+				"ok": {
+					From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:latest"},
+					Generation:   &one,
+					ImportPolicy: imageapi.TagImportPolicy{Scheduled: true},
+				},
+				"failing": {
+					From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:missing"},
+					Generation:   &one,
+					ImportPolicy: imageapi.TagImportPolicy{Scheduled: true},
+				},
+			},
+		},
+	}
+	backoff := newImportBackoff(time.Minute, time.Hour)
+	now := time.Now()
+	backoff.Observe(importBackoffKey(stream, "failing"), true, 1, now)
+
+	if count := resetScheduledTags(stream, backoff, now); count != 1 {
+		t.Fatalf("expected one tag to be imported, got %d", count)
+	}
+	if generation := stream.Spec.Tags["ok"].Generation; generation == nil || *generation != 2 {
+		t.Errorf("expected generation bump for tag not in backoff: %#v", generation)
+	}
+	if generation := stream.Spec.Tags["failing"].Generation; generation == nil || *generation != 1 {
+		t.Errorf("expected no generation bump for backing off tag: %#v", generation)
+	}
+}
@@ -2,6 +2,7 @@ package trigger
 
 import (
 	"fmt"
+	"hash/fnv"
 	"math"
 	"strconv"
 	"strings"
@@ -10,11 +11,14 @@ import (
 	"github.com/golang/glog"
 
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kv1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	kcorelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -54,6 +58,28 @@ type TriggerSource struct {
 	Reactor trigger.ImageReactor
 }
 
+// TriggerRestrictions limits which workloads a trigger controller instance
+// manages so trigger processing can be sharded across controllers or system
+// namespaces can be excluded. Nil selectors place no restriction.
+type TriggerRestrictions struct {
+	// NamespaceSelector is matched against the labels of the namespace that
+	// holds a triggered object. Objects in namespaces that do not match are
+	// ignored.
+	NamespaceSelector labels.Selector
+	// ObjectSelector is matched against the labels of the triggered object
+	// itself. Objects that do not match are ignored.
+	ObjectSelector labels.Selector
+	// Namespaces resolves namespace labels and must be provided when
+	// NamespaceSelector is set.
+	Namespaces kcorelisters.NamespaceLister
+	// Shards is the total number of trigger controller shards. When greater than
+	// one, only objects whose namespace hashes onto Shard are managed by this
+	// instance, allowing trigger processing to scale horizontally.
+	Shards int
+	// Shard is the zero based index of the shard handled by this instance.
+	Shard int
+}
+
 // tagRetriever implements trigger.TagRetriever over an image stream lister.
 type tagRetriever struct {
 	lister imageinternalversion.ImageStreamLister
@@ -121,6 +147,9 @@ type TriggerController struct {
 	triggerCache   cache.ThreadSafeStore
 	triggerSources map[string]TriggerSource
 
+	// restrictions limits which objects this controller instance manages.
+	restrictions TriggerRestrictions
+
 	// To allow injection of syncs for testing.
 	syncImageStreamFn func(key string) error
 	// To allow injection of syncs for testing.
@@ -153,7 +182,7 @@ func NewTriggerEventBroadcaster(client kv1core.CoreV1Interface) record.EventBroa
 }
 
 // NewTriggerController instantiates a trigger controller from the provided sources.
-func NewTriggerController(eventBroadcaster record.EventBroadcaster, isInformer imageinformer.ImageStreamInformer, sources ...TriggerSource) *TriggerController {
+func NewTriggerController(eventBroadcaster record.EventBroadcaster, isInformer imageinformer.ImageStreamInformer, restrictions TriggerRestrictions, sources ...TriggerSource) *TriggerController {
 	lister := isInformer.Lister()
 	c := &TriggerController{
 		eventRecorder:    eventBroadcaster.NewRecorder(legacyscheme.Scheme, v1.EventSource{Component: "image-trigger-controller"}),
@@ -162,6 +191,7 @@ func NewTriggerController(eventBroadcaster record.EventBroadcaster, isInformer i
 		lister:           lister,
 		tagRetriever:     NewTagRetriever(lister),
 		triggerCache:     NewTriggerCache(),
+		restrictions:     restrictions,
 
 		resourceFailureDelayFn: defaultResourceFailureDelay,
 	}
@@ -373,6 +403,52 @@ func (c *TriggerController) syncResource(key string) error {
 	if !exists {
 		return nil
 	}
+	if !c.manages(obj.(runtime.Object)) {
+		glog.V(5).Infof("Resource %q does not match the controller's trigger restrictions, ignoring", key)
+		return nil
+	}
 
 	return source.Reactor.ImageChanged(obj.(runtime.Object), c.tagRetriever)
 }
+
+// manages returns true if the provided object falls within this controller
+// instance's trigger restrictions.
+func (c *TriggerController) manages(obj runtime.Object) bool {
+	if c.restrictions.NamespaceSelector == nil && c.restrictions.ObjectSelector == nil && c.restrictions.Shards <= 1 {
+		return true
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to access object metadata: %v", err))
+		return true
+	}
+	if c.restrictions.Shards > 1 && namespaceShard(accessor.GetNamespace(), c.restrictions.Shards) != c.restrictions.Shard {
+		return false
+	}
+	if c.restrictions.ObjectSelector != nil && !c.restrictions.ObjectSelector.Matches(labels.Set(accessor.GetLabels())) {
+		return false
+	}
+	if c.restrictions.NamespaceSelector != nil {
+		if c.restrictions.Namespaces == nil {
+			utilruntime.HandleError(fmt.Errorf("a namespace lister is required when a namespace selector is configured"))
+			return true
+		}
+		ns, err := c.restrictions.Namespaces.Get(accessor.GetNamespace())
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("unable to retrieve namespace %s: %v", accessor.GetNamespace(), err))
+			return false
+		}
+		if !c.restrictions.NamespaceSelector.Matches(labels.Set(ns.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceShard maps a namespace onto one of shards buckets with a stable hash so that every
+// controller instance agrees on which shard owns a namespace.
+func namespaceShard(namespace string, shards int) int {
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	return int(h.Sum32() % uint32(shards))
+}
@@ -297,7 +297,7 @@ func TestTriggerControllerSyncBuildConfigResource(t *testing.T) {
 			},
 		}
 		inst := fakeBuildConfigInstantiator(test.bc, test.is)
-		reaction := buildconfigs.NewBuildConfigReactor(inst, nil)
+		reaction := buildconfigs.NewBuildConfigReactor(inst, nil, nil)
 		controller := TriggerController{
 			triggerCache: NewTriggerCache(),
 			lister:       lister,
@@ -396,7 +396,7 @@ func TestTriggerControllerSyncBuildConfigResourceErrorHandling(t *testing.T) {
 		if test.err != nil {
 			inst.err = test.err
 		}
-		reaction := buildconfigs.NewBuildConfigReactor(inst, nil)
+		reaction := buildconfigs.NewBuildConfigReactor(inst, nil, nil)
 		controller := TriggerController{
 			triggerCache: NewTriggerCache(),
 			lister:       lister,
@@ -1104,6 +1104,7 @@ func TestTriggerController(t *testing.T) {
 	podReactor := &fakeImageReactor{nested: alterPodFromTriggers(podWatch)}
 	deploymentReactor := &fakeImageReactor{nested: alterDeploymentConfigFromTriggers(dcWatch)}
 	c := NewTriggerController(record.NewBroadcasterForTests(0), &imageStreamInformer{isInformer},
+		TriggerRestrictions{},
 		TriggerSource{
 			Resource: schema.GroupResource{Resource: "buildconfigs"},
 			Informer: bcInformer,
@@ -1350,3 +1351,29 @@ func controllerDrained(c *TriggerController) bool {
 	}
 	return true
 }
+
+func TestTriggerControllerSharding(t *testing.T) {
+	pod := &kapi.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "sharded"}}
+	shard := namespaceShard("sharded", 2)
+
+	owner := &TriggerController{restrictions: TriggerRestrictions{Shards: 2, Shard: shard}}
+	if !owner.manages(pod) {
+		t.Errorf("expected the owning shard to manage the object")
+	}
+
+	other := &TriggerController{restrictions: TriggerRestrictions{Shards: 2, Shard: 1 - shard}}
+	if other.manages(pod) {
+		t.Errorf("expected the other shard to ignore the object")
+	}
+
+	// sharding disabled manages everything
+	unsharded := &TriggerController{restrictions: TriggerRestrictions{}}
+	if !unsharded.manages(pod) {
+		t.Errorf("expected an unsharded controller to manage the object")
+	}
+
+	// the hash must be stable across instances
+	if namespaceShard("sharded", 2) != shard {
+		t.Errorf("expected a stable namespace hash")
+	}
+}
@@ -3,6 +3,7 @@ package importer
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -94,7 +95,7 @@ func (i *ImageStreamImporter) Import(ctx gocontext.Context, isi *imageapi.ImageS
 	}
 
 	i.importImages(ctx, i.retriever, isi, stream, i.limiter)
-	i.importFromRepository(ctx, i.retriever, isi, i.maximumTagsPerRepo, i.limiter)
+	i.importFromRepository(ctx, i.retriever, isi, stream, i.maximumTagsPerRepo, i.limiter)
 	return nil
 }
 
@@ -230,7 +231,7 @@ func (i *ImageStreamImporter) importImages(ctx gocontext.Context, retriever Repo
 // importFromRepository imports the repository named on the ImageStreamImport, if any, importing up to maximumTags, and reporting
 // status on each image that is attempted to be imported. If the repository cannot be found or tags cannot be retrieved, the repository
 // status field is set.
-func (i *ImageStreamImporter) importFromRepository(ctx gocontext.Context, retriever RepositoryRetriever, isi *imageapi.ImageStreamImport, maximumTags int, limiter flowcontrol.RateLimiter) {
+func (i *ImageStreamImporter) importFromRepository(ctx gocontext.Context, retriever RepositoryRetriever, isi *imageapi.ImageStreamImport, stream *imageapi.ImageStream, maximumTags int, limiter flowcontrol.RateLimiter) {
 	if isi.Spec.Repository == nil {
 		return
 	}
@@ -271,6 +272,20 @@ func (i *ImageStreamImporter) importFromRepository(ctx gocontext.Context, retrie
 		Insecure:    spec.ImportPolicy.Insecure,
 		MaximumTags: maximumTags,
 	}
+
+	// the stream may restrict which upstream tags are imported when the whole
+	// repository is requested
+	if stream != nil {
+		if pattern := stream.Annotations[imageapi.ImporterImportFilterAnnotation]; len(pattern) > 0 {
+			filter, err := regexp.Compile(pattern)
+			if err != nil {
+				status.Status = invalidStatus("", field.Invalid(field.NewPath("metadata", "annotations").Key(imageapi.ImporterImportFilterAnnotation), pattern, fmt.Sprintf("invalid import filter: %v", err)))
+				return
+			}
+			repo.ImportFilter = filter
+		}
+	}
+
 	i.importRepositoryFromDocker(ctx, retriever, repo, limiter)
 
 	if repo.Err != nil {
@@ -520,7 +535,7 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 			set.Delete("")
 			set.Insert(imageapi.DefaultImageTag)
 		}
-		tags = set.List()
+		tags = filterImportTags(set.List(), repository.ImportFilter)
 		// include only the top N tags in the result, put the rest in AdditionalTags
 		imageapi.PrioritizeTags(tags)
 		for _, s := range tags {
@@ -624,7 +639,7 @@ func importRepositoryFromDockerV1(ctx gocontext.Context, repository *importRepos
 			set.Delete("")
 			set.Insert(imageapi.DefaultImageTag)
 		}
-		tags = set.List()
+		tags = filterImportTags(set.List(), repository.ImportFilter)
 		// include only the top N tags in the result, put the rest in AdditionalTags
 		imageapi.PrioritizeTags(tags)
 		for _, s := range tags {
@@ -704,9 +719,25 @@ type importRepository struct {
 
 	MaximumTags    int
 	AdditionalTags []string
+	ImportFilter   *regexp.Regexp
 	Err            error
 }
 
+// filterImportTags returns the tags matching the import filter. A nil filter
+// keeps every tag.
+func filterImportTags(tags []string, filter *regexp.Regexp) []string {
+	if filter == nil {
+		return tags
+	}
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if filter.MatchString(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
 // repositoryKey is the key used to cache information loaded from a remote Docker repository.
 type repositoryKey struct {
 	// The URL of the server
@@ -730,12 +761,43 @@ type manifestKey struct {
 func imageImportStatus(err error, kind, position string) metav1.Status {
 	switch t := err.(type) {
 	case kapierrors.APIStatus:
-		return t.Status()
+		return withImportRetryHint(t.Status())
 	case *field.Error:
 		return kapierrors.NewInvalid(imageapi.Kind(kind), position, field.ErrorList{t}).ErrStatus
 	default:
-		return kapierrors.NewInternalError(err).ErrStatus
+		return withImportRetryHint(kapierrors.NewInternalError(err).ErrStatus)
+	}
+}
+
+// withImportRetryHint annotates a failed import status with a suggested retry interval based
+// on the category of the failure. Authorization failures are not retryable until credentials
+// change, missing tags may appear later, and timeouts are usually transient.
+func withImportRetryHint(status metav1.Status) metav1.Status {
+	if status.Status == metav1.StatusSuccess {
+		return status
+	}
+	var seconds int32
+	switch status.Reason {
+	case metav1.StatusReasonUnauthorized, metav1.StatusReasonForbidden:
+		// retrying will not succeed until the credentials used for the import change
+		seconds = 0
+	case metav1.StatusReasonNotFound:
+		seconds = 600
+	case metav1.StatusReasonTimeout, metav1.StatusReasonServerTimeout, metav1.StatusReasonTooManyRequests:
+		seconds = 30
+	default:
+		seconds = 60
+	}
+	if seconds == 0 {
+		return status
+	}
+	if status.Details == nil {
+		status.Details = &metav1.StatusDetails{}
+	}
+	if status.Details.RetryAfterSeconds == 0 {
+		status.Details.RetryAfterSeconds = seconds
 	}
+	return status
 }
 
 func setImageImportStatus(images *imageapi.ImageStreamImport, i int, tag string, err error) {
@@ -236,6 +236,23 @@ func (r *REST) Update(ctx apirequest.Context, tagName string, objInfo rest.Updat
 		return nil, false, kapierrors.NewConflict(imageapi.Resource("imagestreamtags"), istag.Name, fmt.Errorf("another caller has updated the resource version to %s", imageStream.ResourceVersion))
 	}
 
+	// enforce an optional digest precondition so that concurrent taggers can
+	// compare-and-swap instead of overwriting each other
+	if expected, ok := istag.Annotations[imageapi.ImageStreamTagExpectedDigestAnnotation]; ok {
+		current := ""
+		if latest := imageapi.LatestTaggedImage(imageStream, tag); latest != nil {
+			current = latest.Image
+		}
+		if current != expected {
+			if len(current) == 0 {
+				return nil, false, kapierrors.NewConflict(imageapi.Resource("imagestreamtags"), istag.Name, fmt.Errorf("tag does not currently resolve to an image, expected digest %q", expected))
+			}
+			return nil, false, kapierrors.NewConflict(imageapi.Resource("imagestreamtags"), istag.Name, fmt.Errorf("tag currently resolves to %q, expected digest %q", current, expected))
+		}
+		// the precondition is transport only and must not be persisted with the tag
+		delete(istag.Annotations, imageapi.ImageStreamTagExpectedDigestAnnotation)
+	}
+
 	// When we began returning image stream labels in 3.6, old clients that didn't need to send labels would be
 	// broken on update. Explicitly default labels if they are unset.  We don't support mutation of labels on update.
 	if len(imageStream.Labels) > 0 && len(istag.Labels) == 0 {
@@ -557,3 +557,88 @@ func TestCreateImageStreamTag(t *testing.T) {
 		}()
 	}
 }
+
+func TestUpdateImageStreamTagDigestPrecondition(t *testing.T) {
+	tests := map[string]struct {
+		expectedDigest string
+		expectConflict bool
+	}{
+		"matching digest": {
+			expectedDigest: "10",
+		},
+		"stale digest": {
+			expectedDigest: "11",
+			expectConflict: true,
+		},
+		"expected no image": {
+			expectedDigest: "",
+			expectConflict: true,
+		},
+	}
+
+	for name, tc := range tests {
+		func() {
+			client, server, storage := setup(t)
+			defer server.Terminate(t)
+
+			client.Put(
+				context.TODO(),
+				etcdtest.AddPrefix("/imagestreams/default/test"),
+				runtime.EncodeOrDie(legacyscheme.Codecs.LegacyCodec(v1.SchemeGroupVersion),
+					&imageapi.ImageStream{
+						ObjectMeta: metav1.ObjectMeta{
+							CreationTimestamp: metav1.Date(2015, 3, 24, 9, 38, 0, 0, time.UTC),
+							Namespace:         "default",
+							Name:              "test",
+						},
+						Spec: imageapi.ImageStreamSpec{
+							Tags: map[string]imageapi.TagReference{
+								"latest": {
+									Name:            "latest",
+									From:            &kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar/baz:1"},
+									ReferencePolicy: imageapi.TagReferencePolicy{Type: imageapi.SourceTagReferencePolicy},
+								},
+							},
+						},
+						Status: imageapi.ImageStreamStatus{
+							Tags: map[string]imageapi.TagEventList{
+								"latest": {Items: []imageapi.TagEvent{{DockerImageReference: "foo/bar/baz:1", Image: "10"}}},
+							},
+						},
+					},
+				))
+
+			istag := &imageapi.ImageStreamTag{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test:latest",
+					Annotations: map[string]string{
+						imageapi.ImageStreamTagExpectedDigestAnnotation: tc.expectedDigest,
+					},
+				},
+				Tag: &imageapi.TagReference{
+					Name:            "latest",
+					From:            &kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar/baz:2"},
+					ReferencePolicy: imageapi.TagReferencePolicy{Type: imageapi.SourceTagReferencePolicy},
+				},
+			}
+
+			ctx := apirequest.WithUser(apirequest.NewDefaultContext(), &fakeUser{})
+			obj, _, err := storage.Update(ctx, istag.Name, rest.DefaultUpdatedObjectInfo(istag), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc)
+			if tc.expectConflict {
+				if !errors.IsConflict(err) {
+					t.Errorf("%s: expected a conflict error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				return
+			}
+			updated := obj.(*imageapi.ImageStreamTag)
+			if _, ok := updated.Annotations[imageapi.ImageStreamTagExpectedDigestAnnotation]; ok {
+				t.Errorf("%s: expected the digest precondition annotation to be stripped", name)
+			}
+		}()
+	}
+}
@@ -3,6 +3,7 @@ package buildconfigs
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	clientv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,7 +18,9 @@ import (
 
 	"github.com/golang/glog"
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	triggerapi "github.com/openshift/origin/pkg/image/apis/image/v1/trigger"
+	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
 	"github.com/openshift/origin/pkg/image/trigger"
 )
 
@@ -119,16 +122,17 @@ type BuildConfigInstantiator interface {
 // at least one image is out of date.
 type buildConfigReactor struct {
 	instantiator  BuildConfigInstantiator
+	imageClient   imageclient.ImagesGetter
 	eventRecorder record.EventRecorder
 }
 
 // NewBuildConfigReactor creates a new buildConfigReactor
-func NewBuildConfigReactor(instantiator BuildConfigInstantiator, restclient rest.Interface) trigger.ImageReactor {
+func NewBuildConfigReactor(instantiator BuildConfigInstantiator, imageClient imageclient.ImagesGetter, restclient rest.Interface) trigger.ImageReactor {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: v1core.New(restclient).Events("")})
 	eventRecorder := eventBroadcaster.NewRecorder(legacyscheme.Scheme, clientv1.EventSource{Component: "buildconfig-controller"})
 
-	return &buildConfigReactor{instantiator: instantiator, eventRecorder: eventRecorder}
+	return &buildConfigReactor{instantiator: instantiator, imageClient: imageClient, eventRecorder: eventRecorder}
 }
 
 // ImageChanged is passed a build config and a set of changes and updates the object if
@@ -209,6 +213,12 @@ func (r *buildConfigReactor) ImageChanged(obj runtime.Object, tagRetriever trigg
 		return nil
 	}
 
+	// the build config may map selected labels of the triggering image into build
+	// environment variables so downstream builds know what triggered them
+	if request.TriggeredByImage != nil {
+		request.Env = append(request.Env, r.envFromImageLabels(bc, request.TriggeredByImage.Name)...)
+	}
+
 	// instantiate new build
 	glog.V(4).Infof("Requesting build for BuildConfig based on image triggers %s/%s: %#v", bc.Namespace, bc.Name, request)
 	_, err := r.instantiator.Instantiate(bc.Namespace, request)
@@ -219,3 +229,50 @@ func (r *buildConfigReactor) ImageChanged(obj runtime.Object, tagRetriever trigg
 	}
 	return err
 }
+
+// envFromImageLabels resolves the triggering image and maps its docker labels to
+// environment variables as declared by the env-from-labels annotation on the
+// build config. Failures to resolve the image or malformed entries only log, the
+// build is still triggered.
+func (r *buildConfigReactor) envFromImageLabels(bc *buildapi.BuildConfig, imageRef string) []kapi.EnvVar {
+	spec := bc.Annotations[buildapi.BuildTriggerEnvFromLabelsAnnotation]
+	if len(spec) == 0 || r.imageClient == nil {
+		return nil
+	}
+	ref, err := imageapi.ParseDockerImageReference(imageRef)
+	if err != nil || len(ref.ID) == 0 {
+		glog.V(4).Infof("Cannot map image labels for BuildConfig %s/%s: triggering image %q has no digest", bc.Namespace, bc.Name, imageRef)
+		return nil
+	}
+	image, err := r.imageClient.Images().Get(ref.ID, metav1.GetOptions{})
+	if err != nil {
+		glog.V(4).Infof("Cannot map image labels for BuildConfig %s/%s: %v", bc.Namespace, bc.Name, err)
+		return nil
+	}
+	var labels map[string]string
+	if image.DockerImageMetadata.Config != nil {
+		labels = image.DockerImageMetadata.Config.Labels
+	}
+	return envFromLabelsSpec(spec, labels)
+}
+
+// envFromLabelsSpec maps image labels to environment variables following a comma
+// separated list of NAME=label pairs. Labels missing from the image and malformed
+// pairs are skipped.
+func envFromLabelsSpec(spec string, labels map[string]string) []kapi.EnvVar {
+	var env []kapi.EnvVar
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			continue
+		}
+		if value, ok := labels[parts[1]]; ok {
+			env = append(env, kapi.EnvVar{Name: parts[0], Value: value})
+		}
+	}
+	return env
+}
@@ -275,3 +275,45 @@ func TestBuildConfigReactor(t *testing.T) {
 		}
 	}
 }
+
+func TestEnvFromLabelsSpec(t *testing.T) {
+	labels := map[string]string{
+		"version": "1.2.3",
+		"vcs-ref": "abc123",
+	}
+	tests := []struct {
+		name     string
+		spec     string
+		expected []kapi.EnvVar
+	}{
+		{
+			name: "maps declared labels",
+			spec: "VERSION=version,GIT_REF=vcs-ref",
+			expected: []kapi.EnvVar{
+				{Name: "VERSION", Value: "1.2.3"},
+				{Name: "GIT_REF", Value: "abc123"},
+			},
+		},
+		{
+			name:     "missing labels are skipped",
+			spec:     "VENDOR=vendor,VERSION=version",
+			expected: []kapi.EnvVar{{Name: "VERSION", Value: "1.2.3"}},
+		},
+		{
+			name: "malformed entries are skipped",
+			spec: "no-separator, =version,NAME=,VERSION=version",
+			expected: []kapi.EnvVar{
+				{Name: "VERSION", Value: "1.2.3"},
+			},
+		},
+		{
+			name: "empty spec",
+			spec: "",
+		},
+	}
+	for _, test := range tests {
+		if actual := envFromLabelsSpec(test.spec, labels); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, actual)
+		}
+	}
+}
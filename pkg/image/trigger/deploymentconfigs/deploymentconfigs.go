@@ -13,6 +13,7 @@ import (
 
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset/typed/apps/internalversion"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
 	triggerapi "github.com/openshift/origin/pkg/image/apis/image/v1/trigger"
 	"github.com/openshift/origin/pkg/image/trigger"
 )
@@ -238,7 +239,11 @@ func (r *DeploymentConfigReactor) ImageChanged(obj runtime.Object, tagRetriever
 		if glog.V(4) {
 			glog.Infof("Ignoring changes to deployment config %s, has unresolved images: %s", dc.Name, printDeploymentTriggers(newDC.Spec.Triggers))
 		}
-		return nil
+		return r.setTriggersResolvedCondition(dc, kapi.ConditionFalse, appsapi.TriggerResolutionFailedReason,
+			fmt.Sprintf("one or more image change triggers could not be resolved: %s", printDeploymentTriggers(newDC.Spec.Triggers)))
+	}
+	if err := r.setTriggersResolvedCondition(dc, kapi.ConditionTrue, appsapi.TriggersResolvedReason, "all image change triggers are resolved"); err != nil {
+		return err
 	}
 	if updated == nil {
 		glog.V(4).Infof("Deployment config %s has not changed", dc.Name)
@@ -249,6 +254,23 @@ func (r *DeploymentConfigReactor) ImageChanged(obj runtime.Object, tagRetriever
 	return err
 }
 
+// setTriggersResolvedCondition records on the deployment config status whether its automatic
+// image change triggers could be resolved. The condition is only added after a resolution
+// failure has been observed so that configs with always-resolvable triggers are not updated.
+func (r *DeploymentConfigReactor) setTriggersResolvedCondition(dc *appsapi.DeploymentConfig, status kapi.ConditionStatus, reason appsapi.DeploymentConditionReason, message string) error {
+	existing := appsutil.GetDeploymentCondition(dc.Status, appsapi.DeploymentTriggersResolved)
+	if existing == nil && status == kapi.ConditionTrue {
+		return nil
+	}
+	if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+		return nil
+	}
+	updated := dc.DeepCopy()
+	appsutil.SetDeploymentCondition(&updated.Status, *appsutil.NewDeploymentCondition(appsapi.DeploymentTriggersResolved, status, reason, message))
+	_, err := r.Client.DeploymentConfigs(updated.Namespace).UpdateStatus(updated)
+	return err
+}
+
 func printDeploymentTriggers(triggers []appsapi.DeploymentTriggerPolicy) string {
 	var values []string
 	for _, t := range triggers {
@@ -14,6 +14,7 @@ import (
 
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset/fake"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
 )
 
 type fakeTagResponse struct {
@@ -58,11 +59,12 @@ func testDeploymentConfig(params []appsapi.DeploymentTriggerImageChangeParams, c
 
 func TestDeploymentConfigReactor(t *testing.T) {
 	testCases := []struct {
-		tags        []fakeTagResponse
-		obj         *appsapi.DeploymentConfig
-		response    *appsapi.DeploymentConfig
-		expected    *appsapi.DeploymentConfig
-		expectedErr bool
+		tags               []fakeTagResponse
+		obj                *appsapi.DeploymentConfig
+		response           *appsapi.DeploymentConfig
+		expected           *appsapi.DeploymentConfig
+		expectedUnresolved bool
+		expectedErr        bool
 	}{
 		{
 			obj: &appsapi.DeploymentConfig{
@@ -122,6 +124,8 @@ func TestDeploymentConfigReactor(t *testing.T) {
 					ContainerNames: []string{"test"},
 				},
 			}, map[string]string{"test": ""}),
+			response:           &appsapi.DeploymentConfig{},
+			expectedUnresolved: true,
 		},
 
 		{
@@ -173,7 +177,8 @@ func TestDeploymentConfigReactor(t *testing.T) {
 					ContainerNames: []string{"test2"},
 				},
 			}, map[string]string{"test": "", "test2": ""}),
-			response: &appsapi.DeploymentConfig{},
+			response:           &appsapi.DeploymentConfig{},
+			expectedUnresolved: true,
 		},
 
 		{
@@ -253,8 +258,8 @@ func TestDeploymentConfigReactor(t *testing.T) {
 					ContainerNames: []string{"test"},
 				},
 			}, map[string]string{"test": "", "test2": "image-lookup-1"}),
-			response: &appsapi.DeploymentConfig{},
-			expected: nil,
+			response:           &appsapi.DeploymentConfig{},
+			expectedUnresolved: true,
 		},
 
 		{
@@ -273,8 +278,8 @@ func TestDeploymentConfigReactor(t *testing.T) {
 					LastTriggeredImage: "image-lookup-1",
 				},
 			}, map[string]string{"test": ""}),
-			response: &appsapi.DeploymentConfig{},
-			expected: nil,
+			response:           &appsapi.DeploymentConfig{},
+			expectedUnresolved: true,
 		},
 
 		{
@@ -409,7 +414,8 @@ func TestDeploymentConfigReactor(t *testing.T) {
 			case err != nil:
 				return
 			}
-			if test.expected != nil {
+			switch {
+			case test.expected != nil:
 				actions := c.Actions()
 				if len(actions) != 1 || actions[0].GetVerb() != "update" {
 					t.Fatalf("unexpected actions: %v", actions)
@@ -420,7 +426,20 @@ func TestDeploymentConfigReactor(t *testing.T) {
 				if !reflect.DeepEqual(test.expected, actualUpdate) {
 					t.Fatalf("not equal: %s", diff.ObjectReflectDiff(test.expected, actualUpdate))
 				}
-			} else {
+			case test.expectedUnresolved:
+				actions := c.Actions()
+				if len(actions) != 1 || actions[0].GetVerb() != "update" || actions[0].GetSubresource() != "status" {
+					t.Fatalf("unexpected actions: %v", actions)
+				}
+				updatedDC, ok := actualUpdate.(*appsapi.DeploymentConfig)
+				if !ok {
+					t.Fatalf("unexpected status update object: %#v", actualUpdate)
+				}
+				condition := appsutil.GetDeploymentCondition(updatedDC.Status, appsapi.DeploymentTriggersResolved)
+				if condition == nil || condition.Status != kapi.ConditionFalse || condition.Reason != appsapi.TriggerResolutionFailedReason {
+					t.Fatalf("unexpected condition: %#v", condition)
+				}
+			default:
 				if len(c.Actions()) != 0 {
 					t.Fatalf("unexpected actions: %v", c.Actions())
 				}
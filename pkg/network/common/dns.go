@@ -17,6 +17,12 @@ const (
 	dig = "dig"
 
 	defaultTTL = 30 * time.Minute
+	// minimumTTL bounds how soon a record is re-resolved, so records served
+	// with very low TTLs do not cause constant refreshes
+	minimumTTL = 30 * time.Second
+	// failureRetryInterval is how long to wait before retrying a failed
+	// lookup, so an unresolvable name does not cause a tight refresh loop
+	failureRetryInterval = 30 * time.Second
 )
 
 type dnsValue struct {
@@ -109,10 +115,6 @@ func (d *DNS) updateOne(dns string) (error, bool) {
 	// Output Format:
 	// <domain-name>.		<<ttl from authoritative ns>	IN	A	<IP addr>
 	out, err := d.execer.Command(dig, "+nocmd", "+noall", "+answer", "+ttlid", "a", dns).CombinedOutput()
-	if err != nil || len(out) == 0 {
-		return fmt.Errorf("failed to fetch IP addr and TTL value for domain: %q, err: %v", dns, err), false
-	}
-	outStr := strings.Trim(string(out[:]), "\n")
 
 	res, ok := d.dnsMap[dns]
 	if !ok {
@@ -120,6 +122,15 @@ func (d *DNS) updateOne(dns string) (error, bool) {
 		return fmt.Errorf("DNS value not found in dnsMap for domain: %q", dns), false
 	}
 
+	if err != nil || len(out) == 0 {
+		// Retry the lookup later rather than as soon as the sync loop comes
+		// back around, so a failing name does not cause a tight refresh loop
+		res.nextQueryTime = time.Now().Add(failureRetryInterval)
+		d.dnsMap[dns] = res
+		return fmt.Errorf("failed to fetch IP addr and TTL value for domain: %q, err: %v", dns, err), false
+	}
+	outStr := strings.Trim(string(out[:]), "\n")
+
 	var minTTL time.Duration
 	var ips []net.IP
 	for _, line := range strings.Split(outStr, "\n") {
@@ -143,6 +154,12 @@ func (d *DNS) updateOne(dns string) (error, bool) {
 		}
 	}
 
+	if minTTL.Seconds() == 0 {
+		minTTL = defaultTTL
+	} else if minTTL < minimumTTL {
+		minTTL = minimumTTL
+	}
+
 	changed := false
 	if !ipsEqual(res.ips, ips) {
 		changed = true
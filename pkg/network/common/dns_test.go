@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	kexec "k8s.io/utils/exec"
 	fakeexec "k8s.io/utils/exec/testing"
@@ -52,6 +53,14 @@ func TestAddDNS(t *testing.T) {
 			ttl:           600,
 			expectFailure: false,
 		},
+		{
+			testCase:   "Test low TTL is clamped to minimum",
+			domainName: "low-ttl.example.com",
+			dnsResolverOutput: "low-ttl.example.com.		5	IN	A	10.11.12.13",
+			ips:           []net.IP{ip},
+			ttl:           minimumTTL.Seconds(),
+			expectFailure: false,
+		},
 		{
 			testCase:          "Test invalid domain name",
 			domainName:        "sads@#$.com",
@@ -95,6 +104,34 @@ func TestAddDNS(t *testing.T) {
 	}
 }
 
+func TestFailedUpdateRetryTime(t *testing.T) {
+	fexec := &fakeexec.FakeExec{}
+	dns := NewDNS(fexec)
+	addTestResult(t, fexec, "dig +nocmd +noall +answer +ttlid a example.com", "example.com.		600	IN	A	10.11.12.13", nil)
+
+	if err := dns.Add("example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A failed refresh should reschedule the next lookup after the retry
+	// interval instead of leaving nextQueryTime in the past.
+	addTestResult(t, fexec, "dig +nocmd +noall +answer +ttlid a example.com", "", nil)
+	before := time.Now()
+	err, _ := dns.updateOne("example.com")
+	if err == nil {
+		t.Fatalf("expected failure, got success")
+	}
+	ensureTestResults(t, fexec)
+
+	next := dns.Get("example.com").nextQueryTime
+	if next.Before(before) {
+		t.Fatalf("expected failed lookup to be rescheduled in the future, got %v", next)
+	}
+	if next.After(before.Add(failureRetryInterval + time.Minute)) {
+		t.Fatalf("expected failed lookup to be retried after %v, got %v", failureRetryInterval, next)
+	}
+}
+
 func TestUpdateDNS(t *testing.T) {
 	type dnsTest struct {
 		testCase   string
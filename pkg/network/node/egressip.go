@@ -25,8 +25,16 @@ type nodeEgress struct {
 }
 
 type namespaceEgress struct {
-	vnid        uint32
-	requestedIP string
+	vnid uint32
+
+	// requestedIPs lists the namespace's egress IPs in priority order; IPs after
+	// the first serve as standbys that take over if the node hosting an
+	// earlier IP goes away.
+	requestedIPs []string
+
+	// activeEgressIP is the member of requestedIPs currently carrying the
+	// namespace's traffic, or "" if none of them is usable.
+	activeEgressIP string
 }
 
 type egressIPInfo struct {
@@ -187,7 +195,7 @@ func (eip *egressIPWatcher) updateNodeEgress(nodeIP string, nodeEgressIPs []stri
 	for _, ip := range node.requestedIPs.Difference(oldRequestedIPs).UnsortedList() {
 		eg := eip.ensureEgressIPInfo(ip)
 		eg.addNode(node)
-		eip.syncEgressIP(eg)
+		eip.egressIPChanged(eg)
 	}
 
 	// Process removed EgressIPs
@@ -197,6 +205,19 @@ func (eip *egressIPWatcher) updateNodeEgress(nodeIP string, nodeEgressIPs []stri
 			continue
 		}
 		eg.deleteNode(node)
+		eip.egressIPChanged(eg)
+	}
+}
+
+// egressIPChanged resyncs state after the set of nodes or namespaces claiming eg
+// has changed. If a single namespace claims the egress IP, its choice of active
+// egress IP may be affected (eg, failing over to a standby IP when the node
+// hosting the active one is removed), so the namespace's other egress IPs are
+// resynced too.
+func (eip *egressIPWatcher) egressIPChanged(eg *egressIPInfo) {
+	if len(eg.namespaces) == 1 {
+		eip.syncNamespaceEgress(eg.namespaces[0])
+	} else {
 		eip.syncEgressIP(eg)
 	}
 }
@@ -210,14 +231,7 @@ func (eip *egressIPWatcher) handleAddOrUpdateNetNamespace(obj, _ interface{}, ev
 	netns := obj.(*networkapi.NetNamespace)
 	glog.V(5).Infof("Watch %s event for NetNamespace %q", eventType, netns.Name)
 
-	if len(netns.EgressIPs) != 0 {
-		if len(netns.EgressIPs) > 1 {
-			glog.Warningf("Ignoring extra EgressIPs (%v) in NetNamespace %q", netns.EgressIPs[1:], netns.Name)
-		}
-		eip.updateNamespaceEgress(netns.NetID, netns.EgressIPs[0])
-	} else {
-		eip.deleteNamespaceEgress(netns.NetID)
-	}
+	eip.updateNamespaceEgress(netns.NetID, netns.EgressIPs)
 }
 
 func (eip *egressIPWatcher) handleDeleteNetNamespace(obj interface{}) {
@@ -227,45 +241,82 @@ func (eip *egressIPWatcher) handleDeleteNetNamespace(obj interface{}) {
 	eip.deleteNamespaceEgress(netns.NetID)
 }
 
-func (eip *egressIPWatcher) updateNamespaceEgress(vnid uint32, egressIP string) {
+func (eip *egressIPWatcher) updateNamespaceEgress(vnid uint32, egressIPs []string) {
 	eip.Lock()
 	defer eip.Unlock()
 
 	ns := eip.namespacesByVNID[vnid]
 	if ns == nil {
-		if egressIP == "" {
+		if len(egressIPs) == 0 {
 			return
 		}
 		ns = &namespaceEgress{vnid: vnid}
 		eip.namespacesByVNID[vnid] = ns
-	} else if egressIP == "" {
+	} else if len(egressIPs) == 0 {
 		delete(eip.namespacesByVNID, vnid)
 	}
 
-	if ns.requestedIP == egressIP {
-		return
-	}
+	oldRequestedIPs := sets.NewString(ns.requestedIPs...)
+	newRequestedIPs := sets.NewString(egressIPs...)
+	ns.requestedIPs = egressIPs
 
-	if ns.requestedIP != "" {
-		eg := eip.egressIPs[ns.requestedIP]
-		if eg != nil {
-			eg.deleteNamespace(ns)
-			eip.syncEgressIP(eg)
+	// Process removed EgressIPs
+	for _, ip := range oldRequestedIPs.Difference(newRequestedIPs).UnsortedList() {
+		eg := eip.egressIPs[ip]
+		if eg == nil {
+			continue
 		}
+		eg.deleteNamespace(ns)
+		eip.egressIPChanged(eg)
 	}
 
-	ns.requestedIP = egressIP
-	if egressIP == "" {
-		return
+	// Process new EgressIPs
+	for _, ip := range newRequestedIPs.Difference(oldRequestedIPs).UnsortedList() {
+		eg := eip.ensureEgressIPInfo(ip)
+		eg.addNamespace(ns)
 	}
 
-	eg := eip.ensureEgressIPInfo(egressIP)
-	eg.addNamespace(ns)
-	eip.syncEgressIP(eg)
+	if len(egressIPs) == 0 {
+		ns.activeEgressIP = ""
+		return
+	}
+	eip.syncNamespaceEgress(ns)
 }
 
 func (eip *egressIPWatcher) deleteNamespaceEgress(vnid uint32) {
-	eip.updateNamespaceEgress(vnid, "")
+	eip.updateNamespaceEgress(vnid, nil)
+}
+
+// syncNamespaceEgress recomputes which of the namespace's requested egress IPs
+// carries its traffic (the earliest-listed IP that is hosted by exactly one
+// node and not claimed by any other namespace) and then resyncs all of the
+// involved egress IPs, the active one last so that standby state is cleared
+// before the active IP's rules are programmed.
+func (eip *egressIPWatcher) syncNamespaceEgress(ns *namespaceEgress) {
+	active := ""
+	for _, ip := range ns.requestedIPs {
+		eg := eip.egressIPs[ip]
+		if eg == nil {
+			continue
+		}
+		if len(eg.nodes) == 1 && len(eg.namespaces) == 1 {
+			active = ip
+			break
+		}
+	}
+	ns.activeEgressIP = active
+
+	for _, ip := range ns.requestedIPs {
+		if ip == active {
+			continue
+		}
+		if eg := eip.egressIPs[ip]; eg != nil {
+			eip.syncEgressIP(eg)
+		}
+	}
+	if active != "" {
+		eip.syncEgressIP(eip.egressIPs[active])
+	}
 }
 
 func (eip *egressIPWatcher) syncEgressIP(eg *egressIPInfo) {
@@ -276,7 +327,8 @@ func (eip *egressIPWatcher) syncEgressIP(eg *egressIPInfo) {
 func (eip *egressIPWatcher) syncEgressIPTablesState(eg *egressIPInfo) bool {
 	// The egressIPInfo should have an assigned node IP if and only if the
 	// egress IP is active (ie, it is assigned to exactly 1 node and exactly
-	// 1 namespace).
+	// 1 namespace).  Standby egress IPs are assigned on their hosting node
+	// too, so that the IP is already claimed if failover makes it active.
 	egressIPActive := (len(eg.nodes) == 1 && len(eg.namespaces) == 1)
 	assignedNodeIPChanged := false
 	if egressIPActive && eg.assignedNodeIP != eg.nodes[0].nodeIP {
@@ -322,12 +374,23 @@ func (eip *egressIPWatcher) syncEgressOVSState(eg *egressIPInfo, assignedNodeIPC
 	}
 
 	// If we have, or had, a single egress namespace, then update the OVS flows if
-	// something has changed
+	// something has changed.  Only the namespace's active egress IP (or, when no
+	// requested IP is usable, its first requested IP) owns the namespace's flows;
+	// standby egress IPs leave them alone.
 	var err error
-	if len(eg.namespaces) == 1 && (eg.assignedVNID != eg.namespaces[0].vnid || assignedNodeIPChanged) {
-		eg.assignedVNID = eg.namespaces[0].vnid
-		delete(eg.blockedVNIDs, eg.assignedVNID)
-		err = eip.oc.SetNamespaceEgressViaEgressIP(eg.assignedVNID, eg.assignedNodeIP, getMarkForVNID(eg.assignedVNID, eip.masqueradeBit))
+	if len(eg.namespaces) == 1 {
+		ns := eg.namespaces[0]
+		ownsNamespaceFlows := eg.ip == ns.activeEgressIP || (ns.activeEgressIP == "" && len(ns.requestedIPs) > 0 && eg.ip == ns.requestedIPs[0])
+		if ownsNamespaceFlows {
+			if eg.assignedVNID != ns.vnid || assignedNodeIPChanged {
+				eg.assignedVNID = ns.vnid
+				delete(eg.blockedVNIDs, eg.assignedVNID)
+				err = eip.oc.SetNamespaceEgressViaEgressIP(eg.assignedVNID, eg.assignedNodeIP, getMarkForVNID(eg.assignedVNID, eip.masqueradeBit))
+			}
+		} else if eg.assignedVNID != 0 {
+			// another of the namespace's requested egress IPs carries its traffic now
+			eg.assignedVNID = 0
+		}
 	} else if len(eg.namespaces) == 0 && eg.assignedVNID != 0 {
 		err = eip.oc.SetNamespaceEgressNormal(eg.assignedVNID)
 		eg.assignedVNID = 0
@@ -148,7 +148,7 @@ func TestEgressIP(t *testing.T) {
 	}
 
 	// Assign NetNamespace.EgressIP first, then HostSubnet.EgressIP, with a remote EgressIP
-	eip.updateNamespaceEgress(42, "172.17.0.100")
+	eip.updateNamespaceEgress(42, []string{"172.17.0.100"})
 	err = assertNoNetlinkChanges(eip)
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -180,7 +180,7 @@ func TestEgressIP(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 
-	eip.updateNamespaceEgress(43, "172.17.0.105")
+	eip.updateNamespaceEgress(43, []string{"172.17.0.105"})
 	err = assertNoNetlinkChanges(eip)
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -191,7 +191,7 @@ func TestEgressIP(t *testing.T) {
 	}
 
 	// Change NetNamespace.EgressIP
-	eip.updateNamespaceEgress(43, "172.17.0.101")
+	eip.updateNamespaceEgress(43, []string{"172.17.0.101"})
 	err = assertNoNetlinkChanges(eip)
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -202,7 +202,7 @@ func TestEgressIP(t *testing.T) {
 	}
 
 	// Assign NetNamespace.EgressIP first, then HostSubnet.EgressIP, with a local EgressIP
-	eip.updateNamespaceEgress(44, "172.17.0.104")
+	eip.updateNamespaceEgress(44, []string{"172.17.0.104"})
 	err = assertNoNetlinkChanges(eip)
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -223,7 +223,7 @@ func TestEgressIP(t *testing.T) {
 	}
 
 	// Change Namespace EgressIP
-	eip.updateNamespaceEgress(44, "172.17.0.102")
+	eip.updateNamespaceEgress(44, []string{"172.17.0.102"})
 	err = assertNetlinkChange(eip, "release 172.17.0.104")
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -249,7 +249,7 @@ func TestEgressIP(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 
-	eip.updateNamespaceEgress(45, "172.17.0.103")
+	eip.updateNamespaceEgress(45, []string{"172.17.0.103"})
 	err = assertNetlinkChange(eip, "claim 172.17.0.103")
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -271,7 +271,7 @@ func TestEgressIP(t *testing.T) {
 	}
 
 	// Add namespace EgressIP back again after having removed it...
-	eip.updateNamespaceEgress(44, "172.17.0.102")
+	eip.updateNamespaceEgress(44, []string{"172.17.0.102"})
 	err = assertNetlinkChange(eip, "claim 172.17.0.102")
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -325,6 +325,51 @@ func TestEgressIP(t *testing.T) {
 	}
 }
 
+func TestEgressIPFailover(t *testing.T) {
+	eip, flows := setupEgressIPWatcher(t)
+
+	// The namespace requests a primary and a standby egress IP, each hosted by a
+	// different (remote) node. The primary carries the traffic.
+	eip.updateNodeEgress("172.17.0.3", []string{"172.17.0.100"})
+	eip.updateNodeEgress("172.17.0.5", []string{"172.17.0.101"})
+	eip.updateNamespaceEgress(42, []string{"172.17.0.100", "172.17.0.101"})
+	err := assertNoNetlinkChanges(eip)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.3"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// When the node hosting the primary egress IP goes away, traffic fails over
+	// to the standby.
+	eip.updateNodeEgress("172.17.0.3", []string{})
+	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.5"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// When the primary's node comes back, the primary takes over again.
+	eip.updateNodeEgress("172.17.0.3", []string{"172.17.0.100"})
+	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.3"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Losing both hosting nodes drops the namespace's traffic.
+	eip.updateNodeEgress("172.17.0.3", []string{})
+	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.5"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	eip.updateNodeEgress("172.17.0.5", []string{})
+	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Dropped})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
 func TestNodeIPAsEgressIP(t *testing.T) {
 	eip, flows := setupEgressIPWatcher(t)
 
@@ -343,7 +388,7 @@ func TestNodeIPAsEgressIP(t *testing.T) {
 func TestDuplicateNodeEgressIPs(t *testing.T) {
 	eip, flows := setupEgressIPWatcher(t)
 
-	eip.updateNamespaceEgress(42, "172.17.0.100")
+	eip.updateNamespaceEgress(42, []string{"172.17.0.100"})
 	eip.updateNodeEgress("172.17.0.3", []string{"172.17.0.100"})
 	err := assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.3"})
 	if err != nil {
@@ -389,7 +434,7 @@ func TestDuplicateNodeEgressIPs(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 
-	eip.updateNamespaceEgress(42, "172.17.0.100")
+	eip.updateNamespaceEgress(42, []string{"172.17.0.100"})
 	err = assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Dropped})
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -407,7 +452,7 @@ func TestDuplicateNodeEgressIPs(t *testing.T) {
 func TestDuplicateNamespaceEgressIPs(t *testing.T) {
 	eip, flows := setupEgressIPWatcher(t)
 
-	eip.updateNamespaceEgress(42, "172.17.0.100")
+	eip.updateNamespaceEgress(42, []string{"172.17.0.100"})
 	eip.updateNodeEgress("172.17.0.3", []string{"172.17.0.100"})
 	err := assertOVSChanges(eip, &flows, egressOVSChange{vnid: 42, egress: Remote, remote: "172.17.0.3"})
 	if err != nil {
@@ -416,7 +461,7 @@ func TestDuplicateNamespaceEgressIPs(t *testing.T) {
 
 	// Adding the Egress IP to another namespace should not work and should cause both
 	// namespaces to start dropping traffic.
-	eip.updateNamespaceEgress(43, "172.17.0.100")
+	eip.updateNamespaceEgress(43, []string{"172.17.0.100"})
 	err = assertOVSChanges(eip, &flows,
 		egressOVSChange{vnid: 42, egress: Dropped},
 		egressOVSChange{vnid: 43, egress: Dropped},
@@ -436,7 +481,7 @@ func TestDuplicateNamespaceEgressIPs(t *testing.T) {
 	}
 
 	// Add duplicate back, then remove and re-add Node EgressIP, which should have no effect
-	eip.updateNamespaceEgress(43, "172.17.0.100")
+	eip.updateNamespaceEgress(43, []string{"172.17.0.100"})
 	err = assertOVSChanges(eip, &flows,
 		egressOVSChange{vnid: 42, egress: Dropped},
 		egressOVSChange{vnid: 43, egress: Dropped},
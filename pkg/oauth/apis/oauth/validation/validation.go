@@ -304,6 +304,11 @@ func ValidateClientNameField(value string, fldPath *field.Path) field.ErrorList
 func ValidateUserNameField(value string, fldPath *field.Path) field.ErrorList {
 	if len(value) == 0 {
 		return field.ErrorList{field.Required(fldPath, "")}
+	} else if _, saName, err := serviceaccount.SplitUsername(value); err == nil {
+		// tokens can be minted for service accounts as well as users
+		if reasons := validation.ValidateServiceAccountName(saName, false); len(reasons) != 0 {
+			return field.ErrorList{field.Invalid(fldPath, value, strings.Join(reasons, ", "))}
+		}
 	} else if reasons := uservalidation.ValidateUserName(value, false); len(reasons) != 0 {
 		return field.ErrorList{field.Invalid(fldPath, value, strings.Join(reasons, ", "))}
 	}
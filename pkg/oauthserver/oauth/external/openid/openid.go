@@ -16,6 +16,7 @@ import (
 
 	authapi "github.com/openshift/origin/pkg/oauthserver/api"
 	"github.com/openshift/origin/pkg/oauthserver/oauth/external"
+	"github.com/openshift/origin/pkg/oauthserver/userregistry/identitymapper"
 )
 
 const (
@@ -44,6 +45,7 @@ type Config struct {
 	PreferredUsernameClaims []string
 	EmailClaims             []string
 	NameClaims              []string
+	GroupClaims             []string
 
 	IDTokenValidator TokenValidator
 }
@@ -213,6 +215,10 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 		identity.Extra[authapi.IdentityDisplayNameKey] = name
 	}
 
+	if groups := getClaimValues(claims, p.GroupClaims); len(groups) != 0 {
+		identity.Extra[identitymapper.IdentityGroupsKey] = strings.Join(groups, ",")
+	}
+
 	glog.V(4).Infof("identity=%v", identity)
 
 	return identity, true, nil
@@ -235,6 +241,35 @@ func getClaimValue(data map[string]interface{}, claims []string) (string, error)
 	return "", errors.New("No value found")
 }
 
+// getClaimValues returns the values from the first claim that has any.
+// A claim may carry either a single string or a list of strings.
+func getClaimValues(data map[string]interface{}, claims []string) []string {
+	for _, claim := range claims {
+		value, ok := data[claim]
+		if !ok {
+			continue
+		}
+
+		values := []string{}
+		switch typedValue := value.(type) {
+		case string:
+			if len(typedValue) > 0 {
+				values = append(values, typedValue)
+			}
+		case []interface{}:
+			for _, item := range typedValue {
+				if stringValue, ok := item.(string); ok && len(stringValue) > 0 {
+					values = append(values, stringValue)
+				}
+			}
+		}
+		if len(values) > 0 {
+			return values
+		}
+	}
+	return nil
+}
+
 // fetch and decode JSON from the given UserInfo URL
 func fetchUserInfo(url, accessToken string, transport http.RoundTripper) (map[string]interface{}, error) {
 	req, _ := http.NewRequest("GET", url, nil)
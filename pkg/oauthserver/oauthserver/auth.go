@@ -53,6 +53,7 @@ import (
 	"github.com/openshift/origin/pkg/oauthserver/osinserver"
 	"github.com/openshift/origin/pkg/oauthserver/osinserver/registrystorage"
 	"github.com/openshift/origin/pkg/oauthserver/server/csrf"
+	"github.com/openshift/origin/pkg/oauthserver/server/deviceauth"
 	"github.com/openshift/origin/pkg/oauthserver/server/errorpage"
 	"github.com/openshift/origin/pkg/oauthserver/server/grant"
 	"github.com/openshift/origin/pkg/oauthserver/server/login"
@@ -141,6 +142,18 @@ func (c *OAuthServerConfig) WithOAuth(handler http.Handler, requestContextMapper
 	tokenRequestEndpoints := tokenrequest.NewEndpoints(c.ExtraOAuthConfig.Options.MasterPublicURL, c.getOsinOAuthClient)
 	tokenRequestEndpoints.Install(mux, oauthutil.OpenShiftOAuthAPIPrefix)
 
+	deviceAuthHandler := deviceauth.NewDeviceAuth(
+		authRequestHandler,
+		c.getCSRF(),
+		authHandler,
+		combinedOAuthClientGetter,
+		c.ExtraOAuthConfig.OAuthAccessTokenClient,
+		OpenShiftBrowserClientID,
+		c.ExtraOAuthConfig.Options.MasterPublicURL+path.Join(oauthutil.OpenShiftOAuthAPIPrefix, deviceauth.DeviceVerifyEndpoint),
+		c.ExtraOAuthConfig.Options.TokenConfig.AccessTokenMaxAgeSeconds,
+	)
+	deviceAuthHandler.Install(mux, oauthutil.OpenShiftOAuthAPIPrefix)
+
 	// glog.Infof("oauth server configured as: %#v", server)
 	// glog.Infof("auth handler: %#v", authHandler)
 	// glog.Infof("auth request handler: %#v", authRequestHandler)
@@ -343,6 +356,14 @@ func (c *OAuthServerConfig) getAuthenticationHandler(mux mux, errorHandler handl
 			return nil, err
 		}
 
+		// For providers that assert group claims, keep origin groups in sync at login
+		if openIDProvider, isOpenID := identityProvider.Provider.(*configapi.OpenIDIdentityProvider); isOpenID && len(openIDProvider.Claims.Groups) > 0 {
+			identityMapper = identitymapper.NewGroupSyncingIdentityMapper(identityMapper, c.ExtraOAuthConfig.GroupClient, identitymapper.GroupSyncPolicy{
+				Prefix: openIDProvider.GroupsPrefix,
+				Prune:  openIDProvider.PruneGroups,
+			})
+		}
+
 		// TODO: refactor handler building per type
 		if configapi.IsPasswordAuthenticator(identityProvider) {
 			passwordAuth, err := c.getPasswordAuthenticator(identityProvider)
@@ -521,6 +542,7 @@ func (c *OAuthServerConfig) getOAuthProvider(identityProvider configapi.Identity
 			PreferredUsernameClaims: provider.Claims.PreferredUsername,
 			EmailClaims:             provider.Claims.Email,
 			NameClaims:              provider.Claims.Name,
+			GroupClaims:             provider.Claims.Groups,
 		}
 
 		return openid.NewProvider(identityProvider.Name, transport, config)
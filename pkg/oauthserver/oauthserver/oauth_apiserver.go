@@ -75,6 +75,7 @@ func NewOAuthServerConfig(oauthConfig configapi.OAuthConfig, userClientConfig *r
 			EventsClient:                   eventsClient.Events(""),
 			IdentityClient:                 userClient.Identities(),
 			UserClient:                     userClient.Users(),
+			GroupClient:                    userClient.Groups(),
 			UserIdentityMappingClient:      userClient.UserIdentityMappings(),
 			OAuthAccessTokenClient:         oauthClient.OAuthAccessTokens(),
 			OAuthAuthorizeTokenClient:      oauthClient.OAuthAuthorizeTokens(),
@@ -146,6 +147,7 @@ type ExtraOAuthConfig struct {
 	RouteClient routeclient.RouteV1Interface
 
 	UserClient                userclient.UserInterface
+	GroupClient               userclient.GroupInterface
 	IdentityClient            userclient.IdentityInterface
 	UserIdentityMappingClient userclient.UserIdentityMappingInterface
 
@@ -0,0 +1,521 @@
+package deviceauth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	oauthapi "github.com/openshift/api/oauth/v1"
+	oauthclient "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	"github.com/openshift/origin/pkg/apiserver/authentication/internaloauth"
+	scopeauthorizer "github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	oauthclientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	"github.com/openshift/origin/pkg/oauth/scope"
+	"github.com/openshift/origin/pkg/oauthserver/oauth/handlers"
+	"github.com/openshift/origin/pkg/oauthserver/server/csrf"
+	"github.com/openshift/origin/pkg/oauthserver/server/headers"
+)
+
+const (
+	// DeviceAuthEndpoint accepts device authorization requests from clients (RFC 8628, section 3.1)
+	DeviceAuthEndpoint = "/device/auth"
+	// DeviceVerifyEndpoint is the browser-facing page where the user enters the user code
+	DeviceVerifyEndpoint = "/device/verify"
+	// DeviceTokenEndpoint is polled by the client until the user approves or the request expires
+	DeviceTokenEndpoint = "/device/token"
+
+	clientIDParam   = "client_id"
+	scopeParam      = "scope"
+	deviceCodeParam = "device_code"
+	userCodeParam   = "user_code"
+	csrfParam       = "csrf"
+	approveParam    = "approve"
+	// bindSourceCIDRsParam optionally binds the issued token to a comma
+	// separated list of source CIDRs; requests presenting the token from
+	// outside those networks are rejected
+	bindSourceCIDRsParam = "bind_source_cidrs"
+	// bindClientCertParam, when "true", binds the issued token to the TLS
+	// client certificate presented on the token request
+	bindClientCertParam = "bind_client_cert"
+
+	// deviceCodeExpirySeconds bounds how long the user has to enter the code before the request expires
+	deviceCodeExpirySeconds = 300
+	// pollIntervalSeconds is the minimum interval clients should wait between token requests
+	pollIntervalSeconds = 5
+)
+
+// deviceAuthorization tracks a single pending device authorization request
+type deviceAuthorization struct {
+	clientName string
+	scopes     []string
+	userCode   string
+	expires    time.Time
+
+	// approved is set once the user submits the verification form
+	approved bool
+	userName string
+	userUID  string
+}
+
+// DeviceAuth implements the OAuth 2.0 device authorization grant (RFC 8628).
+// Pending authorizations are held in memory, so the flow requires the polling
+// client to reach the same server instance that handled the initial request.
+type DeviceAuth struct {
+	auth            authenticator.Request
+	csrf            csrf.CSRF
+	authHandler     handlers.AuthenticationHandler
+	clients         oauthclientregistry.Getter
+	accessTokens    oauthclient.OAuthAccessTokenInterface
+	browserClientID string
+	verificationURI string
+	// accessTokenMaxAgeSeconds is the server-wide default, overridden per client
+	accessTokenMaxAgeSeconds int32
+
+	lock sync.Mutex
+	// pending is keyed by device code, userCodes maps the human-entered code to the device code
+	pending   map[string]*deviceAuthorization
+	userCodes map[string]string
+}
+
+func NewDeviceAuth(auth authenticator.Request, csrf csrf.CSRF, authHandler handlers.AuthenticationHandler, clients oauthclientregistry.Getter, accessTokens oauthclient.OAuthAccessTokenInterface, browserClientID, verificationURI string, accessTokenMaxAgeSeconds int32) *DeviceAuth {
+	return &DeviceAuth{
+		auth:                     auth,
+		csrf:                     csrf,
+		authHandler:              authHandler,
+		clients:                  clients,
+		accessTokens:             accessTokens,
+		browserClientID:          browserClientID,
+		verificationURI:          verificationURI,
+		accessTokenMaxAgeSeconds: accessTokenMaxAgeSeconds,
+		pending:                  map[string]*deviceAuthorization{},
+		userCodes:                map[string]string{},
+	}
+}
+
+// Install registers the device authorization endpoints into a mux. It is expected
+// that the provided prefix will serve all operations
+func (d *DeviceAuth) Install(mux Mux, paths ...string) {
+	for _, prefix := range paths {
+		mux.HandleFunc(path.Join(prefix, DeviceAuthEndpoint), d.serveAuth)
+		mux.HandleFunc(path.Join(prefix, DeviceVerifyEndpoint), d.serveVerify)
+		mux.HandleFunc(path.Join(prefix, DeviceTokenEndpoint), d.serveToken)
+	}
+}
+
+// deviceAuthResponse follows the wire format of RFC 8628, section 3.2
+type deviceAuthResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int32  `json:"expires_in"`
+	Interval                int32  `json:"interval"`
+}
+
+// deviceTokenResponse follows the wire format of RFC 6749, sections 5.1 and 5.2
+type deviceTokenResponse struct {
+	AccessToken string `json:"access_token,omitempty"`
+	TokenType   string `json:"token_type,omitempty"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// serveAuth starts a new device authorization request for the given client and scopes
+func (d *DeviceAuth) serveAuth(w http.ResponseWriter, req *http.Request) {
+	headers.SetStandardHeaders(w)
+
+	if req.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID := req.PostFormValue(clientIDParam)
+	scopes := scope.Split(req.PostFormValue(scopeParam))
+
+	client, err := d.clients.Get(clientID, metav1.GetOptions{})
+	if err != nil || client == nil {
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "invalid_client"})
+		return
+	}
+	if err := scopeauthorizer.ValidateScopeRestrictions(client, scopes...); err != nil {
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "invalid_scope"})
+		return
+	}
+
+	deviceCode := randomToken()
+	userCode := randomUserCode()
+
+	d.lock.Lock()
+	d.prune()
+	d.pending[deviceCode] = &deviceAuthorization{
+		clientName: client.Name,
+		scopes:     scopes,
+		userCode:   userCode,
+		expires:    time.Now().Add(deviceCodeExpirySeconds * time.Second),
+	}
+	d.userCodes[userCode] = deviceCode
+	d.lock.Unlock()
+
+	writeJSON(w, http.StatusOK, deviceAuthResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         d.verificationURI,
+		VerificationURIComplete: d.verificationURI + "?" + userCodeParam + "=" + userCode,
+		ExpiresIn:               deviceCodeExpirySeconds,
+		Interval:                pollIntervalSeconds,
+	})
+}
+
+// serveVerify renders the verification form for authenticated users and records approvals
+func (d *DeviceAuth) serveVerify(w http.ResponseWriter, req *http.Request) {
+	headers.SetStandardHeaders(w)
+
+	user, ok, err := d.auth.AuthenticateRequest(req)
+	if err != nil || !ok {
+		d.authenticationNeeded(w, req)
+		return
+	}
+
+	switch req.Method {
+	case "GET":
+		d.handleVerifyForm(w, req, "")
+	case "POST":
+		d.handleVerify(user, w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticationNeeded sends the user through the login flow and back to the verification page
+func (d *DeviceAuth) authenticationNeeded(w http.ResponseWriter, req *http.Request) {
+	client, err := d.clients.Get(d.browserClientID, metav1.GetOptions{})
+	if err != nil {
+		glog.Errorf("Unable to get client %q: %v", d.browserClientID, err)
+		http.Error(w, "Unable to authenticate", http.StatusInternalServerError)
+		return
+	}
+	if _, err := d.authHandler.AuthenticationNeeded(&clientWrapper{id: d.browserClientID, client: client}, w, req); err != nil {
+		glog.Errorf("Unable to request authentication: %v", err)
+		http.Error(w, "Unable to authenticate", http.StatusInternalServerError)
+	}
+}
+
+func (d *DeviceAuth) handleVerifyForm(w http.ResponseWriter, req *http.Request, failure string) {
+	csrf, err := d.csrf.Generate(w, req)
+	if err != nil {
+		glog.Errorf("Unable to generate CSRF token: %v", err)
+		http.Error(w, "Could not generate CSRF token", http.StatusInternalServerError)
+		return
+	}
+
+	// Submit to the current path, so we can target this page even via an auth proxy.
+	// Depends on any auth proxies matching at least the last segment of the URL.
+	_, lastSegment := path.Split(req.URL.Path)
+
+	renderVerify(w, verifyData{
+		Action:   lastSegment,
+		Error:    failure,
+		CSRF:     csrf,
+		UserCode: req.URL.Query().Get(userCodeParam),
+	})
+}
+
+func (d *DeviceAuth) handleVerify(user user.Info, w http.ResponseWriter, req *http.Request) {
+	if ok, err := d.csrf.Check(req, req.PostFormValue(csrfParam)); !ok || err != nil {
+		glog.Errorf("Unable to check CSRF token: %v", err)
+		d.handleVerifyForm(w, req, "Invalid CSRF token")
+		return
+	}
+
+	if len(req.PostFormValue(approveParam)) == 0 {
+		renderVerify(w, verifyData{Denied: true})
+		return
+	}
+
+	userCode := strings.ToUpper(strings.TrimSpace(req.PostFormValue(userCodeParam)))
+
+	d.lock.Lock()
+	d.prune()
+	deviceCode, ok := d.userCodes[userCode]
+	if !ok {
+		d.lock.Unlock()
+		d.handleVerifyForm(w, req, "The code you entered is invalid or has expired")
+		return
+	}
+	pending := d.pending[deviceCode]
+	pending.approved = true
+	pending.userName = user.GetName()
+	pending.userUID = user.GetUID()
+	// the code is single-use, a second visitor must not be able to hijack the approval
+	delete(d.userCodes, userCode)
+	scopes := pending.scopes
+	d.lock.Unlock()
+
+	renderVerify(w, verifyData{Approved: true, Scopes: strings.Join(scopes, " ")})
+}
+
+// serveToken exchanges an approved device code for an access token
+func (d *DeviceAuth) serveToken(w http.ResponseWriter, req *http.Request) {
+	headers.SetStandardHeaders(w)
+
+	if req.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	deviceCode := req.PostFormValue(deviceCodeParam)
+	clientID := req.PostFormValue(clientIDParam)
+
+	d.lock.Lock()
+	d.prune()
+	var pending deviceAuthorization
+	entry, ok := d.pending[deviceCode]
+	if ok {
+		pending = *entry
+	}
+	d.lock.Unlock()
+
+	switch {
+	case !ok:
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "expired_token"})
+		return
+	case pending.clientName != clientID:
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "invalid_client"})
+		return
+	case !pending.approved:
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "authorization_pending"})
+		return
+	}
+
+	client, err := d.clients.Get(pending.clientName, metav1.GetOptions{})
+	if err != nil {
+		glog.Errorf("Unable to get client %q: %v", pending.clientName, err)
+		writeJSON(w, http.StatusInternalServerError, deviceTokenResponse{Error: "server_error"})
+		return
+	}
+
+	expiresIn := int64(d.accessTokenMaxAgeSeconds)
+	if client.AccessTokenMaxAgeSeconds != nil {
+		expiresIn = int64(*client.AccessTokenMaxAgeSeconds)
+	}
+
+	annotations, ok := tokenBindingAnnotations(req)
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "invalid_request"})
+		return
+	}
+
+	// the approval is single use: claim it before issuing the token so a
+	// concurrent poll cannot obtain a second token for the same approval
+	d.lock.Lock()
+	_, ok = d.pending[deviceCode]
+	delete(d.pending, deviceCode)
+	d.lock.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, deviceTokenResponse{Error: "expired_token"})
+		return
+	}
+
+	token := &oauthapi.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{Name: randomToken(), Annotations: annotations},
+		ClientName: client.Name,
+		ExpiresIn:  expiresIn,
+		Scopes:     pending.scopes,
+		UserName:   pending.userName,
+		UserUID:    pending.userUID,
+	}
+	if _, err := d.accessTokens.Create(token); err != nil {
+		glog.Errorf("Unable to create access token: %v", err)
+		// restore the approval so a transient storage failure does not destroy
+		// it; the next poll will retry the exchange
+		d.lock.Lock()
+		d.pending[deviceCode] = entry
+		d.lock.Unlock()
+		writeJSON(w, http.StatusInternalServerError, deviceTokenResponse{Error: "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deviceTokenResponse{
+		AccessToken: token.Name,
+		TokenType:   "Bearer",
+		ExpiresIn:   expiresIn,
+		Scope:       strings.Join(pending.scopes, " "),
+	})
+}
+
+// tokenBindingAnnotations builds the binding annotations requested on a token
+// exchange. The returned bool is false when the request asked for a binding
+// that cannot be satisfied, e.g. an unparseable CIDR or a certificate binding
+// on a connection that presented no client certificate.
+func tokenBindingAnnotations(req *http.Request) (map[string]string, bool) {
+	var annotations map[string]string
+	if cidrs := strings.TrimSpace(req.PostFormValue(bindSourceCIDRsParam)); len(cidrs) > 0 {
+		normalized := []string{}
+		for _, cidr := range strings.Split(cidrs, ",") {
+			cidr = strings.TrimSpace(cidr)
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return nil, false
+			}
+			normalized = append(normalized, cidr)
+		}
+		annotations = map[string]string{
+			internaloauth.TokenSourceCIDRsAnnotation: strings.Join(normalized, ","),
+		}
+	}
+	if req.PostFormValue(bindClientCertParam) == "true" {
+		if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+			return nil, false
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[internaloauth.TokenCertificateFingerprintAnnotation] = internaloauth.CertificateFingerprint(req.TLS.PeerCertificates[0])
+	}
+	return annotations, true
+}
+
+// prune removes expired authorizations. Callers must hold d.lock.
+func (d *DeviceAuth) prune() {
+	now := time.Now()
+	for deviceCode, pending := range d.pending {
+		if pending.expires.Before(now) {
+			delete(d.userCodes, pending.userCode)
+			delete(d.pending, deviceCode)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to write device authorization response: %v", err))
+	}
+}
+
+// clientWrapper adapts an OAuthClient to the interface expected by the authentication handler
+type clientWrapper struct {
+	id     string
+	client *oauthapi.OAuthClient
+}
+
+func (w *clientWrapper) GetId() string {
+	return w.id
+}
+
+func (w *clientWrapper) GetSecret() string {
+	return w.client.Secret
+}
+
+func (w *clientWrapper) ClientSecretMatches(secret string) bool {
+	return w.client.Secret == secret
+}
+
+func (w *clientWrapper) GetRedirectUri() string {
+	return strings.Join(w.client.RedirectURIs, ",")
+}
+
+func (w *clientWrapper) GetUserData() interface{} {
+	return w.client
+}
+
+// randomBytes and randomToken are copied from pkg/oauthserver/osinserver/tokengen.go
+func randomBytes(len int) []byte {
+	b := make([]byte, len)
+	if _, err := rand.Read(b); err != nil {
+		// rand.Read should never fail
+		panic(err)
+	}
+	return b
+}
+
+func randomToken() string {
+	for {
+		// 32 bytes (256 bits) = 43 base64-encoded characters
+		b := randomBytes(32)
+		// Use URLEncoding to ensure we don't get / characters
+		s := base64.URLEncoding.EncodeToString(b)
+		// Don't generate tokens with leading dashes... they're hard to use on the command line
+		if strings.HasPrefix(s, "-") {
+			continue
+		}
+		// Strip trailing ='s... they're ugly
+		return strings.TrimRight(s, "=")
+	}
+}
+
+// userCodeAlphabet deliberately omits vowels and easily confused characters
+// so codes are easy to read over the phone and never spell words
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+func randomUserCode() string {
+	b := randomBytes(8)
+	code := make([]byte, 0, 9)
+	for i, c := range b {
+		if i == 4 {
+			code = append(code, '-')
+		}
+		code = append(code, userCodeAlphabet[int(c)%len(userCodeAlphabet)])
+	}
+	return string(code)
+}
+
+type verifyData struct {
+	Action   string
+	Error    string
+	CSRF     string
+	UserCode string
+	Approved bool
+	Denied   bool
+	Scopes   string
+}
+
+func renderVerify(w http.ResponseWriter, data verifyData) {
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	if err := verifyTemplate.Execute(w, data); err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to render device verification template: %v", err))
+	}
+}
+
+// TODO: allow template to be read from an external file
+var verifyTemplate = template.Must(template.New("verifyTemplate").Parse(`
+<style>
+	body    { font-family: sans-serif; font-size: 14px; margin: 2em 2%; background-color: #F9F9F9; }
+	h2      { font-size: 1.4em; }
+	input   { font-family: Menlo, Monaco, Consolas, monospace; font-size: 1.5em; text-transform: uppercase; }
+	.error  { color: #900; }
+</style>
+
+{{ if .Approved }}
+  <h2>Device approved</h2>
+  <p>The device has been granted access{{ if .Scopes }} with scopes <code>{{ .Scopes }}</code>{{ end }}. You may close this page.</p>
+{{ else if .Denied }}
+  <h2>Device denied</h2>
+  <p>The device was not granted access. You may close this page.</p>
+{{ else }}
+  <h2>Enter the code displayed on your device</h2>
+  {{ if .Error }}<p class="error">{{ .Error }}</p>{{ end }}
+  <form action="{{ .Action }}" method="POST">
+    <input type="hidden" name="csrf" value="{{ .CSRF }}">
+    <input type="text" name="user_code" value="{{ .UserCode }}" autofocus>
+    <input type="submit" name="approve" value="Approve">
+  </form>
+{{ end }}
+`))
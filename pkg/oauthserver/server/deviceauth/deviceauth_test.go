@@ -0,0 +1,152 @@
+package deviceauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	oapi "github.com/openshift/api/oauth/v1"
+	oauthfake "github.com/openshift/client-go/oauth/clientset/versioned/fake"
+	"github.com/openshift/origin/pkg/oauthserver/server/csrf"
+)
+
+type testAuth struct {
+	User    user.Info
+	Success bool
+	Err     error
+}
+
+func (t *testAuth) AuthenticateRequest(req *http.Request) (user.Info, bool, error) {
+	return t.User, t.Success, t.Err
+}
+
+func postForm(t *testing.T, postURL string, values url.Values, data interface{}) int {
+	resp, err := http.PostForm(postURL, values)
+	if err != nil {
+		t.Fatalf("Unexpected error posting to %s: %v", postURL, err)
+	}
+	defer resp.Body.Close()
+	if data != nil {
+		if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+			t.Fatalf("Unexpected error decoding response from %s: %v", postURL, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestDeviceFlow(t *testing.T) {
+	fakeOAuthClient := oauthfake.NewSimpleClientset(&oapi.OAuthClient{
+		ObjectMeta:        metav1.ObjectMeta{Name: "myclient"},
+		ScopeRestrictions: []oapi.ScopeRestriction{{ExactValues: []string{"myscope1"}}},
+	})
+
+	deviceAuth := NewDeviceAuth(
+		&testAuth{Success: true, User: &user.DefaultInfo{Name: "username", UID: "useruid"}},
+		&csrf.FakeCSRF{Token: "test"},
+		nil,
+		fakeOAuthClient.Oauth().OAuthClients(),
+		fakeOAuthClient.Oauth().OAuthAccessTokens(),
+		"openshift-browser-client",
+		"https://example.com/oauth/device/verify",
+		86400,
+	)
+
+	mux := http.NewServeMux()
+	deviceAuth.Install(mux, "/oauth")
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// start a device authorization request
+	auth := &deviceAuthResponse{}
+	if status := postForm(t, server.URL+"/oauth/device/auth", url.Values{clientIDParam: {"myclient"}, scopeParam: {"myscope1"}}, auth); status != http.StatusOK {
+		t.Fatalf("Unexpected status starting device authorization: %d", status)
+	}
+	if len(auth.DeviceCode) == 0 || len(auth.UserCode) == 0 {
+		t.Fatalf("Expected device and user codes, got %#v", auth)
+	}
+
+	// polling before the user approves must return authorization_pending
+	pollValues := url.Values{clientIDParam: {"myclient"}, deviceCodeParam: {auth.DeviceCode}}
+	pending := &deviceTokenResponse{}
+	if status := postForm(t, server.URL+"/oauth/device/token", pollValues, pending); status != http.StatusBadRequest {
+		t.Fatalf("Unexpected status polling before approval: %d", status)
+	}
+	if pending.Error != "authorization_pending" {
+		t.Fatalf("Unexpected error polling before approval: %q", pending.Error)
+	}
+
+	// approve the request in the "browser"
+	approveValues := url.Values{csrfParam: {"test"}, userCodeParam: {auth.UserCode}, approveParam: {"Approve"}}
+	if status := postForm(t, server.URL+"/oauth/device/verify", approveValues, nil); status != http.StatusOK {
+		t.Fatalf("Unexpected status approving device authorization: %d", status)
+	}
+
+	// polling with the wrong client must be rejected without consuming the approval
+	badClient := &deviceTokenResponse{}
+	if status := postForm(t, server.URL+"/oauth/device/token", url.Values{clientIDParam: {"otherclient"}, deviceCodeParam: {auth.DeviceCode}}, badClient); status != http.StatusBadRequest {
+		t.Fatalf("Unexpected status polling with wrong client: %d", status)
+	}
+	if badClient.Error != "invalid_client" {
+		t.Fatalf("Unexpected error polling with wrong client: %q", badClient.Error)
+	}
+
+	// polling after approval must mint a token
+	token := &deviceTokenResponse{}
+	if status := postForm(t, server.URL+"/oauth/device/token", pollValues, token); status != http.StatusOK {
+		t.Fatalf("Unexpected status polling after approval: %d, error %q", status, token.Error)
+	}
+	if len(token.AccessToken) == 0 || token.TokenType != "Bearer" {
+		t.Fatalf("Unexpected token response: %#v", token)
+	}
+
+	persisted, err := fakeOAuthClient.Oauth().OAuthAccessTokens().Get(token.AccessToken, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error getting persisted token: %v", err)
+	}
+	if persisted.UserName != "username" || persisted.UserUID != "useruid" || persisted.ClientName != "myclient" {
+		t.Errorf("Unexpected persisted token: %#v", persisted)
+	}
+
+	// the device code is single use
+	reused := &deviceTokenResponse{}
+	if status := postForm(t, server.URL+"/oauth/device/token", pollValues, reused); status != http.StatusBadRequest {
+		t.Fatalf("Unexpected status reusing device code: %d", status)
+	}
+	if reused.Error != "expired_token" {
+		t.Fatalf("Unexpected error reusing device code: %q", reused.Error)
+	}
+}
+
+func TestDeviceFlowBadUserCode(t *testing.T) {
+	fakeOAuthClient := oauthfake.NewSimpleClientset(&oapi.OAuthClient{ObjectMeta: metav1.ObjectMeta{Name: "myclient"}})
+
+	deviceAuth := NewDeviceAuth(
+		&testAuth{Success: true, User: &user.DefaultInfo{Name: "username"}},
+		&csrf.FakeCSRF{Token: "test"},
+		nil,
+		fakeOAuthClient.Oauth().OAuthClients(),
+		fakeOAuthClient.Oauth().OAuthAccessTokens(),
+		"openshift-browser-client",
+		"https://example.com/oauth/device/verify",
+		86400,
+	)
+
+	mux := http.NewServeMux()
+	deviceAuth.Install(mux, "/oauth")
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := http.PostForm(server.URL+"/oauth/device/verify", url.Values{csrfParam: {"test"}, userCodeParam: {"BCDF-GHJK"}, approveParam: {"Approve"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected status: %d", resp.StatusCode)
+	}
+}
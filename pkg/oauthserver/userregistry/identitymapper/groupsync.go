@@ -0,0 +1,149 @@
+package identitymapper
+
+import (
+	"strings"
+
+	"github.com/golang/glog"
+	kerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+
+	userapi "github.com/openshift/api/user/v1"
+	userclient "github.com/openshift/client-go/user/clientset/versioned/typed/user/v1"
+	authapi "github.com/openshift/origin/pkg/oauthserver/api"
+)
+
+const (
+	// IdentityGroupsKey is the key under which identity providers record the
+	// comma separated group names asserted for the identity
+	IdentityGroupsKey = "groups"
+
+	// GroupSyncedProviderLabel is set on groups created by the login time group
+	// syncer and records the identity provider the group was synchronized from
+	GroupSyncedProviderLabel = "openshift.io/idp.provider"
+)
+
+// GroupSyncPolicy controls how groups asserted by an identity provider are
+// synchronized into Group objects at login
+type GroupSyncPolicy struct {
+	// Prefix is prepended to every group name asserted by the provider
+	Prefix string
+	// Prune removes the user from previously synchronized groups that the
+	// provider no longer asserts
+	Prune bool
+}
+
+var _ = authapi.UserIdentityMapper(&groupSyncingIdentityMapper{})
+
+// groupSyncingIdentityMapper decorates another UserIdentityMapper and keeps
+// Group objects in sync with the groups the identity provider asserted for the
+// identity.  Sync errors are logged but do not fail an otherwise successful
+// login.
+type groupSyncingIdentityMapper struct {
+	delegate authapi.UserIdentityMapper
+	groups   userclient.GroupInterface
+	policy   GroupSyncPolicy
+}
+
+// NewGroupSyncingIdentityMapper returns a UserIdentityMapper that delegates
+// user mapping and synchronizes the groups recorded by the identity provider
+// under IdentityGroupsKey according to the given policy.
+func NewGroupSyncingIdentityMapper(delegate authapi.UserIdentityMapper, groups userclient.GroupInterface, policy GroupSyncPolicy) authapi.UserIdentityMapper {
+	return &groupSyncingIdentityMapper{delegate: delegate, groups: groups, policy: policy}
+}
+
+func (m *groupSyncingIdentityMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Info, error) {
+	user, err := m.delegate.UserFor(info)
+	if err != nil {
+		return user, err
+	}
+
+	if err := m.syncGroups(user.GetName(), info); err != nil {
+		glog.Errorf("Error synchronizing groups for user %q from provider %q: %v", user.GetName(), info.GetProviderName(), err)
+	}
+
+	return user, nil
+}
+
+func (m *groupSyncingIdentityMapper) syncGroups(userName string, info authapi.UserIdentityInfo) error {
+	assertedGroups := sets.NewString()
+	for _, group := range strings.Split(info.GetExtra()[IdentityGroupsKey], ",") {
+		if group = strings.TrimSpace(group); len(group) > 0 {
+			assertedGroups.Insert(m.policy.Prefix + group)
+		}
+	}
+
+	errs := []error{}
+	for _, groupName := range assertedGroups.List() {
+		if err := m.addToGroup(groupName, userName, info.GetProviderName()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if m.policy.Prune {
+		if err := m.pruneGroups(userName, info.GetProviderName(), assertedGroups); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kutilerrors.NewAggregate(errs)
+}
+
+// addToGroup ensures a group with the given name exists, carries the sync
+// label for the provider, and contains the user.
+func (m *groupSyncingIdentityMapper) addToGroup(groupName, userName, providerName string) error {
+	group, err := m.groups.Get(groupName, metav1.GetOptions{})
+	if kerrs.IsNotFound(err) {
+		_, err := m.groups.Create(&userapi.Group{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   groupName,
+				Labels: map[string]string{GroupSyncedProviderLabel: providerName},
+			},
+			Users: []string{userName},
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if sets.NewString(group.Users...).Has(userName) {
+		return nil
+	}
+	group.Users = append(group.Users, userName)
+	_, err = m.groups.Update(group)
+	return err
+}
+
+// pruneGroups removes the user from groups previously synchronized from the
+// provider that the provider no longer asserts.
+func (m *groupSyncingIdentityMapper) pruneGroups(userName, providerName string, assertedGroups sets.String) error {
+	selector := labels.SelectorFromSet(labels.Set{GroupSyncedProviderLabel: providerName})
+	groups, err := m.groups.List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for i := range groups.Items {
+		group := &groups.Items[i]
+		if assertedGroups.Has(group.Name) || !sets.NewString(group.Users...).Has(userName) {
+			continue
+		}
+
+		users := []string{}
+		for _, user := range group.Users {
+			if user != userName {
+				users = append(users, user)
+			}
+		}
+		group.Users = users
+		if _, err := m.groups.Update(group); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kutilerrors.NewAggregate(errs)
+}
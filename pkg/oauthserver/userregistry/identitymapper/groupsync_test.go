@@ -0,0 +1,85 @@
+package identitymapper
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+
+	userapi "github.com/openshift/api/user/v1"
+	userfake "github.com/openshift/client-go/user/clientset/versioned/fake"
+	authapi "github.com/openshift/origin/pkg/oauthserver/api"
+)
+
+type fakeDelegateMapper struct {
+	userName string
+}
+
+func (m fakeDelegateMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Info, error) {
+	return &kuser.DefaultInfo{Name: m.userName}, nil
+}
+
+func TestGroupSyncingIdentityMapper(t *testing.T) {
+	existingGroup := &userapi.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "idp:existing",
+			Labels: map[string]string{GroupSyncedProviderLabel: "myprovider"},
+		},
+		Users: []string{"anotheruser"},
+	}
+	staleGroup := &userapi.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "idp:stale",
+			Labels: map[string]string{GroupSyncedProviderLabel: "myprovider"},
+		},
+		Users: []string{"bob", "anotheruser"},
+	}
+	unmanagedGroup := &userapi.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "unmanaged"},
+		Users:      []string{"bob"},
+	}
+	fakeUserClient := userfake.NewSimpleClientset(existingGroup, staleGroup, unmanagedGroup)
+
+	mapper := NewGroupSyncingIdentityMapper(
+		fakeDelegateMapper{userName: "bob"},
+		fakeUserClient.UserV1().Groups(),
+		GroupSyncPolicy{Prefix: "idp:", Prune: true},
+	)
+
+	identity := authapi.NewDefaultUserIdentityInfo("myprovider", "bob")
+	identity.Extra[IdentityGroupsKey] = "existing,new"
+
+	userInfo, err := mapper.UserFor(identity)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userInfo.GetName() != "bob" {
+		t.Errorf("unexpected user: %v", userInfo)
+	}
+
+	expectedMembership := map[string][]string{
+		"idp:existing": {"anotheruser", "bob"},
+		"idp:new":      {"bob"},
+		"idp:stale":    {"anotheruser"},
+		"unmanaged":    {"bob"},
+	}
+	for groupName, expectedUsers := range expectedMembership {
+		group, err := fakeUserClient.UserV1().Groups().Get(groupName, metav1.GetOptions{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", groupName, err)
+			continue
+		}
+		if !sets.NewString(group.Users...).Equal(sets.NewString(expectedUsers...)) {
+			t.Errorf("%s: expected users %v, got %v", groupName, expectedUsers, group.Users)
+		}
+	}
+
+	newGroup, err := fakeUserClient.UserV1().Groups().Get("idp:new", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if newGroup.Labels[GroupSyncedProviderLabel] != "myprovider" {
+		t.Errorf("expected provider label on created group, got %v", newGroup.Labels)
+	}
+}
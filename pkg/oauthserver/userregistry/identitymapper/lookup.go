@@ -30,6 +30,10 @@ func (p *lookupIdentityMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Inf
 		return nil, NewLookupError(info, err)
 	}
 
+	if u.Disabled {
+		return nil, NewLookupError(info, fmt.Errorf("user %s is disabled", u.Name))
+	}
+
 	return &kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
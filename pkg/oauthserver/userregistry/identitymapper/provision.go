@@ -1,6 +1,8 @@
 package identitymapper
 
 import (
+	"fmt"
+
 	"github.com/golang/glog"
 	corev1 "k8s.io/api/core/v1"
 	kerrs "k8s.io/apimachinery/pkg/api/errors"
@@ -122,6 +124,12 @@ func (p *provisioningIdentityMapper) getMapping(ctx apirequest.Context, identity
 		glog.Errorf("user.identities (%#v) does not include identity (%s)", u, identity.Name)
 		return nil, kerrs.NewNotFound(userapi.Resource("useridentitymapping"), identity.Name)
 	}
+	if identity.Disabled {
+		return nil, fmt.Errorf("identity %s is disabled", identity.Name)
+	}
+	if u.Disabled {
+		return nil, fmt.Errorf("user %s is disabled", u.Name)
+	}
 	return &kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
@@ -83,6 +83,7 @@ func NewCommandAdmin(name, fullName string, in io.Reader, out io.Writer, errout
 				cmdutil.ReplaceCommandName("kubectl", fullName, kubecmd.NewCmdDrain(f, out, errout)),
 				cmdutil.ReplaceCommandName("kubectl", fullName, ktemplates.Normalize(kubecmd.NewCmdTaint(f, out))),
 				network.NewCmdPodNetwork(network.PodNetworkCommandName, fullName+" "+network.PodNetworkCommandName, f, out, errout),
+			network.NewCmdNetworkTopology(network.NetworkTopologyCommandName, fullName+" "+network.NetworkTopologyCommandName, f, out),
 			},
 		},
 		{
@@ -102,6 +103,7 @@ func NewCommandAdmin(name, fullName string, in io.Reader, out io.Writer, errout
 				),
 				top.NewCommandTop(top.TopRecommendedName, fullName+" "+top.TopRecommendedName, f, out, errout),
 				image.NewCmdVerifyImageSignature(name, fullName+" "+image.VerifyRecommendedName, f, out, errout),
+				image.NewCmdMoveImageStream(image.MoveImageStreamRecommendedName, fullName+" "+image.MoveImageStreamRecommendedName, f, out, errout),
 			},
 		},
 		{
@@ -25,6 +25,8 @@ func NewCmdCert(name, fullName string, out io.Writer, errout io.Writer) *cobra.C
 	cmds.AddCommand(admin.NewCommandCreateKeyPair(admin.CreateKeyPairCommandName, fullName+" "+admin.CreateKeyPairCommandName, out))
 	cmds.AddCommand(admin.NewCommandCreateServerCert(admin.CreateServerCertCommandName, fullName+" "+admin.CreateServerCertCommandName, out))
 	cmds.AddCommand(admin.NewCommandCreateSignerCert(admin.CreateSignerCertCommandName, fullName+" "+admin.CreateSignerCertCommandName, out))
+	cmds.AddCommand(admin.NewCommandCreateIntermediateSignerCert(admin.CreateIntermediateSignerCertCommandName, fullName+" "+admin.CreateIntermediateSignerCertCommandName, out))
+	cmds.AddCommand(admin.NewCommandCreateCRL(admin.CreateCRLCommandName, fullName+" "+admin.CreateCRLCommandName, out))
 
 	cmds.AddCommand(admin.NewCommandEncrypt(admin.EncryptCommandName, fullName+" "+admin.EncryptCommandName, out, errout))
 	cmds.AddCommand(admin.NewCommandDecrypt(admin.DecryptCommandName, fullName+" "+admin.DecryptCommandName, fullName+" "+admin.EncryptCommandName, out))
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/spf13/cobra"
@@ -16,7 +17,8 @@ import (
 const CreateBootstrapProjectTemplateCommand = "create-bootstrap-project-template"
 
 type CreateBootstrapProjectTemplateOptions struct {
-	Name string
+	Name            string
+	NetworkPolicies []string
 }
 
 func NewCommandCreateBootstrapProjectTemplate(f *clientcmd.Factory, commandName string, fullName string, out io.Writer) *cobra.Command {
@@ -44,6 +46,7 @@ func NewCommandCreateBootstrapProjectTemplate(f *clientcmd.Factory, commandName
 	}
 
 	cmd.Flags().StringVar(&options.Name, "name", delegated.DefaultTemplateName, "The name of the template to output.")
+	cmd.Flags().StringSliceVar(&options.NetworkPolicies, "network-policies", nil, fmt.Sprintf("A comma-separated list of default NetworkPolicies to create in new projects, from: %v. Only effective with the networkpolicy network plugin.", delegated.DefaultNetworkPolicyNames))
 	cmdutil.AddPrinterFlags(cmd)
 
 	// Default to JSON
@@ -68,5 +71,8 @@ func (o CreateBootstrapProjectTemplateOptions) Validate(args []string) error {
 func (o CreateBootstrapProjectTemplateOptions) CreateBootstrapProjectTemplate() (*templateapi.Template, error) {
 	template := delegated.DefaultTemplate()
 	template.Name = o.Name
+	if err := delegated.AddDefaultNetworkPolicies(template, o.NetworkPolicies); err != nil {
+		return nil, err
+	}
 	return template, nil
 }
@@ -13,6 +13,7 @@ import (
 
 	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset"
 	oauthorizationclient "github.com/openshift/origin/pkg/authorization/generated/internalclientset"
+	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset"
 	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset"
 	networkclient "github.com/openshift/origin/pkg/network/generated/internalclientset"
 	oauthclient "github.com/openshift/origin/pkg/oauth/generated/internalclientset"
@@ -38,6 +39,7 @@ func availableClusterDiagnostics() types.DiagnosticList {
 		&clustdiags.ClusterRouter{},
 		&clustdiags.ClusterRoles{},
 		&clustdiags.ClusterRoleBindings{},
+		&clustdiags.ImageTriggerChains{},
 		&clustdiags.MasterNode{},
 		&clustdiags.MetricsApiProxy{},
 		&clustdiags.NodeDefinitions{},
@@ -97,6 +99,10 @@ func (o DiagnosticsOptions) buildClusterDiagnostics(rawConfig *clientcmdapi.Conf
 	if err != nil {
 		return nil, err
 	}
+	buildClient, err := buildclient.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
 	diagnostics := []types.Diagnostic{}
 	for _, diagnosticName := range requestedDiagnostics {
@@ -128,6 +134,8 @@ func (o DiagnosticsOptions) buildClusterDiagnostics(rawConfig *clientcmdapi.Conf
 			d = &clustdiags.ClusterRoles{ClusterRolesClient: oauthorizationClient.Authorization().ClusterRoles(), SARClient: kclusterClient.Authorization()}
 		case clustdiags.ClusterRoleBindingsName:
 			d = &clustdiags.ClusterRoleBindings{ClusterRoleBindingsClient: oauthorizationClient.Authorization().ClusterRoleBindings(), SARClient: kclusterClient.Authorization()}
+		case clustdiags.ImageTriggerChainsName:
+			d = &clustdiags.ImageTriggerChains{KubeClient: kclusterClient, ImageClient: imageClient.Image(), BuildClient: buildClient.Build(), AppsClient: appsClient.Apps()}
 		case clustdiags.MetricsApiProxyName:
 			d = &clustdiags.MetricsApiProxy{KubeClient: kclusterClient}
 		case clustdiags.ServiceExternalIPsName:
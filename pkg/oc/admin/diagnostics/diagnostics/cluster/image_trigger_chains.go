@@ -0,0 +1,293 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	appstypedclient "github.com/openshift/origin/pkg/apps/generated/internalclientset/typed/apps/internalversion"
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	buildtypedclient "github.com/openshift/origin/pkg/build/generated/internalclientset/typed/build/internalversion"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	imagetypedclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
+	"github.com/openshift/origin/pkg/oc/admin/diagnostics/diagnostics/types"
+	"github.com/openshift/origin/pkg/oc/admin/diagnostics/diagnostics/util"
+	"k8s.io/kubernetes/pkg/apis/authorization"
+)
+
+// ImageTriggerChains is a Diagnostic that walks the image trigger chains formed by
+// ImageStreams, BuildConfigs, and DeploymentConfigs and reports broken links,
+// circular build dependencies, and tags that have not been updated recently.
+type ImageTriggerChains struct {
+	KubeClient  kclientset.Interface
+	ImageClient imagetypedclient.ImageStreamsGetter
+	BuildClient buildtypedclient.BuildConfigsGetter
+	AppsClient  appstypedclient.DeploymentConfigsGetter
+}
+
+const (
+	ImageTriggerChainsName = "ImageTriggerChains"
+
+	// staleTagThreshold is how long a tag referenced by a trigger may go without a
+	// new image before the diagnostic calls attention to it.
+	staleTagThreshold = 30 * 24 * time.Hour
+
+	chainMissingStream = `%s %s/%s has an image change trigger that references
+ImageStreamTag %s, but image stream %s does not exist.
+The trigger will never fire and dependent builds or deployments will not run
+automatically.`
+
+	chainMissingTag = `%s %s/%s has an image change trigger that references
+ImageStreamTag %s, but that tag has no images.
+The trigger will not fire until an image is pushed or tagged there.`
+
+	chainCircular = `The following build configurations form a circular image trigger chain:
+
+  %s
+
+Each build in the chain is triggered by the image the previous build produces,
+so a single image change can trigger builds indefinitely. Remove the image
+change trigger from one of the build configurations to break the cycle.`
+
+	chainStaleTag = `ImageStreamTag %s is referenced by an image change trigger on
+%s %s/%s but has not received a new image in %s.
+If builds or deployments for this tag are expected to occur regularly, the
+upstream build or import that feeds this tag may be broken.`
+)
+
+func (d *ImageTriggerChains) Name() string {
+	return ImageTriggerChainsName
+}
+
+func (d *ImageTriggerChains) Description() string {
+	return "Check image trigger chains between image streams, builds, and deployments"
+}
+
+func (d *ImageTriggerChains) Requirements() (client bool, host bool) {
+	return true, false
+}
+
+func (d *ImageTriggerChains) CanRun() (bool, error) {
+	if d.KubeClient == nil || d.ImageClient == nil || d.BuildClient == nil || d.AppsClient == nil {
+		return false, errors.New("must have kube and openshift clients")
+	}
+	can, err := util.UserCan(d.KubeClient.Authorization(), &authorization.ResourceAttributes{
+		Verb:     "list",
+		Group:    imageapi.GroupName,
+		Resource: "imagestreams",
+	})
+	if err != nil {
+		return false, types.DiagnosticError{ID: "DClu5010", LogMessage: fmt.Sprintf(clientAccessError, err), Cause: err}
+	} else if !can {
+		return false, types.DiagnosticError{ID: "DClu5011", LogMessage: "Client does not have cluster-admin access", Cause: err}
+	}
+	return true, nil
+}
+
+// triggerRef identifies the object that holds an image change trigger.
+type triggerRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (d *ImageTriggerChains) Check() types.DiagnosticResult {
+	r := types.NewDiagnosticResult(ImageTriggerChainsName)
+
+	streams, err := d.ImageClient.ImageStreams(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		r.Error("DClu5001", err, fmt.Sprintf("Client error while listing image streams: (%T) %[1]v", err))
+		return r
+	}
+	streamByName := map[string]*imageapi.ImageStream{}
+	for i := range streams.Items {
+		stream := &streams.Items[i]
+		streamByName[stream.Namespace+"/"+stream.Name] = stream
+	}
+
+	buildConfigs, err := d.BuildClient.BuildConfigs(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		r.Error("DClu5002", err, fmt.Sprintf("Client error while listing build configs: (%T) %[1]v", err))
+		return r
+	}
+	deploymentConfigs, err := d.AppsClient.DeploymentConfigs(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		r.Error("DClu5003", err, fmt.Sprintf("Client error while listing deployment configs: (%T) %[1]v", err))
+		return r
+	}
+
+	// edges maps an input ImageStreamTag to the build configs it triggers, keyed by
+	// the tag each of those builds pushes to. It is the graph used for cycle detection.
+	edges := map[string]map[string]string{}
+	referencedBy := map[string]triggerRef{}
+
+	for i := range buildConfigs.Items {
+		bc := &buildConfigs.Items[i]
+		ref := triggerRef{kind: "BuildConfig", namespace: bc.Namespace, name: bc.Name}
+		outputTag := ""
+		if to := bc.Spec.Output.To; to != nil && to.Kind == "ImageStreamTag" {
+			outputTag = tagKey(bc.Namespace, to.Namespace, to.Name)
+		}
+		for _, trigger := range bc.Spec.Triggers {
+			if trigger.Type != buildapi.ImageChangeBuildTriggerType || trigger.ImageChange == nil {
+				continue
+			}
+			from := trigger.ImageChange.From
+			if from == nil {
+				from = buildapi.GetInputReference(bc.Spec.Strategy)
+			}
+			if from == nil || from.Kind != "ImageStreamTag" {
+				continue
+			}
+			inputTag := tagKey(bc.Namespace, from.Namespace, from.Name)
+			d.checkTagExists(r, ref, inputTag, streamByName)
+			referencedBy[inputTag] = ref
+			if len(outputTag) > 0 {
+				if edges[inputTag] == nil {
+					edges[inputTag] = map[string]string{}
+				}
+				edges[inputTag][outputTag] = fmt.Sprintf("%s/%s", bc.Namespace, bc.Name)
+			}
+		}
+	}
+
+	for i := range deploymentConfigs.Items {
+		dc := &deploymentConfigs.Items[i]
+		ref := triggerRef{kind: "DeploymentConfig", namespace: dc.Namespace, name: dc.Name}
+		for _, trigger := range dc.Spec.Triggers {
+			if trigger.ImageChangeParams == nil || trigger.ImageChangeParams.From.Kind != "ImageStreamTag" {
+				continue
+			}
+			inputTag := tagKey(dc.Namespace, trigger.ImageChangeParams.From.Namespace, trigger.ImageChangeParams.From.Name)
+			d.checkTagExists(r, ref, inputTag, streamByName)
+			referencedBy[inputTag] = ref
+		}
+	}
+
+	d.checkCircularChains(r, edges)
+	d.checkStaleTags(r, referencedBy, streamByName)
+
+	return r
+}
+
+// checkTagExists warns when the image stream or the tag history behind a
+// trigger reference is missing.
+func (d *ImageTriggerChains) checkTagExists(r types.DiagnosticResult, ref triggerRef, tag string, streamByName map[string]*imageapi.ImageStream) {
+	streamName, tagName := splitTagKey(tag)
+	stream, exists := streamByName[streamName]
+	if !exists {
+		r.Warn("DClu5004", nil, fmt.Sprintf(chainMissingStream, ref.kind, ref.namespace, ref.name, tag, streamName))
+		return
+	}
+	if _, ok := stream.Status.Tags[tagName]; !ok {
+		r.Warn("DClu5005", nil, fmt.Sprintf(chainMissingTag, ref.kind, ref.namespace, ref.name, tag))
+	}
+}
+
+// checkCircularChains runs a depth-first search over the tag graph built from
+// build config triggers and reports every cycle it finds once.
+func (d *ImageTriggerChains) checkCircularChains(r types.DiagnosticResult, edges map[string]map[string]string) {
+	const (
+		white = iota // unvisited
+		gray         // on the current path
+		black        // fully explored
+	)
+	color := map[string]int{}
+	reported := map[string]bool{}
+
+	var visit func(tag string, path []string)
+	visit = func(tag string, path []string) {
+		color[tag] = gray
+		path = append(path, tag)
+		for next := range edges[tag] {
+			switch color[next] {
+			case gray:
+				// unwind the path back to the repeated tag to name the cycle members
+				cycle := []string{}
+				for i := len(path) - 1; i >= 0; i-- {
+					cycle = append([]string{path[i]}, cycle...)
+					if path[i] == next {
+						break
+					}
+				}
+				members := []string{}
+				for i := range cycle {
+					from := cycle[i]
+					to := next
+					if i < len(cycle)-1 {
+						to = cycle[i+1]
+					}
+					members = append(members, fmt.Sprintf("%s (%s -> %s)", edges[from][to], from, to))
+				}
+				sort.Strings(members)
+				key := strings.Join(members, ", ")
+				if !reported[key] {
+					reported[key] = true
+					r.Error("DClu5006", nil, fmt.Sprintf(chainCircular, strings.Join(members, "\n  ")))
+				}
+				_ = via
+			case white:
+				visit(next, path)
+			}
+		}
+		color[tag] = black
+	}
+
+	tags := []string{}
+	for tag := range edges {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		if color[tag] == white {
+			visit(tag, nil)
+		}
+	}
+}
+
+// checkStaleTags warns about trigger inputs whose most recent image is older
+// than the threshold.
+func (d *ImageTriggerChains) checkStaleTags(r types.DiagnosticResult, referencedBy map[string]triggerRef, streamByName map[string]*imageapi.ImageStream) {
+	tags := []string{}
+	for tag := range referencedBy {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		streamName, tagName := splitTagKey(tag)
+		stream, exists := streamByName[streamName]
+		if !exists {
+			continue
+		}
+		history, ok := stream.Status.Tags[tagName]
+		if !ok || len(history.Items) == 0 {
+			continue
+		}
+		if age := time.Since(history.Items[0].Created.Time); age > staleTagThreshold {
+			ref := referencedBy[tag]
+			r.Warn("DClu5007", nil, fmt.Sprintf(chainStaleTag, tag, ref.kind, ref.namespace, ref.name, age.Round(time.Hour)))
+		}
+	}
+}
+
+// tagKey normalizes an ImageStreamTag reference into "namespace/name:tag" form,
+// applying the namespace of the referencing object when the reference has none.
+func tagKey(defaultNamespace, refNamespace, refName string) string {
+	namespace := refNamespace
+	if len(namespace) == 0 {
+		namespace = defaultNamespace
+	}
+	name, tag, _ := imageapi.SplitImageStreamTag(refName)
+	return fmt.Sprintf("%s/%s:%s", namespace, name, tag)
+}
+
+// splitTagKey splits a "namespace/name:tag" key into the stream key and the tag.
+func splitTagKey(key string) (string, string) {
+	i := strings.LastIndex(key, ":")
+	return key[:i], key[i+1:]
+}
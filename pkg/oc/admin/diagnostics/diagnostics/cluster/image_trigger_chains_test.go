@@ -0,0 +1,50 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/openshift/origin/pkg/oc/admin/diagnostics/diagnostics/types"
+)
+
+func TestTagKey(t *testing.T) {
+	tests := []struct {
+		defaultNamespace string
+		refNamespace     string
+		refName          string
+		expected         string
+	}{
+		{"app", "", "ruby:2.4", "app/ruby:2.4"},
+		{"app", "openshift", "ruby:2.4", "openshift/ruby:2.4"},
+		{"app", "", "ruby", "app/ruby:latest"},
+	}
+	for _, test := range tests {
+		if key := tagKey(test.defaultNamespace, test.refNamespace, test.refName); key != test.expected {
+			t.Errorf("tagKey(%q, %q, %q): expected %q, got %q", test.defaultNamespace, test.refNamespace, test.refName, test.expected, key)
+		}
+	}
+}
+
+func TestCheckCircularChains(t *testing.T) {
+	d := &ImageTriggerChains{}
+
+	// base -> intermediate -> final -> base forms a cycle; side does not
+	edges := map[string]map[string]string{
+		"app/base:latest":         {"app/intermediate:latest": "app/build-intermediate"},
+		"app/intermediate:latest": {"app/final:latest": "app/build-final"},
+		"app/final:latest":        {"app/base:latest": "app/build-base", "app/side:latest": "app/build-side"},
+	}
+
+	r := types.NewDiagnosticResult(ImageTriggerChainsName)
+	d.checkCircularChains(r, edges)
+	if len(r.Errors()) != 1 {
+		t.Fatalf("expected a single circular chain error, got %d: %v", len(r.Errors()), r.Errors())
+	}
+
+	// removing the closing edge leaves an acyclic graph
+	delete(edges["app/final:latest"], "app/base:latest")
+	r = types.NewDiagnosticResult(ImageTriggerChainsName)
+	d.checkCircularChains(r, edges)
+	if len(r.Errors()) != 0 {
+		t.Errorf("expected no errors for an acyclic graph, got %v", r.Errors())
+	}
+}
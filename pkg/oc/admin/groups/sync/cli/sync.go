@@ -95,6 +95,10 @@ type SyncOptions struct {
 	// Confirm determines whether or not to write to OpenShift
 	Confirm bool
 
+	// ConfirmThreshold aborts the sync before any changes are made when more than this
+	// percentage of existing memberships would change. Zero disables the check.
+	ConfirmThreshold int
+
 	// GroupInterface is the interface used to interact with OpenShift Group objects
 	GroupInterface usertypedclient.GroupInterface
 
@@ -176,6 +180,7 @@ func NewCmdSync(name, fullName string, f *clientcmd.Factory, out io.Writer) *cob
 
 	cmd.Flags().StringVar(&typeArg, "type", typeArg, "which groups white- and blacklist entries refer to: "+strings.Join(AllowedSourceTypes, ","))
 	cmd.Flags().BoolVar(&options.Confirm, "confirm", false, "if true, modify OpenShift groups; if false, display results of a dry-run")
+	cmd.Flags().IntVar(&options.ConfirmThreshold, "confirm-threshold", 0, "abort without making changes if more than this percentage of existing memberships would change; 0 disables the check")
 
 	kcmdutil.AddPrinterFlags(cmd)
 	cmd.Flags().Lookup("output").DefValue = "yaml"
@@ -372,9 +377,10 @@ func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 
 	// populate schema-independent syncer fields
 	syncer := &syncgroups.LDAPGroupSyncer{
-		Host:        clientConfig.Host(),
-		GroupClient: o.GroupInterface,
-		DryRun:      !o.Confirm,
+		Host:             clientConfig.Host(),
+		GroupClient:      o.GroupInterface,
+		DryRun:           !o.Confirm,
+		ConfirmThreshold: o.ConfirmThreshold,
 
 		Out: o.Out,
 		Err: os.Stderr,
@@ -417,6 +423,18 @@ func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 
 	// Now we run the Syncer and report any errors
 	openshiftGroups, syncErrors := syncer.Sync()
+
+	// print the change report so the operator can review what the sync did, or in a
+	// dry-run, what it would do
+	for _, change := range syncer.Report().Changes {
+		switch {
+		case change.Created:
+			fmt.Fprintf(o.Stderr, "group/%s: created with %d members\n", change.GroupName, len(change.MembersAdded))
+		case len(change.MembersAdded) > 0 || len(change.MembersRemoved) > 0:
+			fmt.Fprintf(o.Stderr, "group/%s: added %v, removed %v\n", change.GroupName, change.MembersAdded, change.MembersRemoved)
+		}
+	}
+
 	if o.Confirm {
 		return kerrs.NewAggregate(syncErrors)
 	}
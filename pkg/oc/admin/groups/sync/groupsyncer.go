@@ -11,6 +11,7 @@ import (
 
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/oauthserver/ldaputil"
 	"github.com/openshift/origin/pkg/oc/admin/groups/sync/interfaces"
@@ -40,10 +41,16 @@ type LDAPGroupSyncer struct {
 	Host string
 	// DryRun indicates that no changes should be made.
 	DryRun bool
+	// ConfirmThreshold aborts the sync before any changes are made when more than this
+	// percentage of existing memberships would change. Zero disables the check.
+	ConfirmThreshold int
 
 	// Out is used to provide output while the sync job is happening
 	Out io.Writer
 	Err io.Writer
+
+	// report accumulates the membership deltas computed during Sync
+	report SyncReport
 }
 
 var _ GroupSyncer = &LDAPGroupSyncer{}
@@ -90,11 +97,22 @@ func (s *LDAPGroupSyncer) Sync() ([]*userapi.Group, []error) {
 			continue
 		}
 		openshiftGroups = append(openshiftGroups, openshiftGroup)
+	}
+
+	// enforce the confirmation threshold before any changes are made so that a bad
+	// LDAP query cannot wipe out large amounts of membership data
+	if s.ConfirmThreshold > 0 {
+		if percent, ok := s.report.PercentChanged(); ok && percent > s.ConfirmThreshold {
+			errors = append(errors, fmt.Errorf("sync would change %d%% of existing memberships, which exceeds the confirmation threshold of %d%%; no changes were made", percent, s.ConfirmThreshold))
+			return openshiftGroups, errors
+		}
+	}
 
-		if !s.DryRun {
+	if !s.DryRun {
+		for _, openshiftGroup := range openshiftGroups {
 			fmt.Fprintf(s.Out, "group/%s\n", openshiftGroup.Name)
 			if err := s.updateOpenShiftGroup(openshiftGroup); err != nil {
-				fmt.Fprintf(s.Err, "Error updating OpenShift group %q for LDAP group %q: %v.\n", openshiftGroup.Name, ldapGroupUID, err)
+				fmt.Fprintf(s.Err, "Error updating OpenShift group %q: %v.\n", openshiftGroup.Name, err)
 				errors = append(errors, err)
 				continue
 			}
@@ -104,6 +122,11 @@ func (s *LDAPGroupSyncer) Sync() ([]*userapi.Group, []error) {
 	return openshiftGroups, errors
 }
 
+// Report returns the membership deltas computed by the last call to Sync
+func (s *LDAPGroupSyncer) Report() *SyncReport {
+	return &s.report
+}
+
 // determineUsers determines the OpenShift Users that correspond to a list of LDAP member entries
 func (s *LDAPGroupSyncer) determineUsernames(members []*ldap.Entry) ([]string, error) {
 	var usernames []string
@@ -141,8 +164,10 @@ func (s *LDAPGroupSyncer) makeOpenShiftGroup(ldapGroupUID string, usernames []st
 		return nil, err
 	}
 
+	created := false
 	group, err := s.GroupClient.Get(groupName, metav1.GetOptions{})
 	if kapierrors.IsNotFound(err) {
+		created = true
 		group = &userapi.Group{}
 		group.Name = groupName
 		group.Annotations = map[string]string{
@@ -171,6 +196,18 @@ func (s *LDAPGroupSyncer) makeOpenShiftGroup(ldapGroupUID string, usernames []st
 			group.Name, ldaputil.LDAPUIDAnnotation, ldapGroupUID, uid)
 	}
 
+	// record the membership delta before the old membership data is overwritten
+	oldMembers := sets.NewString(group.Users...)
+	newMembers := sets.NewString(usernames...)
+	s.report.Changes = append(s.report.Changes, GroupChange{
+		GroupName:      group.Name,
+		LDAPGroupUID:   ldapGroupUID,
+		Created:        created,
+		MembersAdded:   newMembers.Difference(oldMembers).List(),
+		MembersRemoved: oldMembers.Difference(newMembers).List(),
+	})
+	s.report.PriorMemberships += oldMembers.Len()
+
 	// overwrite Group Users data
 	group.Users = usernames
 	group.Annotations[ldaputil.LDAPSyncTimeAnnotation] = ISO8601(time.Now())
@@ -230,6 +230,55 @@ func TestMissingLDAPGroupUIDMapping(t *testing.T) {
 	checkClientForGroups(tc, newDefaultOpenShiftGroups(testGroupSyncer.Host), t)
 }
 
+// TestConfirmThresholdAborts ensures that no writes happen when the membership changes
+// computed by the sync exceed the confirmation threshold.
+func TestConfirmThresholdAborts(t *testing.T) {
+	existingGroup := &userapi.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "os" + Group1UID,
+			Annotations: map[string]string{
+				ldaputil.LDAPURLAnnotation: newTestHost(),
+				ldaputil.LDAPUIDAnnotation: Group1UID,
+			},
+			Labels: map[string]string{
+				ldaputil.LDAPHostLabel: strings.Split(newTestHost(), ":")[0],
+			},
+		},
+		Users: []string{Member1UID, "stale1", "stale2", "stale3"},
+	}
+	tc := userfakeclient.NewSimpleClientset(existingGroup)
+
+	testGroupSyncer := &LDAPGroupSyncer{
+		GroupLister:          newTestLister(),
+		GroupMemberExtractor: newTestMemberExtractor(),
+		UserNameMapper:       newTestUserNameMapper(),
+		GroupNameMapper:      newTestGroupNameMapper(),
+		GroupClient:          tc.User().Groups(),
+		Host:                 newTestHost(),
+		ConfirmThreshold:     50,
+		Out:                  ioutil.Discard,
+		Err:                  ioutil.Discard,
+	}
+
+	_, errs := testGroupSyncer.Sync()
+	if len(errs) != 1 {
+		t.Fatalf("expected a threshold error, got %v", errs)
+	} else if !strings.Contains(errs[0].Error(), "confirmation threshold") {
+		t.Fatalf("expected a threshold error, got %v", errs)
+	}
+
+	for _, action := range tc.Actions() {
+		switch action.GetVerb() {
+		case "create", "update":
+			t.Errorf("unexpected write action: %v", action)
+		}
+	}
+
+	if changes := testGroupSyncer.Report().MembershipChanges(); changes == 0 {
+		t.Error("expected the report to record membership changes")
+	}
+}
+
 func checkClientForGroups(tc *userfakeclient.Clientset, expectedGroups []*userapi.Group, t *testing.T) {
 	actualGroups := extractActualGroups(tc)
 
@@ -0,0 +1,43 @@
+package syncgroups
+
+// GroupChange records the membership delta computed for a single group during a sync
+type GroupChange struct {
+	// GroupName is the name of the OpenShift Group
+	GroupName string
+	// LDAPGroupUID is the LDAP group unique identifier the OpenShift Group is synced with
+	LDAPGroupUID string
+	// Created indicates that the OpenShift Group did not exist before the sync
+	Created bool
+	// MembersAdded are the usernames added to the group by the sync
+	MembersAdded []string
+	// MembersRemoved are the usernames removed from the group by the sync
+	MembersRemoved []string
+}
+
+// SyncReport aggregates the changes a sync run computed before applying them
+type SyncReport struct {
+	// Changes holds the per-group membership deltas in the order the groups were synced
+	Changes []GroupChange
+	// PriorMemberships is the total number of memberships that existed across the synced
+	// groups before the sync
+	PriorMemberships int
+}
+
+// MembershipChanges returns the total number of memberships that would be added or removed
+func (r *SyncReport) MembershipChanges() int {
+	total := 0
+	for _, change := range r.Changes {
+		total += len(change.MembersAdded) + len(change.MembersRemoved)
+	}
+	return total
+}
+
+// PercentChanged returns the percentage of existing memberships the sync would change.
+// It returns false if no memberships existed before the sync, since a percentage is
+// meaningless on an empty baseline (e.g. the very first sync).
+func (r *SyncReport) PercentChanged() (int, bool) {
+	if r.PriorMemberships == 0 {
+		return 0, false
+	}
+	return r.MembershipChanges() * 100 / r.PriorMemberships, true
+}
@@ -0,0 +1,193 @@
+package image
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+const MoveImageStreamRecommendedName = "move-imagestream"
+
+var (
+	moveImageStreamLong = templates.LongDesc(`
+		Move an image stream to a different namespace or name
+
+		This command copies an image stream, including all of its tags and the image
+		references they resolve to, to a new namespace and/or name, and marks the old
+		image stream as moved. The integrated registry keeps serving pulls of the old
+		repository name by redirecting to the new location until the redirect window
+		expires, so existing pull specs keep working while they are updated.
+
+		The source image stream is left in place, annotated with the new location and
+		the time after which the redirect (and the source stream) may be removed.
+		Images are referenced by digest on the new stream, so pulls through either
+		name resolve to identical content.
+
+		This command does not update references to the old repository in builds,
+		deployment configs, or pod templates; use 'oc adm migrate image-references'
+		for that once the move is complete.`)
+
+	moveImageStreamExample = templates.Examples(`
+	  # Move the "app" image stream from the "dev" project to the "prod" project
+	  %[1]s dev/app prod/app
+
+	  # Rename an image stream within the same project, keeping redirects for 7 days
+	  %[1]s myproject/old-name myproject/new-name --redirect-window=168h`)
+)
+
+type MoveImageStreamOptions struct {
+	Source         string
+	Destination    string
+	RedirectWindow time.Duration
+
+	ImageClient imageclient.ImageStreamsGetter
+
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// NewCmdMoveImageStream implements the move-imagestream command.
+func NewCmdMoveImageStream(name, fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	opts := &MoveImageStreamOptions{
+		Out:            out,
+		ErrOut:         errout,
+		RedirectWindow: 30 * 24 * time.Hour,
+	}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s SOURCE_NAMESPACE/NAME DEST_NAMESPACE/NAME", name),
+		Short:   "Move an image stream to a different namespace or name",
+		Long:    moveImageStreamLong,
+		Example: fmt.Sprintf(moveImageStreamExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(opts.Complete(f, cmd, args))
+			kcmdutil.CheckErr(opts.Validate())
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().DurationVar(&opts.RedirectWindow, "redirect-window", opts.RedirectWindow, "How long the registry serves redirects from the old repository name to the new one.")
+
+	return cmd
+}
+
+func (o *MoveImageStreamOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return kcmdutil.UsageErrorf(cmd, "expected SOURCE_NAMESPACE/NAME and DEST_NAMESPACE/NAME arguments")
+	}
+	o.Source, o.Destination = args[0], args[1]
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	client, err := imageclient.NewForConfig(clientConfig)
+	if err != nil {
+		return err
+	}
+	o.ImageClient = client
+	return nil
+}
+
+func (o *MoveImageStreamOptions) Validate() error {
+	for _, arg := range []string{o.Source, o.Destination} {
+		if _, _, err := splitStreamName(arg); err != nil {
+			return err
+		}
+	}
+	if o.Source == o.Destination {
+		return fmt.Errorf("source and destination must differ")
+	}
+	if o.RedirectWindow < 0 {
+		return fmt.Errorf("--redirect-window must not be negative")
+	}
+	return nil
+}
+
+func (o *MoveImageStreamOptions) Run() error {
+	srcNamespace, srcName, _ := splitStreamName(o.Source)
+	dstNamespace, dstName, _ := splitStreamName(o.Destination)
+
+	source, err := o.ImageClient.ImageStreams(srcNamespace).Get(srcName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if target, ok := source.Annotations[imageapi.MovedToAnnotation]; ok {
+		return fmt.Errorf("image stream %s has already been moved to %s", o.Source, target)
+	}
+
+	expires := time.Now().Add(o.RedirectWindow).UTC()
+
+	destination := &imageapi.ImageStream{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   dstNamespace,
+			Name:        dstName,
+			Labels:      source.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *source.Spec.DeepCopy(),
+	}
+	for k, v := range source.Annotations {
+		destination.Annotations[k] = v
+	}
+	destination.Annotations[imageapi.MovedFromAnnotation] = o.Source
+	// tag references by digest so the new repository resolves to identical content
+	if destination.Spec.Tags == nil {
+		destination.Spec.Tags = map[string]imageapi.TagReference{}
+	}
+	for tag, events := range source.Status.Tags {
+		if len(events.Items) == 0 {
+			continue
+		}
+		if _, ok := destination.Spec.Tags[tag]; ok {
+			continue
+		}
+		destination.Spec.Tags[tag] = imageapi.TagReference{
+			Name: tag,
+			From: &kapi.ObjectReference{
+				Kind:      "ImageStreamImage",
+				Namespace: srcNamespace,
+				Name:      imageapi.JoinImageStreamImage(srcName, events.Items[0].Image),
+			},
+		}
+	}
+
+	if _, err := o.ImageClient.ImageStreams(dstNamespace).Create(destination); err != nil {
+		if kapierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("image stream %s already exists - choose a different destination", o.Destination)
+		}
+		return err
+	}
+
+	if source.Annotations == nil {
+		source.Annotations = map[string]string{}
+	}
+	source.Annotations[imageapi.MovedToAnnotation] = o.Destination
+	source.Annotations[imageapi.MovedRedirectExpiresAnnotation] = expires.Format(time.RFC3339)
+	if _, err := o.ImageClient.ImageStreams(srcNamespace).Update(source); err != nil {
+		return fmt.Errorf("the destination image stream was created, but the source could not be marked as moved: %v", err)
+	}
+
+	fmt.Fprintf(o.Out, "imagestream %q moved to %q, pulls of the old repository redirect until %s\n", o.Source, o.Destination, expires.Format(time.RFC3339))
+	return nil
+}
+
+func splitStreamName(value string) (string, string, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("%q is not a valid image stream reference - expected NAMESPACE/NAME", value)
+	}
+	return parts[0], parts[1], nil
+}
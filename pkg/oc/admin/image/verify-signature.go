@@ -9,7 +9,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/containers/image/docker/policyconfiguration"
 	"github.com/containers/image/docker/reference"
@@ -67,6 +69,12 @@ var (
 
 	# Remove all signature verifications from the image
 	%[1]s sha256:c841e9b64e4579bd56c794bdd7c36e1c257110fd2404bebbb8b613e4935228c4 --remove-all
+
+	# Verify the signatures of every tag of an image stream and save the results
+	%[1]s --imagestream=mysql --save
+
+	# Verify the signatures of all image streams matching a label selector
+	%[1]s --selector=compliance=required --save
 	`)
 )
 
@@ -77,6 +85,9 @@ type VerifyImageSignatureOptions struct {
 	PublicKey         []byte
 	Save              bool
 	RemoveAll         bool
+	ImageStream       string
+	Selector          string
+	Namespace         string
 	CurrentUser       string
 	CurrentUserToken  string
 	RegistryURL       string
@@ -122,10 +133,24 @@ func NewCmdVerifyImageSignature(name, fullName string, f *clientcmd.Factory, out
 	cmd.Flags().StringVar(&opts.PublicKeyFilename, "public-key", opts.PublicKeyFilename, fmt.Sprintf("A path to a public GPG key to be used for verification. (defaults to %q)", opts.PublicKeyFilename))
 	cmd.Flags().StringVar(&opts.RegistryURL, "registry-url", opts.RegistryURL, "The address to use when contacting the registry, instead of using the internal cluster address. This is useful if you can't resolve or reach the internal registry address.")
 	cmd.Flags().BoolVar(&opts.Insecure, "insecure", opts.Insecure, "If set, use the insecure protocol for registry communication.")
+	cmd.Flags().StringVar(&opts.ImageStream, "imagestream", opts.ImageStream, "If set, verify the signatures of every tagged image in the given image stream. The expected identity of each image is derived from the image stream tag.")
+	cmd.Flags().StringVar(&opts.Selector, "selector", opts.Selector, "If set, verify the signatures of every tagged image in all image streams matching the label selector.")
 	return cmd
 }
 
 func (o *VerifyImageSignatureOptions) Validate() error {
+	if o.batchMode() {
+		if len(o.ImageStream) > 0 && len(o.Selector) > 0 {
+			return errors.New("only one of --imagestream and --selector may be specified")
+		}
+		if o.RemoveAll {
+			return errors.New("the --remove-all cannot be used when verifying image streams")
+		}
+		if len(o.ExpectedIdentity) > 0 {
+			return errors.New("the --expected-identity cannot be used when verifying image streams, the identity is derived from the image stream tag")
+		}
+		return nil
+	}
 	if !o.RemoveAll {
 		if len(o.ExpectedIdentity) == 0 {
 			return errors.New("the --expected-identity is required")
@@ -140,11 +165,20 @@ func (o *VerifyImageSignatureOptions) Validate() error {
 	return nil
 }
 func (o *VerifyImageSignatureOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
-	if len(args) != 1 {
-		return kcmdutil.UsageErrorf(cmd, "exactly one image must be specified")
-	}
-	o.InputImage = args[0]
 	var err error
+	if o.batchMode() {
+		if len(args) != 0 {
+			return kcmdutil.UsageErrorf(cmd, "an image cannot be specified when verifying image streams")
+		}
+		if o.Namespace, _, err = f.DefaultNamespace(); err != nil {
+			return err
+		}
+	} else {
+		if len(args) != 1 {
+			return kcmdutil.UsageErrorf(cmd, "exactly one image must be specified")
+		}
+		o.InputImage = args[0]
+	}
 
 	if len(o.PublicKeyFilename) > 0 {
 		if o.PublicKey, err = ioutil.ReadFile(o.PublicKeyFilename); err != nil {
@@ -181,7 +215,32 @@ func (o *VerifyImageSignatureOptions) Complete(f *clientcmd.Factory, cmd *cobra.
 	return nil
 }
 
+// batchMode returns true if the command should verify image streams instead of a
+// single image.
+func (o *VerifyImageSignatureOptions) batchMode() bool {
+	return len(o.ImageStream) > 0 || len(o.Selector) > 0
+}
+
+// newPolicyContext prepares the signature verification policy that trusts the
+// configured public GPG key.
+func (o *VerifyImageSignatureOptions) newPolicyContext() (*signature.PolicyContext, error) {
+	pr, err := signature.NewPRSignedByKeyPath(signature.SBKeyTypeGPGKeys, o.PublicKeyFilename, signature.NewPRMMatchRepoDigestOrExact())
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare verification policy requirements: %v", err)
+	}
+	policy := signature.Policy{Default: []signature.PolicyRequirement{pr}}
+	pc, err := signature.NewPolicyContext(&policy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup policy: %v", err)
+	}
+	return pc, nil
+}
+
 func (o VerifyImageSignatureOptions) Run() error {
+	if o.batchMode() {
+		return o.runBatch()
+	}
+
 	img, err := o.ImageClient.Images().Get(o.InputImage, metav1.GetOptions{})
 	if err != nil {
 		return err
@@ -190,14 +249,9 @@ func (o VerifyImageSignatureOptions) Run() error {
 		return fmt.Errorf("%s does not have any signature", img.Name)
 	}
 
-	pr, err := signature.NewPRSignedByKeyPath(signature.SBKeyTypeGPGKeys, o.PublicKeyFilename, signature.NewPRMMatchRepoDigestOrExact())
+	pc, err := o.newPolicyContext()
 	if err != nil {
-		return fmt.Errorf("unable to prepare verification policy requirements: %v", err)
-	}
-	policy := signature.Policy{Default: []signature.PolicyRequirement{pr}}
-	pc, err := signature.NewPolicyContext(&policy)
-	if err != nil {
-		return fmt.Errorf("unable to setup policy: %v", err)
+		return err
 	}
 	defer pc.Destroy()
 
@@ -205,15 +259,30 @@ func (o VerifyImageSignatureOptions) Run() error {
 		img.Signatures = []imageapi.ImageSignature{}
 	}
 
+	o.verifyImageSignatures(pc, img, o.ExpectedIdentity)
+
+	if o.Save || o.RemoveAll {
+		_, err := o.ImageClient.Images().Update(img)
+		return err
+	}
+	return nil
+}
+
+// verifyImageSignatures verifies every signature of the image against the given
+// expected identity and updates the signature conditions in place. It returns
+// true when at least one signature confirmed the image identity.
+func (o VerifyImageSignatureOptions) verifyImageSignatures(pc *signature.PolicyContext, img *imageapi.Image, expectedIdentity string) bool {
+	verified := false
 	for i, s := range img.Signatures {
 		// Verify the signature against the policy
-		signedBy, err := o.verifySignature(pc, img, s.Content)
+		signedBy, err := o.verifySignature(pc, img, s.Content, expectedIdentity)
 		if err != nil {
-			fmt.Fprintf(o.ErrOut, "error verifying signature %s for image %s (verification status will be removed): %v\n", img.Signatures[i].Name, o.InputImage, err)
+			fmt.Fprintf(o.ErrOut, "error verifying signature %s for image %s (verification status will be removed): %v\n", img.Signatures[i].Name, img.Name, err)
 			img.Signatures[i] = imageapi.ImageSignature{}
 			continue
 		}
-		fmt.Fprintf(o.Out, "image %q identity is now confirmed (signed by GPG key %q)\n", o.InputImage, signedBy)
+		fmt.Fprintf(o.Out, "image %q identity is now confirmed (signed by GPG key %q)\n", img.Name, signedBy)
+		verified = true
 
 		now := metav1.Now()
 		newConditions := []imageapi.SignatureCondition{
@@ -238,14 +307,97 @@ func (o VerifyImageSignatureOptions) Run() error {
 		// TODO: This should not be just a key id but a human-readable identity.
 		img.Signatures[i].IssuedBy.CommonName = signedBy
 	}
+	return verified
+}
 
-	if o.Save || o.RemoveAll {
-		_, err := o.ImageClient.Images().Update(img)
+// runBatch verifies the signatures of every tagged image in the selected image
+// streams and prints a per tag report followed by a summary suitable for
+// compliance scans. The expected identity of each image is the image stream tag
+// pull spec.
+func (o VerifyImageSignatureOptions) runBatch() error {
+	var streams []imageapi.ImageStream
+	if len(o.ImageStream) > 0 {
+		stream, err := o.ImageClient.ImageStreams(o.Namespace).Get(o.ImageStream, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		streams = append(streams, *stream)
+	} else {
+		list, err := o.ImageClient.ImageStreams(o.Namespace).List(metav1.ListOptions{LabelSelector: o.Selector})
+		if err != nil {
+			return err
+		}
+		streams = list.Items
+	}
+
+	pc, err := o.newPolicyContext()
+	if err != nil {
 		return err
 	}
+	defer pc.Destroy()
+
+	verified, rejected, unsigned := 0, 0, 0
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTAG\tIMAGE\tSTATUS")
+	for i := range streams {
+		stream := &streams[i]
+		tags := []string{}
+		for tag := range stream.Status.Tags {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		for _, tag := range tags {
+			latest := imageapi.LatestTaggedImage(stream, tag)
+			if latest == nil || len(latest.Image) == 0 {
+				continue
+			}
+			img, err := o.ImageClient.Images().Get(latest.Image, metav1.GetOptions{})
+			if err != nil {
+				fmt.Fprintf(o.ErrOut, "error getting image %s for %s:%s: %v\n", latest.Image, stream.Name, tag, err)
+				rejected++
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", stream.Name, tag, latest.Image, "error")
+				continue
+			}
+			if len(img.Signatures) == 0 {
+				unsigned++
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", stream.Name, tag, img.Name, "unsigned")
+				continue
+			}
+			if o.verifyImageSignatures(pc, img, verifyIdentityForTag(stream, tag)) {
+				verified++
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", stream.Name, tag, img.Name, "verified")
+			} else {
+				rejected++
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", stream.Name, tag, img.Name, "rejected")
+			}
+			if o.Save {
+				if _, err := o.ImageClient.Images().Update(img); err != nil {
+					fmt.Fprintf(o.ErrOut, "error saving verification status of image %s: %v\n", img.Name, err)
+				}
+			}
+		}
+	}
+	w.Flush()
+
+	fmt.Fprintf(o.Out, "Verified %d, rejected %d and unsigned %d tagged images in %d image stream(s)\n", verified, rejected, unsigned, len(streams))
+	if rejected > 0 {
+		return fmt.Errorf("%d tagged image(s) failed signature verification", rejected)
+	}
 	return nil
 }
 
+// verifyIdentityForTag returns the expected signature identity for a tag of the
+// image stream, which is the pull spec users would reference the tag by.
+func verifyIdentityForTag(stream *imageapi.ImageStream, tag string) string {
+	ref, err := imageapi.ParseDockerImageReference(stream.Status.DockerImageRepository)
+	if err != nil {
+		return imageapi.JoinImageStreamTag(stream.Status.DockerImageRepository, tag)
+	}
+	ref.Tag = tag
+	ref.ID = ""
+	return ref.Exact()
+}
+
 // getImageManifest fetches the manifest for provided image from the integrated registry.
 func (o *VerifyImageSignatureOptions) getImageManifest(img *imageapi.Image) ([]byte, error) {
 	parsed, err := imageapi.ParseDockerImageReference(img.DockerImageReference)
@@ -267,12 +419,12 @@ func (o *VerifyImageSignatureOptions) getImageManifest(img *imageapi.Image) ([]b
 // signature message and the manifest matches as well.
 // In case the image identity is confirmed, this function returns the matching GPG key in
 // short form, otherwise it returns rejection reason.
-func (o *VerifyImageSignatureOptions) verifySignature(pc *signature.PolicyContext, img *imageapi.Image, sigBlob []byte) (string, error) {
+func (o *VerifyImageSignatureOptions) verifySignature(pc *signature.PolicyContext, img *imageapi.Image, sigBlob []byte, expectedIdentity string) (string, error) {
 	manifest, err := o.getImageManifest(img)
 	if err != nil {
 		return "", fmt.Errorf("failed to get image %q manifest: %v", img.Name, err)
 	}
-	allowed, err := pc.IsRunningImageAllowed(newUnparsedImage(o.ExpectedIdentity, sigBlob, manifest))
+	allowed, err := pc.IsRunningImageAllowed(newUnparsedImage(expectedIdentity, sigBlob, manifest))
 	if !allowed && err == nil {
 		return "", errors.New("signature rejected but no error set")
 	}
@@ -3,7 +3,10 @@ package migrate
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/glog"
@@ -13,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/kubernetes/pkg/kubectl"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
@@ -20,6 +24,9 @@ import (
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
 )
 
+// checkpointWriteInterval is the number of processed resources between checkpoint writes.
+const checkpointWriteInterval = 100
+
 // MigrateVisitFunc is invoked for each returned object, and may return a
 // Reporter that can contain info to be used by save.
 type MigrateVisitFunc func(info *resource.Info) (Reporter, error)
@@ -60,14 +67,17 @@ type ResourceOptions struct {
 	In          io.Reader
 	Out, ErrOut io.Writer
 
-	Unstructured  bool
-	AllNamespaces bool
-	Include       []string
-	Filenames     []string
-	Confirm       bool
-	Output        string
-	FromKey       string
-	ToKey         string
+	Unstructured   bool
+	AllNamespaces  bool
+	Include        []string
+	Filenames      []string
+	Confirm        bool
+	Output         string
+	FromKey        string
+	ToKey          string
+	Workers        int
+	QPS            float32
+	CheckpointFile string
 
 	OverlappingResources []sets.String
 	DefaultExcludes      []schema.GroupResource
@@ -88,6 +98,10 @@ func (o *ResourceOptions) Bind(c *cobra.Command) {
 	c.Flags().StringVar(&o.FromKey, "from-key", o.FromKey, "If specified, only migrate items with a key (namespace/name or name) greater than or equal to this value")
 	c.Flags().StringVar(&o.ToKey, "to-key", o.ToKey, "If specified, only migrate items with a key (namespace/name or name) less than this value")
 
+	c.Flags().IntVar(&o.Workers, "workers", 1, "Number of parallel workers used to migrate objects.")
+	c.Flags().Float32Var(&o.QPS, "qps", 0, "Maximum number of migration requests per second across all workers. Zero means no limit.")
+	c.Flags().StringVar(&o.CheckpointFile, "checkpoint-file", "", "If specified, periodically record the last migrated key to this file. When the file already contains a key and --from-key is unset, the migration resumes from the recorded key.")
+
 	// kcmdutil.PrinterForCommand needs these flags, however they are useless
 	// here because oc process returns list of heterogeneous objects that is
 	// not suitable for formatting as a table.
@@ -138,6 +152,18 @@ func (o *ResourceOptions) Complete(f *clientcmd.Factory, c *cobra.Command) error
 	}
 	allNamespaces := !explicitNamespace && o.AllNamespaces
 
+	// resume from the key recorded by an interrupted run
+	if len(o.CheckpointFile) > 0 && len(o.FromKey) == 0 {
+		key, err := readCheckpoint(o.CheckpointFile)
+		if err != nil {
+			return err
+		}
+		if len(key) > 0 {
+			fmt.Fprintf(o.ErrOut, "info: resuming from key %q recorded in %s\n", key, o.CheckpointFile)
+			o.FromKey = key
+		}
+	}
+
 	if len(o.FromKey) > 0 || len(o.ToKey) > 0 {
 		o.FilterFn = func(info *resource.Info) (bool, error) {
 			var key string
@@ -252,18 +278,30 @@ func (o *ResourceOptions) Validate() error {
 	if len(o.Filenames) == 0 && len(o.Include) == 0 {
 		return fmt.Errorf("you must specify at least one resource or resource type to migrate with --include or --filenames")
 	}
+	if o.Workers < 0 {
+		return fmt.Errorf("--workers must be at least 1")
+	}
+	if o.QPS < 0 {
+		return fmt.Errorf("--qps must not be negative")
+	}
 	return nil
 }
 
 func (o *ResourceOptions) Visitor() *ResourceVisitor {
-	return &ResourceVisitor{
-		Out:      o.Out,
-		Builder:  o.Builder,
-		SaveFn:   o.SaveFn,
-		PrintFn:  o.PrintFn,
-		FilterFn: o.FilterFn,
-		DryRun:   o.DryRun,
+	v := &ResourceVisitor{
+		Out:            o.Out,
+		Builder:        o.Builder,
+		SaveFn:         o.SaveFn,
+		PrintFn:        o.PrintFn,
+		FilterFn:       o.FilterFn,
+		DryRun:         o.DryRun,
+		Workers:        o.Workers,
+		CheckpointFile: o.CheckpointFile,
+	}
+	if o.QPS > 0 {
+		v.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(o.QPS, int(o.QPS)+1)
 	}
+	return v
 }
 
 type ResourceVisitor struct {
@@ -276,6 +314,15 @@ type ResourceVisitor struct {
 	FilterFn MigrateFilterFunc
 
 	DryRun bool
+
+	// Workers is the number of goroutines that process objects concurrently.
+	// Values below one are treated as one.
+	Workers int
+	// RateLimiter, if set, throttles requests across all workers.
+	RateLimiter flowcontrol.RateLimiter
+	// CheckpointFile, if set, is periodically updated with the highest migrated
+	// key so that an interrupted migration can be resumed.
+	CheckpointFile string
 }
 
 func (o *ResourceVisitor) Visit(fn MigrateVisitFunc) error {
@@ -301,35 +348,71 @@ func (o *ResourceVisitor) Visit(fn MigrateVisitFunc) error {
 	result.IgnoreErrors(errors.IsMethodNotSupported, errors.IsNotFound)
 
 	t := migrateTracker{
-		out:       out,
-		migrateFn: fn,
-		actionFn:  actionFn,
-		dryRun:    dryRun,
+		out:         out,
+		migrateFn:   fn,
+		actionFn:    actionFn,
+		dryRun:      dryRun,
+		rateLimiter: o.RateLimiter,
 
 		resourcesWithErrors: sets.NewString(),
 	}
+	if len(o.CheckpointFile) > 0 && !dryRun {
+		t.checkpoint = &checkpointWriter{path: o.CheckpointFile, every: checkpointWriteInterval}
+	}
+
+	workers := o.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	work := make(chan *resource.Info, workers)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for info := range work {
+				t.attempt(info, 10)
+			}
+		}()
+	}
 
 	err := result.Visit(func(info *resource.Info, err error) error {
 		if err == nil && o.FilterFn != nil {
 			var ok bool
 			if ok, err = o.FilterFn(info); err == nil && !ok {
+				t.lock.Lock()
 				t.found++
 				t.ignored++
 				if glog.V(2) {
 					t.report("ignored:", info, nil)
 				}
+				t.lock.Unlock()
 				return nil
 			}
 		}
 		if err != nil {
+			t.lock.Lock()
 			t.resourcesWithErrors.Insert(info.Mapping.Resource)
 			t.errors++
 			t.report("error:", info, err)
+			t.lock.Unlock()
 			return nil
 		}
-		t.attempt(info, 10)
+		work <- info
 		return nil
 	})
+	close(work)
+	wg.Wait()
+
+	if t.checkpoint != nil {
+		if err == nil && t.errors == 0 {
+			// a completed migration no longer needs its checkpoint
+			t.checkpoint.clear()
+		} else {
+			t.checkpoint.flush()
+			fmt.Fprintf(out, "info: rerun with --checkpoint-file=%s to resume from the last migrated key\n", o.CheckpointFile)
+		}
+	}
 
 	if summarize {
 		if dryRun {
@@ -401,13 +484,17 @@ const (
 )
 
 // migrateTracker abstracts transforming and saving resources and can be used to keep track
-// of how many total resources have been updated.
+// of how many total resources have been updated. It may be used from multiple workers -
+// lock guards the counters, the output writer and the checkpoint.
 type migrateTracker struct {
-	out       io.Writer
-	migrateFn MigrateVisitFunc
-	actionFn  MigrateActionFunc
-	dryRun    bool
-
+	out         io.Writer
+	migrateFn   MigrateVisitFunc
+	actionFn    MigrateActionFunc
+	dryRun      bool
+	rateLimiter flowcontrol.RateLimiter
+	checkpoint  *checkpointWriter
+
+	lock                              sync.Mutex
 	found, ignored, unchanged, errors int
 
 	resourcesWithErrors sets.String
@@ -430,8 +517,13 @@ func (t *migrateTracker) report(prefix string, info *resource.Info, err error) {
 // attempt will try to invoke the migrateFn and saveFn on info, retrying any recalculation requests up
 // to retries times.
 func (t *migrateTracker) attempt(info *resource.Info, retries int) {
-	t.found++
 	result, err := t.try(info, retries)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.found++
+	if err == nil && t.checkpoint != nil {
+		t.checkpoint.record(checkpointKey(info))
+	}
 	switch {
 	case err != nil:
 		t.resourcesWithErrors.Insert(info.Mapping.Resource)
@@ -461,6 +553,9 @@ func (t *migrateTracker) attempt(info *resource.Info, retries int) {
 // try will mutate the info and attempt to save, recalculating if there are any retries left.
 // The result of the attempt or an error will be returned.
 func (t *migrateTracker) try(info *resource.Info, retries int) (attemptResult, error) {
+	if t.rateLimiter != nil {
+		t.rateLimiter.Accept()
+	}
 	reporter, err := t.migrateFn(info)
 	if err != nil {
 		return attemptResultError, err
@@ -479,7 +574,9 @@ func (t *migrateTracker) try(info *resource.Info, retries int) (attemptResult, e
 			if canRetry(err) {
 				if retries > 0 {
 					if bool(glog.V(1)) && err != ErrRecalculate {
+						t.lock.Lock()
 						t.report("retry:", info, err)
+						t.lock.Unlock()
 					}
 					result, err := t.try(info, retries-1)
 					switch result {
@@ -495,6 +592,69 @@ func (t *migrateTracker) try(info *resource.Info, retries int) (attemptResult, e
 	return attemptResultSuccess, nil
 }
 
+// checkpointKey returns the key of info in the same form used by --from-key and --to-key.
+func checkpointKey(info *resource.Info) string {
+	if info.Mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return info.Namespace + "/" + info.Name
+	}
+	return info.Name
+}
+
+// checkpointWriter periodically persists the highest migrated key so that an interrupted
+// migration can be resumed with --from-key. Callers must serialize access.
+type checkpointWriter struct {
+	path  string
+	every int
+
+	lastKey string
+	dirty   int
+}
+
+// record notes that the given key was migrated and persists the checkpoint after
+// every records have accumulated.
+func (w *checkpointWriter) record(key string) {
+	if key > w.lastKey {
+		w.lastKey = key
+	}
+	w.dirty++
+	if w.dirty >= w.every {
+		w.flush()
+	}
+}
+
+// flush writes the current checkpoint to disk. Write errors are logged but do not
+// interrupt the migration.
+func (w *checkpointWriter) flush() {
+	if w.dirty == 0 || len(w.lastKey) == 0 {
+		return
+	}
+	if err := ioutil.WriteFile(w.path, []byte(w.lastKey+"\n"), 0644); err != nil {
+		glog.Errorf("Unable to write checkpoint file %s: %v", w.path, err)
+		return
+	}
+	w.dirty = 0
+}
+
+// clear removes the checkpoint file after a successful migration.
+func (w *checkpointWriter) clear() {
+	if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+		glog.Errorf("Unable to remove checkpoint file %s: %v", w.path, err)
+	}
+}
+
+// readCheckpoint returns the key recorded in the checkpoint file, or the empty
+// string if the file does not exist.
+func readCheckpoint(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
 // canRetry returns true if the provided error indicates a retry is possible.
 func canRetry(err error) bool {
 	if temp, ok := err.(TemporaryError); ok && temp.Temporary() {
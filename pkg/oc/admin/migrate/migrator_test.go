@@ -2,7 +2,10 @@ package migrate
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -409,3 +412,33 @@ func TestIsNotFoundForInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckpointWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate-checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoint")
+
+	w := &checkpointWriter{path: path, every: 2}
+
+	// nothing is written until enough records accumulate
+	w.record("bar/a")
+	if key, err := readCheckpoint(path); err != nil || key != "" {
+		t.Fatalf("expected no checkpoint yet, got %q, %v", key, err)
+	}
+
+	// the highest key seen so far is persisted
+	w.record("bar/c")
+	w.record("bar/b")
+	w.flush()
+	if key, err := readCheckpoint(path); err != nil || key != "bar/c" {
+		t.Fatalf("expected checkpoint %q, got %q, %v", "bar/c", key, err)
+	}
+
+	w.clear()
+	if key, err := readCheckpoint(path); err != nil || key != "" {
+		t.Fatalf("expected checkpoint to be removed, got %q, %v", key, err)
+	}
+}
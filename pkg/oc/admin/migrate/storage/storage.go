@@ -33,6 +33,11 @@ var (
 		to operate on (key is NAMESPACE/NAME for resources in namespaces or NAME for cluster scoped
 		resources). --from-key is inclusive if specified, while --to-key is exclusive.
 
+		Large datasets may be migrated in parallel with --workers, and --qps limits the rate of
+		requests sent to the server. When --checkpoint-file is specified, the last migrated key is
+		periodically recorded to that file and an interrupted migration resumes from the recorded
+		key instead of starting from scratch.
+
 		By default, events are not migrated since they expire within a very short period of time. If you
 		have significantly increased the expiration time of events, run a migration with --include=events
 
@@ -0,0 +1,268 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/network"
+	networkapi "github.com/openshift/origin/pkg/network/apis/network"
+	networkclient "github.com/openshift/origin/pkg/network/generated/internalclientset"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+const NetworkTopologyCommandName = "network-topology"
+
+var (
+	networkTopologyLong = templates.LongDesc(`
+		Dump the SDN topology as a single structured document
+
+		Collects the cluster network definition, the host subnet assigned to every
+		node, the VNID and egress IPs of every network namespace, and all egress
+		network policy rules, so that connectivity issues can be inspected in one
+		place. By default a human readable summary is printed; use -o json to emit
+		the document for tooling.`)
+
+	networkTopologyExample = templates.Examples(`
+		# Print a summary of the SDN topology
+		%[1]s
+
+		# Dump the SDN topology as JSON
+		%[1]s -o json`)
+)
+
+// NetworkTopology is the structured document describing the SDN state.
+type NetworkTopology struct {
+	Plugin          string                `json:"plugin,omitempty"`
+	ClusterNetworks []ClusterNetworkCIDR  `json:"clusterNetworks,omitempty"`
+	ServiceNetwork  string                `json:"serviceNetwork,omitempty"`
+	Nodes           []NodeTopology        `json:"nodes"`
+	Namespaces      []NamespaceTopology   `json:"namespaces,omitempty"`
+	EgressPolicies  []EgressPolicySummary `json:"egressPolicies,omitempty"`
+}
+
+// ClusterNetworkCIDR describes one pod network CIDR.
+type ClusterNetworkCIDR struct {
+	CIDR             string `json:"cidr"`
+	HostSubnetLength uint32 `json:"hostSubnetLength"`
+}
+
+// NodeTopology describes the host subnet assigned to one node.
+type NodeTopology struct {
+	Name      string   `json:"name"`
+	HostIP    string   `json:"hostIP"`
+	Subnet    string   `json:"subnet"`
+	EgressIPs []string `json:"egressIPs,omitempty"`
+}
+
+// NamespaceTopology describes the network namespace of one project.
+type NamespaceTopology struct {
+	Name      string   `json:"name"`
+	NetID     uint32   `json:"netid"`
+	Global    bool     `json:"global,omitempty"`
+	EgressIPs []string `json:"egressIPs,omitempty"`
+}
+
+// EgressPolicySummary describes the rules of one EgressNetworkPolicy.
+type EgressPolicySummary struct {
+	Namespace string       `json:"namespace"`
+	Name      string       `json:"name"`
+	Rules     []EgressRule `json:"rules"`
+}
+
+// EgressRule describes a single egress network policy rule.
+type EgressRule struct {
+	Type         string `json:"type"`
+	CIDRSelector string `json:"cidrSelector,omitempty"`
+	DNSName      string `json:"dnsName,omitempty"`
+}
+
+type NetworkTopologyOptions struct {
+	Oclient networkclient.Interface
+	Out     io.Writer
+
+	Output string
+}
+
+func NewCmdNetworkTopology(commandName, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	topologyOp := &NetworkTopologyOptions{}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Dump the SDN topology for troubleshooting",
+		Long:    networkTopologyLong,
+		Example: fmt.Sprintf(networkTopologyExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := topologyOp.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(c, err.Error()))
+			}
+
+			err := topologyOp.Run()
+			kcmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().StringVarP(&topologyOp.Output, "output", "o", "", "Output format. Supported value: json.")
+
+	return cmd
+}
+
+func (t *NetworkTopologyOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 0 {
+		return fmt.Errorf("no arguments are supported")
+	}
+	if len(t.Output) > 0 && t.Output != "json" {
+		return fmt.Errorf("unsupported output format %q, only json is supported", t.Output)
+	}
+
+	networkClient, err := f.OpenshiftInternalNetworkClient()
+	if err != nil {
+		return err
+	}
+	t.Oclient = networkClient
+	t.Out = out
+	return nil
+}
+
+func (t *NetworkTopologyOptions) Run() error {
+	topology, err := t.buildTopology()
+	if err != nil {
+		return err
+	}
+
+	if t.Output == "json" {
+		output, err := json.MarshalIndent(topology, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(t.Out, string(output))
+		return nil
+	}
+
+	if len(topology.Plugin) > 0 {
+		fmt.Fprintf(t.Out, "Plugin: %s\n", topology.Plugin)
+	}
+	for _, cn := range topology.ClusterNetworks {
+		fmt.Fprintf(t.Out, "Cluster network: %s (host subnet length %d)\n", cn.CIDR, cn.HostSubnetLength)
+	}
+	if len(topology.ServiceNetwork) > 0 {
+		fmt.Fprintf(t.Out, "Service network: %s\n", topology.ServiceNetwork)
+	}
+
+	fmt.Fprintf(t.Out, "\nNodes:\n")
+	for _, node := range topology.Nodes {
+		fmt.Fprintf(t.Out, "  %s (%s) subnet %s", node.Name, node.HostIP, node.Subnet)
+		if len(node.EgressIPs) > 0 {
+			fmt.Fprintf(t.Out, " egress IPs %v", node.EgressIPs)
+		}
+		fmt.Fprintf(t.Out, "\n")
+	}
+
+	if len(topology.Namespaces) > 0 {
+		fmt.Fprintf(t.Out, "\nNamespaces:\n")
+		for _, ns := range topology.Namespaces {
+			fmt.Fprintf(t.Out, "  %s VNID %d", ns.Name, ns.NetID)
+			if ns.Global {
+				fmt.Fprintf(t.Out, " (global)")
+			}
+			if len(ns.EgressIPs) > 0 {
+				fmt.Fprintf(t.Out, " egress IPs %v", ns.EgressIPs)
+			}
+			fmt.Fprintf(t.Out, "\n")
+		}
+	}
+
+	if len(topology.EgressPolicies) > 0 {
+		fmt.Fprintf(t.Out, "\nEgress network policies:\n")
+		for _, policy := range topology.EgressPolicies {
+			fmt.Fprintf(t.Out, "  %s/%s:\n", policy.Namespace, policy.Name)
+			for _, rule := range policy.Rules {
+				switch {
+				case len(rule.CIDRSelector) > 0:
+					fmt.Fprintf(t.Out, "    %s to %s\n", rule.Type, rule.CIDRSelector)
+				case len(rule.DNSName) > 0:
+					fmt.Fprintf(t.Out, "    %s to %s\n", rule.Type, rule.DNSName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *NetworkTopologyOptions) buildTopology() (*NetworkTopology, error) {
+	topology := &NetworkTopology{}
+
+	// the cluster network only exists once the SDN master has started; its absence
+	// is reported as an empty header rather than a hard failure
+	clusterNetwork, err := t.Oclient.Network().ClusterNetworks().Get(networkapi.ClusterNetworkDefault, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		topology.Plugin = clusterNetwork.PluginName
+		topology.ServiceNetwork = clusterNetwork.ServiceNetwork
+		for _, entry := range clusterNetwork.ClusterNetworks {
+			topology.ClusterNetworks = append(topology.ClusterNetworks, ClusterNetworkCIDR{CIDR: entry.CIDR, HostSubnetLength: entry.HostSubnetLength})
+		}
+	case !kapierrors.IsNotFound(err):
+		return nil, err
+	}
+
+	subnets, err := t.Oclient.Network().HostSubnets().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, subnet := range subnets.Items {
+		topology.Nodes = append(topology.Nodes, NodeTopology{
+			Name:      subnet.Host,
+			HostIP:    subnet.HostIP,
+			Subnet:    subnet.Subnet,
+			EgressIPs: subnet.EgressIPs,
+		})
+	}
+	sort.Slice(topology.Nodes, func(i, j int) bool { return topology.Nodes[i].Name < topology.Nodes[j].Name })
+
+	netnsList, err := t.Oclient.Network().NetNamespaces().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, netns := range netnsList.Items {
+		topology.Namespaces = append(topology.Namespaces, NamespaceTopology{
+			Name:      netns.NetName,
+			NetID:     netns.NetID,
+			Global:    netns.NetID == network.GlobalVNID,
+			EgressIPs: netns.EgressIPs,
+		})
+	}
+	sort.Slice(topology.Namespaces, func(i, j int) bool { return topology.Namespaces[i].Name < topology.Namespaces[j].Name })
+
+	policies, err := t.Oclient.Network().EgressNetworkPolicies(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies.Items {
+		summary := EgressPolicySummary{Namespace: policy.Namespace, Name: policy.Name}
+		for _, rule := range policy.Spec.Egress {
+			summary.Rules = append(summary.Rules, EgressRule{
+				Type:         string(rule.Type),
+				CIDRSelector: rule.To.CIDRSelector,
+				DNSName:      rule.To.DNSName,
+			})
+		}
+		topology.EgressPolicies = append(topology.EgressPolicies, summary)
+	}
+	sort.Slice(topology.EgressPolicies, func(i, j int) bool {
+		if topology.EgressPolicies[i].Namespace != topology.EgressPolicies[j].Namespace {
+			return topology.EgressPolicies[i].Namespace < topology.EgressPolicies[j].Namespace
+		}
+		return topology.EgressPolicies[i].Name < topology.EgressPolicies[j].Name
+	})
+
+	return topology, nil
+}
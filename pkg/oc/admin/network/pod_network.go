@@ -33,5 +33,7 @@ func NewCmdPodNetwork(name, fullName string, f *clientcmd.Factory, out, errOut i
 
 	cmds.AddCommand(NewCmdIsolateProjectsNetwork(IsolateProjectsNetworkCommandName, fullName+" "+IsolateProjectsNetworkCommandName, f, out))
 
+	cmds.AddCommand(NewCmdShowNetworks(ShowNetworksCommandName, fullName+" "+ShowNetworksCommandName, f, out))
+
 	return cmds
 }
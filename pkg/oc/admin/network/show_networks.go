@@ -0,0 +1,173 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/network"
+	networkclient "github.com/openshift/origin/pkg/network/generated/internalclientset"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+const ShowNetworksCommandName = "show-networks"
+
+var (
+	showNetworksLong = templates.LongDesc(`
+		Show pod network VNID assignments
+
+		Reports the virtual network IDs (VNIDs) in use by the %[1]s network plugin,
+		grouping the namespaces that share a network, marking global namespaces, and
+		flagging namespaces with pod network changes that the SDN controller has not
+		yet applied.`)
+
+	showNetworksExample = templates.Examples(`
+		# Show the current VNID assignments
+		%[1]s
+
+		# Show the current VNID assignments as JSON for tooling
+		%[1]s -o json`)
+)
+
+// PodNetworkReport describes the cluster's current VNID assignments.
+type PodNetworkReport struct {
+	Networks []PodNetworkGroup `json:"networks"`
+	// PendingChanges lists requested pod network changes (join/isolate/global)
+	// that the SDN controller has not applied yet.
+	PendingChanges []PodNetworkChange `json:"pendingChanges,omitempty"`
+}
+
+// PodNetworkGroup describes one VNID and the namespaces assigned to it.
+type PodNetworkGroup struct {
+	NetID  uint32 `json:"netid"`
+	Global bool   `json:"global,omitempty"`
+	// Joined is true when more than one namespace shares this VNID.
+	Joined     bool     `json:"joined,omitempty"`
+	Namespaces []string `json:"namespaces"`
+}
+
+// PodNetworkChange describes a pending pod network change for a namespace.
+type PodNetworkChange struct {
+	Namespace string `json:"namespace"`
+	Action    string `json:"action"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+type ShowNetworksOptions struct {
+	Oclient networkclient.Interface
+	Out     io.Writer
+
+	Output string
+}
+
+func NewCmdShowNetworks(commandName, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	showOp := &ShowNetworksOptions{}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Show pod network VNID assignments",
+		Long:    fmt.Sprintf(showNetworksLong, network.MultiTenantPluginName),
+		Example: fmt.Sprintf(showNetworksExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := showOp.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(c, err.Error()))
+			}
+
+			err := showOp.Run()
+			kcmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().StringVarP(&showOp.Output, "output", "o", "", "Output format. Supported value: json.")
+
+	return cmd
+}
+
+func (s *ShowNetworksOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 0 {
+		return fmt.Errorf("no arguments are supported")
+	}
+	if len(s.Output) > 0 && s.Output != "json" {
+		return fmt.Errorf("unsupported output format %q, only json is supported", s.Output)
+	}
+
+	networkClient, err := f.OpenshiftInternalNetworkClient()
+	if err != nil {
+		return err
+	}
+	s.Oclient = networkClient
+	s.Out = out
+	return nil
+}
+
+func (s *ShowNetworksOptions) Run() error {
+	netnsList, err := s.Oclient.Network().NetNamespaces().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	report := PodNetworkReport{}
+	namespacesByVNID := map[uint32][]string{}
+	for _, netns := range netnsList.Items {
+		namespacesByVNID[netns.NetID] = append(namespacesByVNID[netns.NetID], netns.NetName)
+
+		action, args, err := network.GetChangePodNetworkAnnotation(&netns)
+		if err == nil {
+			report.PendingChanges = append(report.PendingChanges, PodNetworkChange{
+				Namespace: netns.NetName,
+				Action:    string(action),
+				Arguments: args,
+			})
+		}
+	}
+
+	for vnid, namespaces := range namespacesByVNID {
+		sort.Strings(namespaces)
+		report.Networks = append(report.Networks, PodNetworkGroup{
+			NetID:      vnid,
+			Global:     vnid == network.GlobalVNID,
+			Joined:     vnid != network.GlobalVNID && len(namespaces) > 1,
+			Namespaces: namespaces,
+		})
+	}
+	sort.Slice(report.Networks, func(i, j int) bool { return report.Networks[i].NetID < report.Networks[j].NetID })
+	sort.Slice(report.PendingChanges, func(i, j int) bool { return report.PendingChanges[i].Namespace < report.PendingChanges[j].Namespace })
+
+	if s.Output == "json" {
+		output, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(s.Out, string(output))
+		return nil
+	}
+
+	for _, group := range report.Networks {
+		switch {
+		case group.Global:
+			fmt.Fprintf(s.Out, "VNID %d (global):\n", group.NetID)
+		case group.Joined:
+			fmt.Fprintf(s.Out, "VNID %d (joined):\n", group.NetID)
+		default:
+			fmt.Fprintf(s.Out, "VNID %d:\n", group.NetID)
+		}
+		for _, ns := range group.Namespaces {
+			fmt.Fprintf(s.Out, "  %s\n", ns)
+		}
+	}
+	for _, change := range report.PendingChanges {
+		if len(change.Arguments) > 0 {
+			fmt.Fprintf(s.Out, "warning: namespace %q has a pending %q change (%s) not yet applied by the SDN controller\n", change.Namespace, change.Action, change.Arguments)
+		} else {
+			fmt.Fprintf(s.Out, "warning: namespace %q has a pending %q change not yet applied by the SDN controller\n", change.Namespace, change.Action)
+		}
+	}
+
+	return nil
+}
@@ -2,31 +2,47 @@ package node
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 )
 
 const (
-	flagGracePeriod = "grace-period"
-	flagDryRun      = "dry-run"
-	flagForce       = "force"
+	flagGracePeriod      = "grace-period"
+	flagDryRun           = "dry-run"
+	flagForce            = "force"
+	flagCancelBuilds     = "cancel-builds"
+	flagWaitForDeployers = "wait-for-deployers"
+
+	// deployerWaitTimeout is the maximum time to wait for an in-flight deployer pod
+	// to finish before failing the evacuation of its node.
+	deployerWaitTimeout = 10 * time.Minute
 )
 
 type EvacuateOptions struct {
 	Options *NodeOptions
 
 	// Optional params
-	DryRun      bool
-	Force       bool
-	GracePeriod int64
+	DryRun           bool
+	Force            bool
+	GracePeriod      int64
+	CancelBuilds     bool
+	WaitForDeployers bool
 
 	printPodHeaders bool
 }
@@ -49,6 +65,8 @@ func (e *EvacuateOptions) AddFlags(cmd *cobra.Command) {
 	flags.BoolVar(&e.DryRun, flagDryRun, e.DryRun, "Show pods that will be migrated. Optional param for --evacuate")
 	flags.BoolVar(&e.Force, flagForce, e.Force, "Delete pods not backed by replication controller. Optional param for --evacuate")
 	flags.Int64Var(&e.GracePeriod, flagGracePeriod, e.GracePeriod, "Grace period (seconds) for pods being deleted. Ignored if negative. Optional param for --evacuate")
+	flags.BoolVar(&e.CancelBuilds, flagCancelBuilds, e.CancelBuilds, "Gracefully cancel builds whose pods run on the node instead of evicting them. Optional param for --evacuate")
+	flags.BoolVar(&e.WaitForDeployers, flagWaitForDeployers, e.WaitForDeployers, "Wait for in-flight deployer pods on the node to finish instead of evicting them mid-rollout. Optional param for --evacuate")
 
 }
 
@@ -173,6 +191,26 @@ func (e *EvacuateOptions) RunEvacuate(node *kapi.Node) error {
 
 		printer.PrintObj(&pod, e.Options.Writer)
 
+		if e.CancelBuilds {
+			if buildName := pod.Annotations[buildapi.BuildAnnotation]; len(buildName) > 0 {
+				if err := e.cancelBuild(pod.Namespace, buildName); err != nil {
+					glog.Errorf("Unable to cancel build %s/%s: %v", pod.Namespace, buildName, err)
+					errList = append(errList, err)
+				}
+				continue
+			}
+		}
+
+		if e.WaitForDeployers {
+			if deploymentName := pod.Annotations[appsapi.DeploymentAnnotation]; len(deploymentName) > 0 {
+				if err := e.waitForDeployer(&pod, node.ObjectMeta.Name); err != nil {
+					glog.Errorf("Unable to wait for deployer pod %s/%s: %v", pod.Namespace, pod.Name, err)
+					errList = append(errList, err)
+				}
+				continue
+			}
+		}
+
 		if isManaged || e.Force {
 			if err := e.Options.KubeClient.Core().Pods(pod.Namespace).Delete(pod.Name, deleteOptions); err != nil {
 				glog.Errorf("Unable to delete a pod: %+v, error: %v", pod, err)
@@ -203,3 +241,75 @@ Suggested options:
 func (e *EvacuateOptions) makeDeleteOptions() *metav1.DeleteOptions {
 	return &metav1.DeleteOptions{GracePeriodSeconds: &e.GracePeriod}
 }
+
+// cancelBuild gracefully cancels the build owning a build pod on the evacuated node.
+// The build controller tears the pod down once the cancellation is recorded.
+func (e *EvacuateOptions) cancelBuild(namespace, name string) error {
+	return wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
+		build, err := e.Options.BuildClient.Build().Builds(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if kapierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if buildutil.IsTerminalPhase(build.Status.Phase) || build.Status.Cancelled {
+			return true, nil
+		}
+		build.Status.Cancelled = true
+		_, err = e.Options.BuildClient.Build().Builds(namespace).Update(build)
+		switch {
+		case err == nil:
+			fmt.Fprintf(e.Options.ErrWriter, "Cancelled build %s/%s\n", namespace, name)
+			return true, nil
+		case kapierrors.IsConflict(err):
+			return false, nil
+		}
+		return false, err
+	})
+}
+
+// waitForDeployer blocks until an in-flight deployer pod finishes rather than evicting
+// it mid-rollout, then annotates the owning deployment config with the evacuated node.
+func (e *EvacuateOptions) waitForDeployer(pod *kapi.Pod, nodeName string) error {
+	fmt.Fprintf(e.Options.ErrWriter, "Waiting for deployer pod %s/%s to finish\n", pod.Namespace, pod.Name)
+	err := wait.PollImmediate(5*time.Second, deployerWaitTimeout, func() (bool, error) {
+		latest, err := e.Options.KubeClient.Core().Pods(pod.Namespace).Get(pod.Name, metav1.GetOptions{})
+		if err != nil {
+			if kapierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return latest.Status.Phase == kapi.PodSucceeded || latest.Status.Phase == kapi.PodFailed, nil
+	})
+	if err != nil {
+		return fmt.Errorf("deployer pod %s/%s did not finish: %v", pod.Namespace, pod.Name, err)
+	}
+	return e.annotateDeploymentConfig(pod, nodeName)
+}
+
+// annotateDeploymentConfig records the evacuated node on the deployment config whose
+// deployer pod was waited on.
+func (e *EvacuateOptions) annotateDeploymentConfig(pod *kapi.Pod, nodeName string) error {
+	rcName := pod.Annotations[appsapi.DeploymentAnnotation]
+	rc, err := e.Options.KubeClient.Core().ReplicationControllers(pod.Namespace).Get(rcName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	dcName := appsutil.DeploymentConfigNameFor(rc)
+	if len(dcName) == 0 {
+		return nil
+	}
+	dc, err := e.Options.AppsClient.Apps().DeploymentConfigs(pod.Namespace).Get(dcName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	copied := dc.DeepCopy()
+	if copied.Annotations == nil {
+		copied.Annotations = map[string]string{}
+	}
+	copied.Annotations[appsapi.DeploymentDrainedNodeAnnotation] = nodeName
+	_, err = e.Options.AppsClient.Apps().DeploymentConfigs(pod.Namespace).Update(copied)
+	return err
+}
@@ -26,6 +26,14 @@ func TestEvacuateFlags(t *testing.T) {
 			flagName:   flagGracePeriod,
 			defaultVal: strconv.FormatInt(defaults.GracePeriod, 10),
 		},
+		"cancel builds": {
+			flagName:   flagCancelBuilds,
+			defaultVal: strconv.FormatBool(defaults.CancelBuilds),
+		},
+		"wait for deployers": {
+			flagName:   flagWaitForDeployers,
+			defaultVal: strconv.FormatBool(defaults.WaitForDeployers),
+		},
 	}
 
 	cmd := NewCommandManageNode(nil, ManageNodeCommandName, ManageNodeCommandName, nil, nil)
@@ -24,6 +24,8 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	kprinters "k8s.io/kubernetes/pkg/printers"
 
+	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset"
+	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset"
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
 )
 
@@ -31,6 +33,8 @@ type NodeOptions struct {
 	DefaultNamespace   string
 	ExternalKubeClient kubernetes.Interface
 	KubeClient         kclientset.Interface
+	AppsClient         appsclient.Interface
+	BuildClient        buildclient.Interface
 	Writer             io.Writer
 	ErrWriter          io.Writer
 
@@ -72,6 +76,15 @@ func (n *NodeOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []st
 		return err
 	}
 
+	appsClient, err := f.OpenshiftInternalAppsClient()
+	if err != nil {
+		return err
+	}
+	buildClient, err := f.OpenshiftInternalBuildClient()
+	if err != nil {
+		return err
+	}
+
 	cmdPrinter, err := f.PrinterForOptions(kcmdutil.ExtractCmdPrintOptions(c, false))
 	if err != nil {
 		return err
@@ -82,6 +95,8 @@ func (n *NodeOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []st
 	n.DefaultNamespace = defaultNamespace
 	n.ExternalKubeClient = externalkc
 	n.KubeClient = kc
+	n.AppsClient = appsClient
+	n.BuildClient = buildClient
 	n.Writer = out
 	n.ErrWriter = errout
 	n.Mapper = mapper
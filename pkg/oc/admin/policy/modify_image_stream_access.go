@@ -0,0 +1,307 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+	authorizationclient "github.com/openshift/origin/pkg/authorization/generated/internalclientset/typed/authorization/internalversion"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+const (
+	AddImageStreamAccessRecommendedName    = "add-image-stream-access"
+	RemoveImageStreamAccessRecommendedName = "remove-image-stream-access"
+
+	// imageStreamAccessRolePrefix prefixes the role and role binding managed by the
+	// add-image-stream-access and remove-image-stream-access commands.
+	imageStreamAccessRolePrefix = "imagestream-access-"
+)
+
+var (
+	addImageStreamAccessLong = templates.LongDesc(`
+		Grant other namespaces access to an image stream in the current project
+
+		This command creates a role and role binding that allow the service accounts of the
+		named namespaces to pull the image stream through the integrated registry and to
+		resolve its tags for image change triggers. Unlike granting the system:image-puller
+		role, access is confined to the single image stream - the other namespaces cannot
+		read or pull any other image stream in the project.
+
+		When a tag is specified (STREAM:TAG), reading tags is limited to the named tag.
+		Pulls through the integrated registry are authorized per image stream, so pull
+		access always covers the whole stream.`)
+
+	addImageStreamAccessExample = templates.Examples(`
+		# Allow pods in the 'frontend' namespace to pull and trigger on the 'ruby' image stream
+	  %[1]s ruby frontend
+
+	  # Limit tag reads to the 'ruby:2.5' tag for two namespaces
+	  %[1]s ruby:2.5 frontend backend`)
+
+	removeImageStreamAccessLong = templates.LongDesc(`
+		Revoke access to an image stream in the current project from other namespaces
+
+		This command removes the named namespaces from the role binding created by the
+		add-image-stream-access command. When the last namespace is removed, the role and
+		role binding are deleted.`)
+
+	removeImageStreamAccessExample = templates.Examples(`
+		# Revoke the 'frontend' namespace's access to the 'ruby' image stream
+	  %[1]s ruby frontend`)
+)
+
+// ImageStreamAccessOptions contains the input required to grant or revoke
+// access to a single image stream for other namespaces.
+type ImageStreamAccessOptions struct {
+	StreamName string
+	Tag        string
+	Namespaces []string
+
+	BindingNamespace string
+
+	RoleClient        authorizationclient.RoleInterface
+	RoleBindingClient authorizationclient.RoleBindingInterface
+
+	Out io.Writer
+}
+
+// NewCmdAddImageStreamAccess implements the OpenShift cli add-image-stream-access command
+func NewCmdAddImageStreamAccess(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ImageStreamAccessOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name + " STREAM[:TAG] NAMESPACE [NAMESPACE ...]",
+		Short:   "Grant other namespaces access to an image stream in the current project",
+		Long:    addImageStreamAccessLong,
+		Example: fmt.Sprintf(addImageStreamAccessExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(cmd, err.Error()))
+			}
+
+			kcmdutil.CheckErr(options.AddAccess())
+		},
+	}
+
+	return cmd
+}
+
+// NewCmdRemoveImageStreamAccess implements the OpenShift cli remove-image-stream-access command
+func NewCmdRemoveImageStreamAccess(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ImageStreamAccessOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name + " STREAM[:TAG] NAMESPACE [NAMESPACE ...]",
+		Short:   "Revoke access to an image stream in the current project from other namespaces",
+		Long:    removeImageStreamAccessLong,
+		Example: fmt.Sprintf(removeImageStreamAccessExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(cmd, err.Error()))
+			}
+
+			kcmdutil.CheckErr(options.RemoveAccess())
+		},
+	}
+
+	return cmd
+}
+
+func (o *ImageStreamAccessOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) < 2 {
+		return errors.New("you must specify an image stream and at least one namespace")
+	}
+
+	if strings.Contains(args[0], ":") {
+		name, tag, err := imageapi.ParseImageStreamTagName(args[0])
+		if err != nil {
+			return err
+		}
+		o.StreamName = name
+		o.Tag = tag
+	} else {
+		o.StreamName = args[0]
+	}
+	o.Namespaces = args[1:]
+
+	authorizationClient, err := f.OpenshiftInternalAuthorizationClient()
+	if err != nil {
+		return err
+	}
+
+	o.BindingNamespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range o.Namespaces {
+		if namespace == o.BindingNamespace {
+			return fmt.Errorf("namespace %q is the current namespace - its pods can already pull the image stream", namespace)
+		}
+	}
+
+	o.RoleClient = authorizationClient.Authorization().Roles(o.BindingNamespace)
+	o.RoleBindingClient = authorizationClient.Authorization().RoleBindings(o.BindingNamespace)
+
+	return nil
+}
+
+func (o *ImageStreamAccessOptions) roleName() string {
+	if len(o.Tag) > 0 {
+		return imageStreamAccessRolePrefix + o.StreamName + "-" + o.Tag
+	}
+	return imageStreamAccessRolePrefix + o.StreamName
+}
+
+func (o *ImageStreamAccessOptions) streamRef() string {
+	if len(o.Tag) > 0 {
+		return o.StreamName + ":" + o.Tag
+	}
+	return o.StreamName
+}
+
+// roleRules builds the rules for the managed role. The integrated registry
+// authorizes pulls with a get check against the imagestreams/layers subresource
+// using the stream name, so pull access is granted per stream even when a
+// single tag is named; reading tags is confined to the named tag.
+func (o *ImageStreamAccessOptions) roleRules() ([]authorizationapi.PolicyRule, error) {
+	layers, err := authorizationapi.NewRule("get").
+		Groups(kapi.GroupName, "image.openshift.io").
+		Resources("imagestreams/layers").
+		Names(o.StreamName).
+		Rule()
+	if err != nil {
+		return nil, err
+	}
+
+	tagResources := authorizationapi.NewRule("get").
+		Groups(kapi.GroupName, "image.openshift.io")
+	if len(o.Tag) > 0 {
+		tagResources = tagResources.Resources("imagestreamtags").Names(o.StreamName + ":" + o.Tag)
+	} else {
+		tagResources = tagResources.Resources("imagestreams").Names(o.StreamName)
+	}
+	tags, err := tagResources.Rule()
+	if err != nil {
+		return nil, err
+	}
+
+	return []authorizationapi.PolicyRule{layers, tags}, nil
+}
+
+// AddAccess ensures the managed role exists and that the role binding contains
+// the service account groups of the requested namespaces.
+func (o *ImageStreamAccessOptions) AddAccess() error {
+	rules, err := o.roleRules()
+	if err != nil {
+		return err
+	}
+
+	role := &authorizationapi.Role{
+		ObjectMeta: metav1.ObjectMeta{Namespace: o.BindingNamespace, Name: o.roleName()},
+		Rules:      rules,
+	}
+	if _, err := o.RoleClient.Create(role); err != nil {
+		if !kapierrors.IsAlreadyExists(err) {
+			return err
+		}
+		existing, err := o.RoleClient.Get(role.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		existing.Rules = role.Rules
+		if _, err := o.RoleClient.Update(existing); err != nil {
+			return err
+		}
+	}
+
+	roleBinding, err := o.RoleBindingClient.Get(o.roleName(), metav1.GetOptions{})
+	if err != nil {
+		if !kapierrors.IsNotFound(err) {
+			return err
+		}
+		roleBinding = &authorizationapi.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: o.BindingNamespace, Name: o.roleName()},
+			RoleRef:    kapi.ObjectReference{Namespace: o.BindingNamespace, Name: o.roleName()},
+		}
+		roleBinding.Subjects = o.subjects()
+		if _, err := o.RoleBindingClient.Create(roleBinding); err != nil {
+			return err
+		}
+	} else {
+	subjectCheck:
+		for _, newSubject := range o.subjects() {
+			for _, existingSubject := range roleBinding.Subjects {
+				if existingSubject.Kind == newSubject.Kind && existingSubject.Name == newSubject.Name {
+					continue subjectCheck
+				}
+			}
+			roleBinding.Subjects = append(roleBinding.Subjects, newSubject)
+		}
+		if _, err := o.RoleBindingClient.Update(roleBinding); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(o.Out, "granted access to image stream %q to namespaces %q\n", o.streamRef(), o.Namespaces)
+	return nil
+}
+
+// RemoveAccess removes the service account groups of the requested namespaces
+// from the role binding, deleting the role and binding when no subjects remain.
+func (o *ImageStreamAccessOptions) RemoveAccess() error {
+	roleBinding, err := o.RoleBindingClient.Get(o.roleName(), metav1.GetOptions{})
+	if err != nil {
+		if kapierrors.IsNotFound(err) {
+			return fmt.Errorf("no access to image stream %q has been granted", o.streamRef())
+		}
+		return err
+	}
+
+	remaining, found := removeSubjects(roleBinding.Subjects, o.subjects())
+	if found == 0 {
+		return fmt.Errorf("no access to image stream %q has been granted to namespaces %q", o.streamRef(), o.Namespaces)
+	}
+
+	if len(remaining) > 0 {
+		roleBinding.Subjects = remaining
+		if _, err := o.RoleBindingClient.Update(roleBinding); err != nil {
+			return err
+		}
+	} else {
+		if err := o.RoleBindingClient.Delete(roleBinding.Name, &metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		if err := o.RoleClient.Delete(o.roleName(), &metav1.DeleteOptions{}); err != nil && !kapierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	fmt.Fprintf(o.Out, "revoked access to image stream %q from namespaces %q\n", o.streamRef(), o.Namespaces)
+	return nil
+}
+
+// subjects returns the service account groups of the requested namespaces.
+func (o *ImageStreamAccessOptions) subjects() []kapi.ObjectReference {
+	subjects := []kapi.ObjectReference{}
+	for _, namespace := range o.Namespaces {
+		subjects = append(subjects, kapi.ObjectReference{
+			Kind: authorizationapi.SystemGroupKind,
+			Name: serviceaccount.MakeNamespaceGroupName(namespace),
+		})
+	}
+	return subjects
+}
@@ -0,0 +1,109 @@
+package policy
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+	fakeauthorizationclient "github.com/openshift/origin/pkg/authorization/generated/internalclientset/fake"
+)
+
+func imageStreamAccessOptions(fake *fakeauthorizationclient.Clientset, namespaces ...string) *ImageStreamAccessOptions {
+	return &ImageStreamAccessOptions{
+		StreamName:        "ruby",
+		Tag:               "2.5",
+		Namespaces:        namespaces,
+		BindingNamespace:  "myproject",
+		RoleClient:        fake.Authorization().Roles("myproject"),
+		RoleBindingClient: fake.Authorization().RoleBindings("myproject"),
+		Out:               ioutil.Discard,
+	}
+}
+
+func TestAddImageStreamAccess(t *testing.T) {
+	fake := fakeauthorizationclient.NewSimpleClientset()
+
+	if err := imageStreamAccessOptions(fake, "frontend").AddAccess(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	role, err := fake.Authorization().Roles("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(role.Rules) != 2 {
+		t.Fatalf("expected two rules, got %#v", role.Rules)
+	}
+	if !role.Rules[0].Resources.Has("imagestreams/layers") || !role.Rules[0].ResourceNames.Has("ruby") {
+		t.Errorf("unexpected layers rule: %#v", role.Rules[0])
+	}
+	if !role.Rules[1].Resources.Has("imagestreamtags") || !role.Rules[1].ResourceNames.Has("ruby:2.5") {
+		t.Errorf("unexpected tag rule: %#v", role.Rules[1])
+	}
+
+	binding, err := fake.Authorization().RoleBindings("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedSubjects := []kapi.ObjectReference{
+		{Kind: authorizationapi.SystemGroupKind, Name: "system:serviceaccounts:frontend"},
+	}
+	if !reflect.DeepEqual(binding.Subjects, expectedSubjects) {
+		t.Errorf("expected subjects %#v, got %#v", expectedSubjects, binding.Subjects)
+	}
+
+	// granting again for an additional namespace appends without duplicating
+	if err := imageStreamAccessOptions(fake, "frontend", "backend").AddAccess(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding, err = fake.Authorization().RoleBindings("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedSubjects = append(expectedSubjects, kapi.ObjectReference{Kind: authorizationapi.SystemGroupKind, Name: "system:serviceaccounts:backend"})
+	if !reflect.DeepEqual(binding.Subjects, expectedSubjects) {
+		t.Errorf("expected subjects %#v, got %#v", expectedSubjects, binding.Subjects)
+	}
+}
+
+func TestRemoveImageStreamAccess(t *testing.T) {
+	fake := fakeauthorizationclient.NewSimpleClientset()
+	if err := imageStreamAccessOptions(fake, "frontend", "backend").AddAccess(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := imageStreamAccessOptions(fake, "frontend").RemoveAccess(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding, err := fake.Authorization().RoleBindings("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedSubjects := []kapi.ObjectReference{
+		{Kind: authorizationapi.SystemGroupKind, Name: "system:serviceaccounts:backend"},
+	}
+	if !reflect.DeepEqual(binding.Subjects, expectedSubjects) {
+		t.Errorf("expected subjects %#v, got %#v", expectedSubjects, binding.Subjects)
+	}
+
+	// removing a namespace that was never granted access is an error
+	if err := imageStreamAccessOptions(fake, "frontend").RemoveAccess(); err == nil {
+		t.Errorf("expected an error removing a namespace without access")
+	}
+
+	// removing the last namespace deletes the role and binding
+	if err := imageStreamAccessOptions(fake, "backend").RemoveAccess(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := fake.Authorization().RoleBindings("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{}); !kapierrors.IsNotFound(err) {
+		t.Errorf("expected the role binding to be deleted, got %v", err)
+	}
+	if _, err := fake.Authorization().Roles("myproject").Get("imagestream-access-ruby-2.5", metav1.GetOptions{}); !kapierrors.IsNotFound(err) {
+		t.Errorf("expected the role to be deleted, got %v", err)
+	}
+}
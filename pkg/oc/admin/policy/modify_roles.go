@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -38,7 +39,10 @@ var (
 	  %[1]s view user1
 
 	  # Add the 'edit' role to serviceaccount1 for the current project
-	  %[1]s edit -z serviceaccount1`)
+	  %[1]s edit -z serviceaccount1
+
+	  # Add the 'admin' role to user1 for two hours only
+	  %[1]s admin user1 --duration=2h`)
 )
 
 type RoleModificationOptions struct {
@@ -46,6 +50,9 @@ type RoleModificationOptions struct {
 	RoleName            string
 	RoleBindingName     string
 	RoleBindingAccessor RoleBindingAccessor
+	// Duration, when non-zero, marks the binding to expire after the given
+	// amount of time; an expired binding is removed by a controller.
+	Duration time.Duration
 
 	Targets  []string
 	Users    []string
@@ -84,6 +91,7 @@ func NewCmdAddRoleToGroup(name, fullName string, f *clientcmd.Factory, out io.Wr
 
 	cmd.Flags().StringVar(&options.RoleBindingName, "rolebinding-name", "", "Name of the rolebinding to modify or create. If left empty creates a new rolebinding with a default name")
 	cmd.Flags().StringVar(&options.RoleNamespace, "role-namespace", "", "namespace where the role is located: empty means a role defined in cluster policy")
+	cmd.Flags().DurationVar(&options.Duration, "duration", 0, "If non-zero, the created or updated rolebinding expires after the given duration and is removed automatically.")
 
 	kcmdutil.AddDryRunFlag(cmd)
 	kcmdutil.AddPrinterFlags(cmd)
@@ -118,6 +126,7 @@ func NewCmdAddRoleToUser(name, fullName string, f *clientcmd.Factory, out io.Wri
 	cmd.Flags().StringVar(&options.RoleBindingName, "rolebinding-name", "", "Name of the rolebinding to modify or create. If left empty creates a new rolebinding with a default name")
 	cmd.Flags().StringVar(&options.RoleNamespace, "role-namespace", "", "namespace where the role is located: empty means a role defined in cluster policy")
 	cmd.Flags().StringSliceVarP(&saNames, "serviceaccount", "z", saNames, "service account in the current namespace to use as a user")
+	cmd.Flags().DurationVar(&options.Duration, "duration", 0, "If non-zero, the created or updated rolebinding expires after the given duration and is removed automatically.")
 
 	kcmdutil.AddDryRunFlag(cmd)
 	kcmdutil.AddPrinterFlags(cmd)
@@ -481,6 +490,13 @@ subjectCheck:
 		roleBinding.Subjects = append(roleBinding.Subjects, newSubject)
 	}
 
+	if o.Duration > 0 {
+		if roleBinding.Annotations == nil {
+			roleBinding.Annotations = map[string]string{}
+		}
+		roleBinding.Annotations[authorizationapi.RoleBindingExpirationAnnotation] = time.Now().Add(o.Duration).UTC().Format(time.RFC3339)
+	}
+
 	if len(o.Output) > 0 {
 		return o.PrintObj(roleBinding)
 	}
@@ -57,6 +57,13 @@ func NewCmdPolicy(name, fullName string, f *clientcmd.Factory, out, errout io.Wr
 				NewCmdRemoveGroupFromProject(RemoveGroupRecommendedName, fullName+" "+RemoveGroupRecommendedName, f, out),
 			},
 		},
+		{
+			Message: "Manage image stream access:",
+			Commands: []*cobra.Command{
+				NewCmdAddImageStreamAccess(AddImageStreamAccessRecommendedName, fullName+" "+AddImageStreamAccessRecommendedName, f, out),
+				NewCmdRemoveImageStreamAccess(RemoveImageStreamAccessRecommendedName, fullName+" "+RemoveImageStreamAccessRecommendedName, f, out),
+			},
+		},
 		{
 			Message: "Assign roles to users and groups:",
 			Commands: []*cobra.Command{
@@ -11,6 +11,7 @@ import (
 	sccutil "github.com/openshift/origin/pkg/security/securitycontextconstraints/util"
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
 	kapihelper "k8s.io/kubernetes/pkg/apis/core/helper"
@@ -21,6 +22,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+	allocator "github.com/openshift/origin/pkg/security"
 	securityapi "github.com/openshift/origin/pkg/security/apis/security"
 	securitytypedclient "github.com/openshift/origin/pkg/security/generated/internalclientset/typed/security/internalversion"
 )
@@ -38,12 +40,16 @@ type ReconcileSCCOptions struct {
 	// is the name of the openshift infrastructure namespace.  It is provided here so that
 	// the command doesn't need to try and parse the policy config.
 	InfraNamespace string
+	// ShowDiff causes the preview to print a field-level diff for each changed SCC along
+	// with the pods that were admitted by it, instead of the full replacement objects.
+	ShowDiff bool
 
 	Out    io.Writer
 	Output string
 
 	SCCClient securitytypedclient.SecurityContextConstraintsInterface
 	NSClient  kcoreclient.NamespaceInterface
+	PodClient kcoreclient.PodsGetter
 }
 
 var (
@@ -105,6 +111,7 @@ func NewCmdReconcileSCC(name, fullName string, f *clientcmd.Factory, out io.Writ
 	cmd.Flags().BoolVar(&o.Confirmed, "confirm", o.Confirmed, "If true, specify that cluster SCCs should be modified. Defaults to false, displaying what would be replaced but not actually replacing anything.")
 	cmd.Flags().BoolVar(&o.Union, "additive-only", o.Union, "If true, preserves extra users, groups, labels and annotations in the SCC as well as existing priorities.")
 	cmd.Flags().StringVar(&o.InfraNamespace, "infrastructure-namespace", o.InfraNamespace, "Name of the infrastructure namespace.")
+	cmd.Flags().BoolVar(&o.ShowDiff, "diff", o.ShowDiff, "If true, display a field-level diff of what would change for each SCC and the pods that were admitted by it, instead of printing the replacement objects.")
 	kcmdutil.AddPrinterFlags(cmd)
 	cmd.Flags().Lookup("output").DefValue = "yaml"
 	cmd.Flags().Lookup("output").Value.Set("yaml")
@@ -126,6 +133,7 @@ func (o *ReconcileSCCOptions) Complete(cmd *cobra.Command, f *clientcmd.Factory,
 	}
 	o.SCCClient = securityClient.Security().SecurityContextConstraints()
 	o.NSClient = kClient.Core().Namespaces()
+	o.PodClient = kClient.Core()
 	o.Output = kcmdutil.GetFlagString(cmd, "output")
 
 	return nil
@@ -154,6 +162,10 @@ func (o *ReconcileSCCOptions) RunReconcileSCCs(cmd *cobra.Command, f *clientcmd.
 		return nil
 	}
 
+	if !o.Confirmed && o.ShowDiff {
+		return o.printChangedSCCDiffs(changedSCCs)
+	}
+
 	if !o.Confirmed {
 		list := &kapi.List{}
 		for _, item := range changedSCCs {
@@ -225,6 +237,63 @@ func (o *ReconcileSCCOptions) ReplaceChangedSCCs(changedSCCs []*securityapi.Secu
 	return nil
 }
 
+// printChangedSCCDiffs prints a field-level diff between the current cluster SCC and the
+// reconciled SCC, followed by a best-effort list of the pods that were admitted by the
+// drifted SCC and therefore may rely on its current settings.
+func (o *ReconcileSCCOptions) printChangedSCCDiffs(changedSCCs []*securityapi.SecurityContextConstraints) error {
+	for _, updated := range changedSCCs {
+		actual, err := o.SCCClient.Get(updated.Name, metav1.GetOptions{})
+		if kapierrors.IsNotFound(err) {
+			fmt.Fprintf(o.Out, "securitycontextconstraints/%s does not exist and would be created\n\n", updated.Name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// normalize ordering so the diff only reports semantic drift
+		sortVolumes(actual)
+		sort.StringSlice(actual.Groups).Sort()
+		sort.StringSlice(actual.Users).Sort()
+
+		fmt.Fprintf(o.Out, "securitycontextconstraints/%s would be updated (a=current, b=reconciled):%s\n", updated.Name, diff.ObjectReflectDiff(actual, updated))
+
+		pods, err := o.podsAdmittedBySCC(updated.Name)
+		if err != nil {
+			fmt.Fprintf(o.Out, "error: unable to determine the pods admitted by securitycontextconstraints/%s: %v\n\n", updated.Name, err)
+			continue
+		}
+		if len(pods) == 0 {
+			fmt.Fprintf(o.Out, "no running pods were admitted by securitycontextconstraints/%s\n\n", updated.Name)
+			continue
+		}
+		fmt.Fprintf(o.Out, "pods admitted by securitycontextconstraints/%s that may rely on the current settings:\n", updated.Name)
+		for _, pod := range pods {
+			fmt.Fprintf(o.Out, "  %s\n", pod)
+		}
+		fmt.Fprintf(o.Out, "\n")
+	}
+	return nil
+}
+
+// podsAdmittedBySCC returns the namespace/name of every pod whose validated SCC
+// annotation names the given SCC. This is best-effort: the annotation records the
+// SCC that admitted the pod, not which of its fields the pod depends on.
+func (o *ReconcileSCCOptions) podsAdmittedBySCC(name string) ([]string, error) {
+	pods, err := o.PodClient.Pods(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	matches := []string{}
+	for i := range pods.Items {
+		if pods.Items[i].Annotations[allocator.ValidatedSCCAnnotation] == name {
+			matches = append(matches, fmt.Sprintf("%s/%s", pods.Items[i].Namespace, pods.Items[i].Name))
+		}
+	}
+	sort.StringSlice(matches).Sort()
+	return matches, nil
+}
+
 // computeUpdatedSCC determines if the expected SCC looks like the actual SCC
 // it does this by making the expected SCC mirror the actual SCC for items that
 // we are not reconciling and performing a diff (ignoring changes to metadata).
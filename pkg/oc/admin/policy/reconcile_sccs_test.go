@@ -6,7 +6,9 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
 
+	allocator "github.com/openshift/origin/pkg/security"
 	securityapi "github.com/openshift/origin/pkg/security/apis/security"
 )
 
@@ -592,3 +594,37 @@ func goodSCC() securityapi.SecurityContextConstraints {
 		Groups: []string{"group"},
 	}
 }
+
+func TestPodsAdmittedBySCC(t *testing.T) {
+	pod := func(namespace, name, scc string) *kapi.Pod {
+		p := &kapi.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		if len(scc) > 0 {
+			p.Annotations = map[string]string{allocator.ValidatedSCCAnnotation: scc}
+		}
+		return p
+	}
+	client := fake.NewSimpleClientset(
+		pod("default", "privileged-pod", "privileged"),
+		pod("test", "restricted-pod", "restricted"),
+		pod("test", "another-privileged-pod", "privileged"),
+		pod("test", "unannotated-pod", ""),
+	)
+	o := &ReconcileSCCOptions{PodClient: client.Core()}
+
+	pods, err := o.podsAdmittedBySCC("privileged")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"default/privileged-pod", "test/another-privileged-pod"}
+	if !reflect.DeepEqual(pods, expected) {
+		t.Errorf("expected %v, got %v", expected, pods)
+	}
+
+	pods, err = o.podsAdmittedBySCC("nonroot")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 0 {
+		t.Errorf("expected no pods, got %v", pods)
+	}
+}
@@ -20,14 +20,17 @@ import (
 	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
 	oauthorizationtypedclient "github.com/openshift/origin/pkg/authorization/generated/internalclientset/typed/authorization/internalversion"
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+	projectclient "github.com/openshift/origin/pkg/project/generated/internalclientset/typed/project/internalversion"
 )
 
 const WhoCanRecommendedName = "who-can"
 
 type whoCanOptions struct {
 	allNamespaces    bool
+	perNamespace     bool
 	bindingNamespace string
 	client           oauthorizationtypedclient.AuthorizationInterface
+	projectClient    projectclient.ProjectInterface
 
 	verb         string
 	resource     schema.GroupVersionResource
@@ -56,6 +59,7 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 	}
 
 	cmd.Flags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If true, list who can perform the specified action in all namespaces.")
+	cmd.Flags().BoolVar(&options.perNamespace, "per-namespace", options.perNamespace, "If true, run the review in every project and report the allowed subjects for each namespace.  Must be used with --all-namespaces and -o json|yaml.")
 	kcmdutil.AddPrinterFlags(cmd)
 
 	return cmd
@@ -81,12 +85,27 @@ func (o *whoCanOptions) complete(f *clientcmd.Factory, cmd *cobra.Command, args
 		return errors.New("you must specify two or three arguments: verb, resource, and optional resourceName")
 	}
 
+	if o.perNamespace {
+		if !o.allNamespaces {
+			return errors.New("--per-namespace must be used with --all-namespaces")
+		}
+		if o.output != "json" && o.output != "yaml" {
+			return errors.New("--per-namespace requires -o json or -o yaml")
+		}
+	}
+
 	authorizationClient, err := f.OpenshiftInternalAuthorizationClient()
 	if err != nil {
 		return err
 	}
 	o.client = authorizationClient.Authorization()
 
+	projectClient, err := f.OpenshiftInternalProjectClient()
+	if err != nil {
+		return err
+	}
+	o.projectClient = projectClient.Project()
+
 	o.bindingNamespace, _, err = f.DefaultNamespace()
 	if err != nil {
 		return err
@@ -120,6 +139,10 @@ func (o *whoCanOptions) run() error {
 		ResourceName: o.resourceName,
 	}
 
+	if o.perNamespace {
+		return o.runPerNamespace(authorizationAttributes)
+	}
+
 	resourceAccessReviewResponse := &authorizationapi.ResourceAccessReviewResponse{}
 	var err error
 	if o.allNamespaces {
@@ -188,3 +211,40 @@ func (o *whoCanOptions) run() error {
 
 	return nil
 }
+
+// runPerNamespace runs a local resource access review in every project visible to the
+// caller and aggregates the responses into a single list, one entry per namespace.
+func (o *whoCanOptions) runPerNamespace(authorizationAttributes authorizationapi.Action) error {
+	projects, err := o.projectClient.Projects().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	list := &metav1.List{}
+	list.Kind = "List"
+	list.APIVersion = "v1"
+	for i := range projects.Items {
+		resourceAccessReviewResponse, err := o.client.LocalResourceAccessReviews(projects.Items[i].Name).Create(&authorizationapi.LocalResourceAccessReview{Action: authorizationAttributes})
+		if err != nil {
+			return err
+		}
+
+		// the printing stack is hosed.  Directly convert to the object we will print.
+		printableResponse := &v1.ResourceAccessReviewResponse{}
+		if err := legacyscheme.Scheme.Convert(resourceAccessReviewResponse, printableResponse, nil); err != nil {
+			return err
+		}
+		list.Items = append(list.Items, runtime.RawExtension{Object: printableResponse})
+	}
+
+	switch o.output {
+	case "json":
+		printer := printers.JSONPrinter{}
+		return printer.PrintObj(list, o.out)
+	case "yaml":
+		printer := printers.YAMLPrinter{}
+		return printer.PrintObj(list, o.out)
+	default:
+		return fmt.Errorf("invalid output format %q, only yaml|json supported", o.output)
+	}
+}
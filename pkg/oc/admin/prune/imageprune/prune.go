@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/distribution/manifest/schema2"
@@ -160,6 +161,24 @@ type PrunerOptions struct {
 	RegistryClient *http.Client
 	// RegistryURL is the URL of the integrated Docker registry.
 	RegistryURL *url.URL
+	// NumWorkers is the number of blobs to delete from the registry
+	// concurrently. Values below 1 are treated as 1.
+	NumWorkers int
+}
+
+// PruneSummary reports what a pruning run removed and how long the registry
+// garbage collection took, so that callers can surface progress and last-run
+// metrics without a maintenance window.
+type PruneSummary struct {
+	// DeletedImages is the number of image API objects that were removed.
+	DeletedImages int
+	// DeletedBlobs is the number of blobs that were removed from the registry.
+	DeletedBlobs int
+	// FailedBlobs is the number of blobs that could not be removed from the
+	// registry.
+	FailedBlobs int
+	// GCDuration is the time spent deleting data from the registry.
+	GCDuration time.Duration
 }
 
 // Pruner knows how to prune istags, images, layers and image configs.
@@ -167,8 +186,9 @@ type Pruner interface {
 	// Prune uses imagePruner, streamPruner, layerLinkPruner, blobPruner, and
 	// manifestPruner to remove images that have been identified as candidates
 	// for pruning based on the Pruner's internal pruning algorithm.
-	// Please see NewPruner for details on the algorithm.
-	Prune(imagePruner ImageDeleter, streamPruner ImageStreamDeleter, layerLinkPruner LayerLinkDeleter, blobPruner BlobDeleter, manifestPruner ManifestDeleter) error
+	// Please see NewPruner for details on the algorithm. It returns a summary
+	// of the run for reporting, which may be non-nil even on error.
+	Prune(imagePruner ImageDeleter, streamPruner ImageStreamDeleter, layerLinkPruner LayerLinkDeleter, blobPruner BlobDeleter, manifestPruner ManifestDeleter) (*PruneSummary, error)
 }
 
 // pruner is an object that knows how to prune a data set
@@ -177,6 +197,7 @@ type pruner struct {
 	algorithm      pruneAlgorithm
 	registryClient *http.Client
 	registryURL    *url.URL
+	numWorkers     int
 }
 
 var _ Pruner = &pruner{}
@@ -252,10 +273,16 @@ func NewPruner(options PrunerOptions) (Pruner, kerrors.Aggregate) {
 	}
 	algorithm.namespace = options.Namespace
 
+	numWorkers := options.NumWorkers
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+
 	p := &pruner{
 		algorithm:      algorithm,
 		registryClient: options.RegistryClient,
 		registryURL:    options.RegistryURL,
+		numWorkers:     numWorkers,
 	}
 
 	if err := p.buildGraph(options); err != nil {
@@ -1004,12 +1031,13 @@ func (p *pruner) Prune(
 	layerLinkPruner LayerLinkDeleter,
 	blobPruner BlobDeleter,
 	manifestPruner ManifestDeleter,
-) error {
+) (*PruneSummary, error) {
+	summary := &PruneSummary{}
 	allNodes := p.g.Nodes()
 
 	imageNodes := getImageNodes(allNodes)
 	if len(imageNodes) == 0 {
-		return nil
+		return summary, nil
 	}
 
 	prunableImageNodes, prunableImageIDs := calculatePrunableImages(p.g, imageNodes, p.algorithm)
@@ -1019,27 +1047,33 @@ func (p *pruner) Prune(
 	// if we have any errors after ImageStreams pruning this may mean that
 	// we still have references to images.
 	if len(p.algorithm.namespace) > 0 || err != nil {
-		return err
+		return summary, err
 	}
 
 	var errs []error
 
 	if p.algorithm.pruneRegistry {
+		gcStarted := time.Now()
 		prunableComponents := getPrunableComponents(p.g, prunableImageIDs)
 		errs = append(errs, pruneImageComponents(p.g, p.registryClient, p.registryURL, prunableComponents, layerLinkPruner)...)
-		errs = append(errs, pruneBlobs(p.g, p.registryClient, p.registryURL, prunableComponents, blobPruner)...)
+		deletedBlobs, blobErrs := pruneBlobs(p.g, p.registryClient, p.registryURL, prunableComponents, blobPruner, p.numWorkers)
+		summary.DeletedBlobs = deletedBlobs
+		summary.FailedBlobs = len(blobErrs)
+		errs = append(errs, blobErrs...)
 		errs = append(errs, pruneManifests(p.g, p.registryClient, p.registryURL, prunableImageNodes, manifestPruner)...)
+		summary.GCDuration = time.Since(gcStarted)
 
 		if len(errs) > 0 {
 			// If we had any errors deleting layers, blobs, or manifest data from the registry,
 			// stop here and don't delete any images. This way, you can rerun prune and retry
 			// things that failed.
-			return kerrors.NewAggregate(errs)
+			return summary, kerrors.NewAggregate(errs)
 		}
 	}
 
 	errs = pruneImages(p.g, prunableImageNodes, imagePruner)
-	return kerrors.NewAggregate(errs)
+	summary.DeletedImages = len(prunableImageNodes) - len(errs)
+	return summary, kerrors.NewAggregate(errs)
 }
 
 // imageComponentIsPrunable returns true if the image component is not referenced by any images.
@@ -1097,24 +1131,54 @@ func pruneImageComponents(
 }
 
 // pruneBlobs invokes blobPruner.DeleteBlob for each blob to be deleted from the
-// registry.
+// registry, using numWorkers concurrent deletions so that large registries can
+// be garbage collected while they remain online. It returns the number of
+// blobs deleted along with any errors.
 func pruneBlobs(
 	g genericgraph.Graph,
 	registryClient *http.Client,
 	registryURL *url.URL,
 	componentNodes []*imagegraph.ImageComponentNode,
 	blobPruner BlobDeleter,
-) []error {
-	errs := []error{}
+	numWorkers int,
+) (int, []error) {
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
 
+	var mutex sync.Mutex
+	errs := []error{}
+	deleted := 0
+
+	queue := make(chan *imagegraph.ImageComponentNode)
+	var wg sync.WaitGroup
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for cn := range queue {
+				err := blobPruner.DeleteBlob(registryClient, registryURL, cn.Component)
+				mutex.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("error removing blob %s from the registry %s: %v",
+						cn.Component, registryURL.Host, err))
+				} else {
+					deleted++
+					if deleted%100 == 0 {
+						glog.V(1).Infof("Deleted %d of %d blobs from the registry %s", deleted, len(componentNodes), registryURL.Host)
+					}
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
 	for _, cn := range componentNodes {
-		if err := blobPruner.DeleteBlob(registryClient, registryURL, cn.Component); err != nil {
-			errs = append(errs, fmt.Errorf("error removing blob %s from the registry %s: %v",
-				cn.Component, registryURL.Host, err))
-		}
+		queue <- cn
 	}
+	close(queue)
+	wg.Wait()
 
-	return errs
+	return deleted, errs
 }
 
 // pruneManifests invokes manifestPruner.DeleteManifest for each repository
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -929,7 +930,7 @@ func TestImagePruning(t *testing.T) {
 			blobDeleter := &fakeBlobDeleter{invocations: sets.NewString()}
 			manifestDeleter := &fakeManifestDeleter{invocations: sets.NewString()}
 
-			if err := p.Prune(imageDeleter, streamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter); err != nil {
+			if _, err := p.Prune(imageDeleter, streamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter); err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
 
@@ -1284,7 +1285,7 @@ func TestImageWithStrongAndWeakRefsIsNotPruned(t *testing.T) {
 	blobDeleter := &fakeBlobDeleter{invocations: sets.NewString()}
 	manifestDeleter := &fakeManifestDeleter{invocations: sets.NewString()}
 
-	if err := p.Prune(imageDeleter, streamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter); err != nil {
+	if _, err := p.Prune(imageDeleter, streamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -1393,6 +1394,8 @@ func (p *fakeImageStreamDeleter) NotifyImageStreamPrune(stream *imageapi.ImageSt
 }
 
 type fakeBlobDeleter struct {
+	// mutex makes the fake safe for the concurrent workers used by pruneBlobs
+	mutex       sync.Mutex
 	invocations sets.String
 	err         error
 }
@@ -1400,6 +1403,8 @@ type fakeBlobDeleter struct {
 var _ BlobDeleter = &fakeBlobDeleter{}
 
 func (p *fakeBlobDeleter) DeleteBlob(registryClient *http.Client, registryURL *url.URL, blob string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 	p.invocations.Insert(fmt.Sprintf("%s|%s", registryURL.String(), blob))
 	return p.err
 }
@@ -1427,3 +1432,30 @@ func (p *fakeManifestDeleter) DeleteManifest(registryClient *http.Client, regist
 	p.invocations.Insert(fmt.Sprintf("%s|%s|%s", registryURL.String(), repo, manifest))
 	return p.err
 }
+
+func TestPruneBlobsConcurrent(t *testing.T) {
+	registryURL, err := url.Parse("https://registry1.io")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nodes := []*imagegraph.ImageComponentNode{}
+	expected := sets.NewString()
+	for i := 0; i < 10; i++ {
+		digest := fmt.Sprintf("sha256:%034d", i)
+		nodes = append(nodes, &imagegraph.ImageComponentNode{Component: digest, Type: imagegraph.ImageComponentTypeLayer})
+		expected.Insert(fmt.Sprintf("%s|%s", registryURL.String(), digest))
+	}
+
+	blobDeleter := &fakeBlobDeleter{invocations: sets.NewString()}
+	deleted, errs := pruneBlobs(genericgraph.New(), nil, registryURL, nodes, blobDeleter, 4)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if deleted != len(nodes) {
+		t.Errorf("expected %d deleted blobs, got %d", len(nodes), deleted)
+	}
+	if a, e := blobDeleter.invocations, expected; !reflect.DeepEqual(a, e) {
+		t.Errorf("unexpected blob deletions: %s", diff.ObjectDiff(a, e))
+	}
+}
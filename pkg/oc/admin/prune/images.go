@@ -119,6 +119,7 @@ type PruneImagesOptions struct {
 	Namespace           string
 	ForceInsecure       bool
 	PruneRegistry       *bool
+	NumWorkers          int
 
 	ClientConfig    *restclient.Config
 	AppsClient      appsclient.AppsInterface
@@ -166,6 +167,7 @@ func NewCmdPruneImages(f *clientcmd.Factory, parentName, name string, out io.Wri
 	cmd.Flags().StringVar(&opts.RegistryUrlOverride, "registry-url", opts.RegistryUrlOverride, "The address to use when contacting the registry, instead of using the default value. This is useful if you can't resolve or reach the registry (e.g.; the default is a cluster-internal URL) but you do have an alternative route that works. Particular transport protocol can be enforced using '<scheme>://' prefix.")
 	cmd.Flags().BoolVar(&opts.ForceInsecure, "force-insecure", opts.ForceInsecure, "If true, allow an insecure connection to the docker registry that is hosted via HTTP or has an invalid HTTPS certificate. Whenever possible, use --certificate-authority instead of this dangerous option.")
 	cmd.Flags().BoolVar(opts.PruneRegistry, "prune-registry", *opts.PruneRegistry, "If false, the prune operation will clean up image API objects, but the none of the associated content in the registry is removed.  Note, if only image API objects are cleaned up through use of this flag, the only means for subsequently cleaning up registry data corresponding to those image API objects is to employ the 'hard prune' administrative task.")
+	cmd.Flags().IntVar(&opts.NumWorkers, "num-workers", 1, "Specify the number of blobs deleted from the registry concurrently. Higher values shorten pruning of large registries while the registry stays online.")
 
 	return cmd
 }
@@ -388,6 +390,7 @@ func (o PruneImagesOptions) Run() error {
 		RegistryClient:     registryClient,
 		RegistryURL:        registryURL,
 		PruneRegistry:      o.PruneRegistry,
+		NumWorkers:         o.NumWorkers,
 	}
 	if o.Namespace != metav1.NamespaceAll {
 		options.Namespace = o.Namespace
@@ -421,7 +424,21 @@ func (o PruneImagesOptions) Run() error {
 		fmt.Fprintln(o.Out, "Only API objects will be removed.  No modifications to the image registry will be made.")
 	}
 
-	return pruner.Prune(imageDeleter, imageStreamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter)
+	summary, err := pruner.Prune(imageDeleter, imageStreamDeleter, layerLinkDeleter, blobDeleter, manifestDeleter)
+	if summary != nil {
+		w.Flush()
+		if o.PruneRegistry == nil || *o.PruneRegistry {
+			fmt.Fprintf(o.Out, "
+Deleted %d image API objects; registry garbage collection removed %d blobs (%d failed) in %v.
+",
+				summary.DeletedImages, summary.DeletedBlobs, summary.FailedBlobs, summary.GCDuration.Round(time.Millisecond))
+		} else {
+			fmt.Fprintf(o.Out, "
+Deleted %d image API objects.
+", summary.DeletedImages)
+		}
+	}
+	return err
 }
 
 func (o *PruneImagesOptions) printGraphBuildErrors(errs kutilerrors.Aggregate) {
@@ -568,11 +585,14 @@ type describingBlobDeleter struct {
 	delegate      imageprune.BlobDeleter
 	headerPrinted bool
 	errOut        io.Writer
+	// mutex serializes output when blobs are deleted by concurrent workers.
+	mutex sync.Mutex
 }
 
 var _ imageprune.BlobDeleter = &describingBlobDeleter{}
 
 func (p *describingBlobDeleter) DeleteBlob(registryClient *http.Client, registryURL *url.URL, layer string) error {
+	p.mutex.Lock()
 	if !p.headerPrinted {
 		p.headerPrinted = true
 		fmt.Fprintln(p.w, "\nDeleting registry layer blobs ...")
@@ -580,6 +600,7 @@ func (p *describingBlobDeleter) DeleteBlob(registryClient *http.Client, registry
 	}
 
 	fmt.Fprintf(p.w, "%s\n", layer)
+	p.mutex.Unlock()
 
 	if p.delegate == nil {
 		return nil
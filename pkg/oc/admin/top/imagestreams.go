@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"time"
 
 	units "github.com/docker/go-units"
 	gonum "github.com/gonum/graph"
@@ -102,14 +103,16 @@ func (o TopImageStreamsOptions) Run() error {
 	return nil
 }
 
-var ImageStreamColumns = []string{"NAME", "STORAGE", "IMAGES", "LAYERS"}
+var ImageStreamColumns = []string{"NAME", "STORAGE", "TAGS", "IMAGES", "LAYERS", "LAST UPDATED"}
 
 // imageStreamInfo contains contains statistic information about ImageStream usage.
 type imageStreamInfo struct {
 	ImageStream string
 	Storage     int64
+	Tags        int
 	Images      int
 	Layers      int
+	LastUpdated metav1.Time
 }
 
 var _ Info = &imageStreamInfo{}
@@ -117,8 +120,14 @@ var _ Info = &imageStreamInfo{}
 func (i imageStreamInfo) PrintLine(out io.Writer) {
 	printValue(out, i.ImageStream)
 	printValue(out, units.BytesSize(float64(i.Storage)))
+	printValue(out, i.Tags)
 	printValue(out, i.Images)
 	printValue(out, i.Layers)
+	if i.LastUpdated.IsZero() {
+		printValue(out, "<none>")
+	} else {
+		printValue(out, fmt.Sprintf("%s ago", units.HumanDuration(time.Now().Sub(i.LastUpdated.Time))))
+	}
 }
 
 // imageStreamsTop generates ImageStream information from a graph and
@@ -132,11 +141,19 @@ func (o TopImageStreamsOptions) imageStreamsTop() []Info {
 	streamNodes := getImageStreamNodes(g.Nodes())
 	for _, sn := range streamNodes {
 		storage, images, layers := getImageStreamSize(g, sn)
+		var lastUpdated metav1.Time
+		for _, history := range sn.ImageStream.Status.Tags {
+			if len(history.Items) > 0 && history.Items[0].Created.After(lastUpdated.Time) {
+				lastUpdated = history.Items[0].Created
+			}
+		}
 		infos = append(infos, imageStreamInfo{
 			ImageStream: fmt.Sprintf("%s/%s", sn.ImageStream.Namespace, sn.ImageStream.Name),
 			Storage:     storage,
+			Tags:        len(sn.ImageStream.Status.Tags),
 			Images:      images,
 			Layers:      layers,
+			LastUpdated: lastUpdated,
 		})
 	}
 	sort.Slice(infos, func(i, j int) bool {
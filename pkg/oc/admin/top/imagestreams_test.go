@@ -34,6 +34,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        1,
 					Images:      0,
 					Layers:      0,
 				},
@@ -65,6 +66,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        1,
 					Images:      1,
 					Layers:      1,
 				},
@@ -96,6 +98,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        1,
 					Storage:     int64(1024),
 					Images:      1,
 					Layers:      1,
@@ -131,6 +134,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        1,
 					Storage:     int64(1536),
 					Images:      1,
 					Layers:      2,
@@ -173,6 +177,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        2,
 					Storage:     int64(1152),
 					Images:      2,
 					Layers:      3,
@@ -223,6 +228,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        2,
 					Storage:     int64(1152 + len("raw image config")),
 					Images:      2,
 					Layers:      3,
@@ -262,6 +268,7 @@ func TestImageStreamsTop(t *testing.T) {
 			expected: []Info{
 				imageStreamInfo{
 					ImageStream: "ns1/stream1",
+					Tags:        1,
 					Storage:     int64(1024),
 					Images:      1,
 					Layers:      1,
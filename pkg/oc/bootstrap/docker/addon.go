@@ -0,0 +1,192 @@
+package docker
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/variable"
+	"github.com/openshift/origin/pkg/oc/bootstrap/clusterup/kubeapiserver"
+	"github.com/openshift/origin/pkg/oc/bootstrap/docker/openshift"
+	osclientcmd "github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+// CmdAddRecommendedName is the recommended command name
+const CmdAddRecommendedName = "add"
+
+var (
+	cmdAddLong = templates.LongDesc(`
+		Installs add-ons into a running OpenShift cluster that was started with 'oc cluster up'.
+
+		Add-ons are optional components such as metrics or logging. They can be installed at
+		any time after the cluster is running, so a minimal cluster can be started first and
+		grown as needed. Use a profile (--profile) or the same --host-config-dir that was
+		passed to 'oc cluster up' so the command can locate the cluster configuration.`)
+
+	cmdAddExample = templates.Examples(`
+	  # Install metrics and logging into the running cluster
+	  %[1]s metrics logging
+
+	  # Install the service catalog into the cluster started with profile 'dev'
+	  %[1]s --profile=dev service-catalog`)
+)
+
+// ClusterAddOn describes an optional component that can be installed into a running
+// cluster. New add-ons only need to be appended to clusterAddOns to become available
+// to the add command.
+type ClusterAddOn struct {
+	// Name is the argument users pass to select this add-on.
+	Name string
+	// Description is a short description of what the add-on installs.
+	Description string
+	// Install installs the add-on into the running cluster.
+	Install func(c *ClusterUpConfig, out io.Writer) error
+}
+
+// clusterAddOns is the registry of installable add-ons.
+var clusterAddOns = []ClusterAddOn{
+	{
+		Name:        "metrics",
+		Description: "Cluster metrics (experimental)",
+		Install: func(c *ClusterUpConfig, out io.Writer) error {
+			return c.InstallMetrics(out)
+		},
+	},
+	{
+		Name:        "logging",
+		Description: "Aggregated logging (experimental)",
+		Install: func(c *ClusterUpConfig, out io.Writer) error {
+			return c.InstallLogging(out)
+		},
+	},
+	{
+		Name:        "service-catalog",
+		Description: "Service catalog and template service broker (experimental)",
+		Install: func(c *ClusterUpConfig, out io.Writer) error {
+			if err := c.InstallServiceCatalog(out); err != nil {
+				return err
+			}
+			if err := c.InstallTemplateServiceBroker(out); err != nil {
+				return err
+			}
+			return c.RegisterTemplateServiceBroker(out)
+		},
+	},
+}
+
+// getClusterAddOn returns the add-on registered under the given name.
+func getClusterAddOn(name string) (ClusterAddOn, bool) {
+	for _, addon := range clusterAddOns {
+		if addon.Name == name {
+			return addon, true
+		}
+	}
+	return ClusterAddOn{}, false
+}
+
+// availableAddOnNames returns the sorted names of all registered add-ons.
+func availableAddOnNames() []string {
+	names := []string{}
+	for _, addon := range clusterAddOns {
+		names = append(names, addon.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewCmdAdd creates a command that installs add-ons into a running cluster
+func NewCmdAdd(name, fullName string, f *osclientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	config := &ClusterAddConfig{
+		ClusterUpConfig: &ClusterUpConfig{
+			Out:            out,
+			UsePorts:       openshift.BasePorts,
+			PortForwarding: defaultPortForwarding(),
+			DNSPort:        openshift.DefaultDNSPort,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s ADDON ...", name),
+		Short:   "Install add-ons into a running cluster",
+		Long:    cmdAddLong,
+		Example: fmt.Sprintf(cmdAddExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			kcmdutil.CheckErr(config.Complete(f, c, args, out))
+			kcmdutil.CheckErr(config.Run(out))
+		},
+	}
+	config.Bind(cmd.Flags())
+	return cmd
+}
+
+// ClusterAddConfig installs one or more add-ons into a running cluster
+type ClusterAddConfig struct {
+	*ClusterUpConfig
+
+	AddOns []ClusterAddOn
+}
+
+func (c *ClusterAddConfig) Bind(flags *pflag.FlagSet) {
+	flags.StringVar(&c.Profile, "profile", "", "Name of the cluster profile the cluster was started with")
+	flags.StringVar(&c.HostConfigDir, "host-config-dir", "", "Directory on Docker host for OpenShift configuration")
+	flags.StringVar(&c.DockerMachine, "docker-machine", "", "Specify the Docker machine to use")
+	flags.StringVar(&c.ImageVersion, "version", "", "Specify the tag for OpenShift images")
+	flags.StringVar(&c.Image, "image", variable.DefaultImagePrefix, "Specify the images to use for OpenShift")
+	flags.StringVar(&c.ImageStreams, "image-streams", defaultImageStreams, "Specify which image streams to use, centos7|rhel7")
+	flags.StringVar(&c.PublicHostname, "public-hostname", "", "Public hostname for OpenShift cluster")
+	flags.StringVar(&c.RoutingSuffix, "routing-suffix", "", "Default suffix for server routes")
+}
+
+func (c *ClusterAddConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("specify one or more add-ons to install: %s", strings.Join(availableAddOnNames(), ", "))
+	}
+	for _, name := range args {
+		addon, ok := getClusterAddOn(name)
+		if !ok {
+			return fmt.Errorf("unknown add-on %q, valid add-ons are: %s", name, strings.Join(availableAddOnNames(), ", "))
+		}
+		c.AddOns = append(c.AddOns, addon)
+	}
+
+	if err := c.ClusterUpConfig.Complete(f, cmd, out); err != nil {
+		return err
+	}
+
+	// The installers read the cluster configuration that 'oc cluster up' generated.
+	if len(c.LocalConfigDir) == 0 {
+		if len(c.HostConfigDir) == 0 {
+			return fmt.Errorf("cannot locate the cluster configuration: specify --profile or --host-config-dir matching the flags passed to 'oc cluster up'")
+		}
+		c.LocalConfigDir = filepath.Join(c.HostConfigDir, kubeapiserver.KubeAPIServerDirName)
+	}
+	return nil
+}
+
+// Run installs the requested add-ons into the running cluster.
+func (c *ClusterAddConfig) Run(out io.Writer) error {
+	_, running, err := c.DockerHelper().GetContainerState(openshift.OpenShiftContainer)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return fmt.Errorf("no running OpenShift cluster was found, start one with 'oc cluster up'")
+	}
+
+	taskPrinter := NewTaskPrinter(getDetailedOut(out))
+	for _, addon := range c.AddOns {
+		taskPrinter.StartTask(fmt.Sprintf("Installing %s", addon.Name))
+		if err := addon.Install(c.ClusterUpConfig, out); err != nil {
+			return taskPrinter.ToError(err)
+		}
+		taskPrinter.Success()
+	}
+	return nil
+}
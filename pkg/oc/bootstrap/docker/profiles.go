@@ -0,0 +1,64 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+
+	"k8s.io/client-go/util/homedir"
+)
+
+// profileNameRegexp restricts profile names to something that is safe to use
+// as a directory name.
+var profileNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// ProfileDir returns the directory that holds the configuration and data of
+// the named cluster profile.
+func ProfileDir(name string) (string, error) {
+	if !profileNameRegexp.MatchString(name) {
+		return "", fmt.Errorf("invalid profile name %q: a profile name may only contain lower case letters, numbers, and dashes", name)
+	}
+	home := homedir.HomeDir()
+	if len(home) == 0 {
+		return "", fmt.Errorf("cannot determine home directory to store profile %q", name)
+	}
+	return path.Join(home, ".oc", "profiles", name), nil
+}
+
+// applyProfileDirs points the cluster directories at stable locations beneath
+// the profile directory so that configuration, etcd data and volumes survive
+// restarts. Directories that were set explicitly by flags take precedence over
+// the profile defaults.
+func (c *ClusterUpConfig) applyProfileDirs() error {
+	profileDir, err := ProfileDir(c.Profile)
+	if err != nil {
+		return err
+	}
+	if len(c.BaseTempDir) == 0 {
+		c.BaseTempDir = profileDir
+	}
+	if len(c.HostConfigDir) == 0 {
+		// StartSelfHosted generates the configuration and persists it here on
+		// the first run of the profile; later runs reuse it.
+		c.HostConfigDir = path.Join(profileDir, "openshift.local.config")
+	}
+	if len(c.HostDataDir) == 0 {
+		c.HostDataDir = path.Join(profileDir, "openshift.local.etcd")
+	}
+	if len(c.HostVolumesDir) == 0 {
+		c.HostVolumesDir = path.Join(profileDir, "openshift.local.volumes")
+	}
+	if len(c.HostPersistentVolumesDir) == 0 {
+		c.HostPersistentVolumesDir = path.Join(profileDir, "openshift.local.pv")
+	}
+	for _, dir := range []string{c.BaseTempDir, c.HostDataDir, c.HostVolumesDir, c.HostPersistentVolumesDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	// The config dir is intentionally not created here so that StartSelfHosted
+	// can tell a first run of the profile from a restart.
+	c.UseExistingConfig = true
+	return nil
+}
@@ -99,8 +99,9 @@ func (c *ClusterUpConfig) StartSelfHosted(out io.Writer) error {
 		err                          error
 	)
 
+	useExistingHostConfig := len(c.HostConfigDir) > 0 && !c.WriteConfig && hasMasterConfig(c.HostConfigDir)
 	switch {
-	case len(c.HostConfigDir) > 0 && !c.WriteConfig:
+	case useExistingHostConfig:
 		masterConfigDir = filepath.Join(c.HostConfigDir, kubeapiserver.KubeAPIServerDirName, "master")
 		openshiftAPIServerConfigDir = filepath.Join(c.HostConfigDir, kubeapiserver.OpenShiftAPIServerDirName)
 		openshiftControllerConfigDir = filepath.Join(c.HostConfigDir, kubeapiserver.OpenShiftControllerManagerDirName)
@@ -143,30 +144,7 @@ func (c *ClusterUpConfig) StartSelfHosted(out io.Writer) error {
 
 	// if we're supposed to write the config, we'll do that and then exit
 	if c.WriteConfig {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return err
-		}
-		absHostDir, err := cmdutil.MakeAbs(c.HostConfigDir, cwd)
-		if err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(masterConfigDir, path.Join(absHostDir, kubeapiserver.KubeAPIServerDirName, "master")); err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(openshiftAPIServerConfigDir, path.Join(absHostDir, kubeapiserver.OpenShiftAPIServerDirName)); err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(openshiftControllerConfigDir, path.Join(absHostDir, kubeapiserver.OpenShiftControllerManagerDirName)); err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(nodeConfigDir, path.Join(absHostDir, kubelet.NodeConfigDirName)); err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(kubeDNSConfigDir, path.Join(absHostDir, kubelet.KubeDNSDirName)); err != nil {
-			return err
-		}
-		if err := tmpformac.CopyDirectory(podManifestDir, path.Join(absHostDir, kubelet.PodManifestDirName)); err != nil {
+		if _, err := c.copyConfigToHostDir(masterConfigDir, openshiftAPIServerConfigDir, openshiftControllerConfigDir, nodeConfigDir, kubeDNSConfigDir, podManifestDir); err != nil {
 			return err
 		}
 
@@ -174,6 +152,22 @@ func (c *ClusterUpConfig) StartSelfHosted(out io.Writer) error {
 		return nil
 	}
 
+	// A host config dir that did not hold a configuration yet (for example the first run
+	// with --profile) gets the freshly generated configuration copied into it so that
+	// later starts can reuse it.
+	if len(c.HostConfigDir) > 0 && !useExistingHostConfig {
+		absHostDir, err := c.copyConfigToHostDir(masterConfigDir, openshiftAPIServerConfigDir, openshiftControllerConfigDir, nodeConfigDir, kubeDNSConfigDir, podManifestDir)
+		if err != nil {
+			return err
+		}
+		masterConfigDir = filepath.Join(absHostDir, kubeapiserver.KubeAPIServerDirName, "master")
+		openshiftAPIServerConfigDir = filepath.Join(absHostDir, kubeapiserver.OpenShiftAPIServerDirName)
+		openshiftControllerConfigDir = filepath.Join(absHostDir, kubeapiserver.OpenShiftControllerManagerDirName)
+		nodeConfigDir = filepath.Join(absHostDir, kubelet.NodeConfigDirName)
+		kubeDNSConfigDir = filepath.Join(absHostDir, kubelet.KubeDNSDirName)
+		podManifestDir = filepath.Join(absHostDir, kubelet.PodManifestDirName)
+	}
+
 	kubeletFlags, err := c.makeKubeletFlags(out, nodeConfigDir)
 	if err != nil {
 		return err
@@ -274,6 +268,45 @@ func (c *ClusterUpConfig) StartSelfHosted(out io.Writer) error {
 	return nil
 }
 
+// hasMasterConfig returns true if the host config directory already holds a generated
+// master configuration that can be reused.
+func hasMasterConfig(configDir string) bool {
+	_, err := os.Stat(filepath.Join(configDir, kubeapiserver.KubeAPIServerDirName, "master", "master-config.yaml"))
+	return err == nil
+}
+
+// copyConfigToHostDir copies the generated configuration directories into the host config
+// directory and returns its absolute path.
+func (c *ClusterUpConfig) copyConfigToHostDir(masterConfigDir, openshiftAPIServerConfigDir, openshiftControllerConfigDir, nodeConfigDir, kubeDNSConfigDir, podManifestDir string) (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	absHostDir, err := cmdutil.MakeAbs(c.HostConfigDir, cwd)
+	if err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(masterConfigDir, path.Join(absHostDir, kubeapiserver.KubeAPIServerDirName, "master")); err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(openshiftAPIServerConfigDir, path.Join(absHostDir, kubeapiserver.OpenShiftAPIServerDirName)); err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(openshiftControllerConfigDir, path.Join(absHostDir, kubeapiserver.OpenShiftControllerManagerDirName)); err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(nodeConfigDir, path.Join(absHostDir, kubelet.NodeConfigDirName)); err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(kubeDNSConfigDir, path.Join(absHostDir, kubelet.KubeDNSDirName)); err != nil {
+		return "", err
+	}
+	if err := tmpformac.CopyDirectory(podManifestDir, path.Join(absHostDir, kubelet.PodManifestDirName)); err != nil {
+		return "", err
+	}
+	return absHostDir, nil
+}
+
 // makeMasterConfig returns the directory where a generated masterconfig lives
 func (c *ClusterUpConfig) makeMasterConfig(out io.Writer) (string, error) {
 	publicHost := c.PublicHostname
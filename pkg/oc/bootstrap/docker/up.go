@@ -95,6 +95,9 @@ var (
 	  # Start OpenShift and preserve data and config between restarts
 	  %[1]s --host-data-dir=/mydata --use-existing-config
 
+	  # Start OpenShift and keep configuration and data under a named profile
+	  %[1]s --profile=mycluster
+
 	  # Use a different set of images
 	  %[1]s --image="registry.example.com/origin" --version="v1.1"
 
@@ -178,6 +181,7 @@ type ClusterUpConfig struct {
 	Image                       string
 	ImageStreams                string
 	DockerMachine               string
+	Profile                     string
 	SkipRegistryCheck           bool
 	ShouldInstallMetrics        bool
 	ShouldInstallLogging        bool
@@ -239,6 +243,7 @@ func (config *ClusterUpConfig) Bind(flags *pflag.FlagSet) {
 	flags.BoolVar(&config.SkipRegistryCheck, "skip-registry-check", false, "Skip Docker daemon registry check")
 	flags.StringVar(&config.PublicHostname, "public-hostname", "", "Public hostname for OpenShift cluster")
 	flags.StringVar(&config.RoutingSuffix, "routing-suffix", "", "Default suffix for server routes")
+	flags.StringVar(&config.Profile, "profile", "", "Name of a cluster profile. Configuration and data for each profile are kept under a stable directory and reused on restart (experimental).")
 	flags.BoolVar(&config.UseExistingConfig, "use-existing-config", false, "Use existing configuration if present")
 	flags.StringVar(&config.HostConfigDir, "host-config-dir", config.HostConfigDir, "Directory on Docker host for OpenShift configuration")
 	flags.BoolVar(&config.WriteConfig, "write-config", false, "Write the configuration files into host config dir")
@@ -268,6 +273,11 @@ func (c *ClusterUpConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command, o
 		c.ImageVersion = strings.TrimRight("v"+version.Get().Major+"."+version.Get().Minor, "+")
 
 	}
+	if len(c.Profile) > 0 {
+		if err := c.applyProfileDirs(); err != nil {
+			return err
+		}
+	}
 	if len(c.BaseTempDir) == 0 {
 		var err error
 		c.BaseTempDir, err = tmpformac.TempDir("", "oc-cluster-up-")
@@ -165,6 +165,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdExport(fullName, f, in, out),
 				cmd.NewCmdExtract(fullName, f, in, out, errout),
 				cmd.NewCmdIdle(fullName, f, out, errout),
+				cmd.NewCmdWait(cmd.WaitRecommendedName, fullName+" "+cmd.WaitRecommendedName, f, out),
 				observe.NewCmdObserve(fullName, f, out, errout),
 				policy.NewCmdPolicy(policy.PolicyRecommendedName, fullName+" "+policy.PolicyRecommendedName, f, out, errout),
 				cmd.NewCmdAuth(fullName, f, out, errout),
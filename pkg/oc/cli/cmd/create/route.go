@@ -1,20 +1,25 @@
 package create
 
 import (
+	"encoding/pem"
 	"fmt"
 	"io"
 
 	"github.com/spf13/cobra"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
 	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	"github.com/openshift/origin/pkg/service/controller/servingcert"
 	fileutil "github.com/openshift/origin/pkg/util/file"
 )
 
@@ -291,7 +296,10 @@ var (
 
 		Specify the service (either just its name or using type/name syntax) that the
 		generated route should expose via the --service flag. A destination CA certificate
-		is needed for reencrypt routes, specify one with the --dest-ca-cert flag.`)
+		is needed for reencrypt routes, specify one with the --dest-ca-cert flag. If the
+		service is annotated with a generated serving certificate and no destination CA
+		certificate is given, the service-serving CA is discovered from the serving cert
+		secret and used automatically.`)
 
 	reencryptRouteExample = templates.Examples(`
 		# Create a route named "my-route" that exposes the frontend service.
@@ -330,8 +338,7 @@ func NewCmdCreateReencryptRoute(fullName string, f *clientcmd.Factory, out io.Wr
 	cmd.MarkFlagFilename("key")
 	cmd.Flags().String("ca-cert", "", "Path to a CA certificate file.")
 	cmd.MarkFlagFilename("ca-cert")
-	cmd.Flags().String("dest-ca-cert", "", "Path to a CA certificate file, used for securing the connection from the router to the destination.")
-	cmd.MarkFlagRequired("dest-ca-cert")
+	cmd.Flags().String("dest-ca-cert", "", "Path to a CA certificate file, used for securing the connection from the router to the destination. If unset and the service uses a generated serving certificate, the service-serving CA is used.")
 	cmd.MarkFlagFilename("dest-ca-cert")
 	cmd.Flags().String("wildcard-policy", "", "Sets the WildcardPolicy for the hostname, the default is \"None\". valid values are \"None\" and \"Subdomain\"")
 
@@ -395,6 +402,14 @@ func CreateReencryptRoute(f *clientcmd.Factory, out io.Writer, cmd *cobra.Comman
 	if err != nil {
 		return err
 	}
+	if len(destCACert) == 0 {
+		// default the destination CA to the service-serving CA when the target
+		// service uses a generated serving certificate
+		destCACert, err = servingCertCA(kc, ns, serviceName)
+		if err != nil {
+			return err
+		}
+	}
 	route.Spec.TLS.DestinationCACertificate = string(destCACert)
 
 	insecurePolicy := kcmdutil.GetFlagString(cmd, "insecure-policy")
@@ -427,6 +442,40 @@ func CreateReencryptRoute(f *clientcmd.Factory, out io.Writer, cmd *cobra.Comman
 	return nil
 }
 
+// servingCertCA returns the PEM encoded CA certificates that signed the generated
+// serving certificate of the named service, or nil when the service is not annotated
+// with a serving cert secret. The serving cert controller stores the full chain in
+// the secret, leaf first, so the CA certificates are extracted from the chain.
+func servingCertCA(kc kclientset.Interface, namespace, serviceName string) ([]byte, error) {
+	service, err := kc.Core().Services(namespace).Get(serviceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	secretName := service.Annotations[servingcert.ServingCertSecretAnnotation]
+	if len(secretName) == 0 {
+		return nil, fmt.Errorf("a destination CA certificate is required; service %q does not use a generated serving certificate, specify one with --dest-ca-cert", serviceName)
+	}
+	secret, err := kc.Core().Secrets(namespace).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read serving cert secret %q for service %q: %v", secretName, serviceName, err)
+	}
+	certs, err := crypto.CertsFromPEM(secret.Data[kapi.TLSCertKey])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse serving cert secret %q for service %q: %v", secretName, serviceName, err)
+	}
+	var caPEM []byte
+	for _, cert := range certs {
+		if !cert.IsCA {
+			continue
+		}
+		caPEM = append(caPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	if len(caPEM) == 0 {
+		return nil, fmt.Errorf("serving cert secret %q for service %q does not contain a CA certificate, specify one with --dest-ca-cert", secretName, serviceName)
+	}
+	return caPEM, nil
+}
+
 func resolveServiceName(f *clientcmd.Factory, resource string) (string, error) {
 	if len(resource) == 0 {
 		return "", fmt.Errorf("you need to provide a service name via --service")
@@ -28,6 +28,7 @@ import (
 
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset/typed/apps/internalversion"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	imageclient "github.com/openshift/origin/pkg/image/generated/internalclientset/typed/image/internalversion"
 	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
@@ -62,6 +63,7 @@ type DebugOptions struct {
 	KeepInitContainers bool
 	OneContainer       bool
 	NodeName           string
+	Revision           int64
 	AddEnv             []kapi.EnvVar
 	RemoveEnv          []string
 }
@@ -106,6 +108,9 @@ var (
 	  # Debug a specific failing container by running the env command in the 'second' container
 	  %[1]s dc/test -c second -- /bin/env
 
+	  # Debug the environment of the third deployment of a config, exactly as it ran
+	  %[1]s dc/test --revision=3
+
 	  # See the pod that would be created to debug
 	  %[1]s dc/test -o yaml`)
 )
@@ -164,6 +169,7 @@ func NewCmdDebug(fullName string, f *clientcmd.Factory, in io.Reader, out, errou
 	cmd.Flags().BoolVar(&options.KeepReadiness, "keep-readiness", false, "If true, keep the original pod readiness probes")
 	cmd.Flags().BoolVar(&options.OneContainer, "one-container", false, "If true, run only the selected container, remove all others")
 	cmd.Flags().StringVar(&options.NodeName, "node-name", "", "Set a specific node to run on - by default the pod will run on any valid node")
+	cmd.Flags().Int64Var(&options.Revision, "revision", 0, "Debug the pod template captured in this historical deployment config revision, including the exact images it deployed. Only valid for deployment configs.")
 	cmd.Flags().BoolVar(&options.AsRoot, "as-root", false, "If true, try to run the container as the root user")
 	cmd.Flags().Int64Var(&options.AsUser, "as-user", -1, "Try to run the container as a specific user UID (note: admins may limit your ability to use this flag)")
 
@@ -246,12 +252,39 @@ func (o *DebugOptions) Complete(cmd *cobra.Command, f *clientcmd.Factory, args [
 		return fmt.Errorf("you must identify a resource with a pod template to debug")
 	}
 
-	template, err := f.ApproximatePodTemplateForObject(infos[0].Object)
-	if err != nil && template == nil {
-		return fmt.Errorf("cannot debug %s: %v", infos[0].Name, err)
-	}
-	if err != nil {
-		glog.V(4).Infof("Unable to get exact template, but continuing with fallback: %v", err)
+	var template *kapi.PodTemplateSpec
+	if o.Revision > 0 {
+		// use the pod template captured in the replication controller for the requested
+		// revision so the debug pod matches exactly what that deployment ran, including
+		// any resolved image digests
+		dc, ok := infos[0].Object.(*appsapi.DeploymentConfig)
+		if !ok {
+			return fmt.Errorf("--revision may only be used with deployment configs")
+		}
+		kc, err := f.ClientSet()
+		if err != nil {
+			return err
+		}
+		rcName := appsutil.DeploymentNameForConfigVersion(dc.Name, o.Revision)
+		rc, err := kc.Core().ReplicationControllers(infos[0].Namespace).Get(rcName, metav1.GetOptions{})
+		if kapierrors.IsNotFound(err) {
+			return fmt.Errorf("revision %d of %s no longer exists - the replication controller %q may have been pruned", o.Revision, infos[0].Name, rcName)
+		}
+		if err != nil {
+			return err
+		}
+		if appsutil.DeploymentConfigNameFor(rc) != dc.Name {
+			return fmt.Errorf("replication controller %q was not created by deployment config %q", rcName, dc.Name)
+		}
+		template = rc.Spec.Template
+	} else {
+		template, err = f.ApproximatePodTemplateForObject(infos[0].Object)
+		if err != nil && template == nil {
+			return fmt.Errorf("cannot debug %s: %v", infos[0].Name, err)
+		}
+		if err != nil {
+			glog.V(4).Infof("Unable to get exact template, but continuing with fallback: %v", err)
+		}
 	}
 	pod := &kapi.Pod{
 		ObjectMeta: template.ObjectMeta,
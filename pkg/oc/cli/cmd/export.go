@@ -129,53 +129,10 @@ func RunExport(f *clientcmd.Factory, exporter Exporter, in io.Reader, out io.Wri
 	}
 
 	if !raw {
-		newInfos := []*resource.Info{}
-		errs := []error{}
-		for _, info := range infos {
-			converted := false
-
-			// convert unstructured object to runtime.Object
-			data, err := runtime.Encode(legacyscheme.Codecs.LegacyCodec(), info.Object)
-			if err != nil {
-				errs = append(errs, err)
-				continue
-			}
-			decoded, err := runtime.Decode(f.Decoder(true), data)
-			if err == nil {
-				// ignore error, if any, in order to allow resources
-				// not known by the client to still be exported
-				info.Object = decoded
-				converted = true
-			}
-
-			if err := exporter.Export(info.Object, exact); err != nil {
-				if err == ErrExportOmit {
-					continue
-				}
-				errs = append(errs, err)
-			}
-
-			// if an unstructured resource was successfully converted by the universal decoder,
-			// re-convert that object once again into its external version.
-			// If object cannot be converted to an external version, ignore error and proceed with
-			// internal version.
-			if converted {
-				if data, err = runtime.Encode(legacyscheme.Codecs.LegacyCodec(outputVersion), info.Object); err == nil {
-					external, err := runtime.Decode(f.Decoder(false), data)
-					if err != nil {
-						errs = append(errs, fmt.Errorf("error: failed to convert resource to external version: %v", err))
-						continue
-					}
-					info.Object = external
-				}
-			}
-
-			newInfos = append(newInfos, info)
-		}
-		if len(errs) > 0 {
-			return utilerrors.NewAggregate(errs)
+		infos, err = exportInfos(f, exporter, infos, outputVersion, exact)
+		if err != nil {
+			return err
 		}
-		infos = newInfos
 	}
 
 	var result runtime.Object
@@ -224,3 +181,129 @@ func RunExport(f *clientcmd.Factory, exporter Exporter, in io.Reader, out io.Wri
 	}
 	return p.PrintObj(result, out)
 }
+
+// exportInfos runs each object through the exporter to strip fields that are assigned
+// by the server, and converts the result back to its external version when possible.
+func exportInfos(f *clientcmd.Factory, exporter Exporter, infos []*resource.Info, outputVersion schema.GroupVersion, exact bool) ([]*resource.Info, error) {
+	newInfos := []*resource.Info{}
+	errs := []error{}
+	for _, info := range infos {
+		converted := false
+
+		// convert unstructured object to runtime.Object
+		data, err := runtime.Encode(legacyscheme.Codecs.LegacyCodec(), info.Object)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		decoded, err := runtime.Decode(f.Decoder(true), data)
+		if err == nil {
+			// ignore error, if any, in order to allow resources
+			// not known by the client to still be exported
+			info.Object = decoded
+			converted = true
+		}
+
+		if err := exporter.Export(info.Object, exact); err != nil {
+			if err == ErrExportOmit {
+				continue
+			}
+			errs = append(errs, err)
+		}
+
+		// if an unstructured resource was successfully converted by the universal decoder,
+		// re-convert that object once again into its external version.
+		// If object cannot be converted to an external version, ignore error and proceed with
+		// internal version.
+		if converted {
+			if data, err = runtime.Encode(legacyscheme.Codecs.LegacyCodec(outputVersion), info.Object); err == nil {
+				external, err := runtime.Decode(f.Decoder(false), data)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("error: failed to convert resource to external version: %v", err))
+					continue
+				}
+				info.Object = external
+			}
+		}
+
+		newInfos = append(newInfos, info)
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return newInfos, nil
+}
+
+// RunGetExportClean implements the --export-clean mode of the get command. It retrieves
+// the requested objects the same way get does, then strips status, generated fields, and
+// other cluster-specific values (such as a service's clusterIP, a build config trigger's
+// last triggered image ID, or a build's status) so the output can be re-applied in
+// another namespace or cluster.
+func RunGetExportClean(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	selector := kcmdutil.GetFlagString(cmd, "selector")
+	allNamespaces := kcmdutil.GetFlagBool(cmd, "all-namespaces")
+	filenames := kcmdutil.GetFlagStringSlice(cmd, "filename")
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	outputVersion := *clientConfig.GroupVersion
+
+	cmdNamespace, explicit, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	b := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(cmdNamespace).DefaultNamespace().AllNamespaces(allNamespaces).
+		FilenameParam(explicit, &resource.FilenameOptions{Recursive: false, Filenames: filenames}).
+		LabelSelectorParam(selector).
+		ResourceTypeOrNameArgs(true, args...).
+		Flatten()
+
+	one := false
+	infos, err := b.Do().IntoSingleItemImplied(&one).Infos()
+	if err != nil {
+		return err
+	}
+
+	if len(infos) == 0 {
+		return fmt.Errorf("no resources found - nothing to export")
+	}
+
+	infos, err = exportInfos(f, &DefaultExporter{}, infos, outputVersion, false)
+	if err != nil {
+		return err
+	}
+
+	result, err := clientcmd.AsVersionedObject(infos, !one, outputVersion, legacyscheme.Codecs.LegacyCodec(outputVersion))
+	if err != nil {
+		return err
+	}
+
+	// use YAML as the default format
+	outputFormat := kcmdutil.GetFlagString(cmd, "output")
+	templateFile := kcmdutil.GetFlagString(cmd, "template")
+	if len(outputFormat) == 0 && len(templateFile) != 0 {
+		outputFormat = "template"
+	}
+	if len(outputFormat) == 0 {
+		outputFormat = "yaml"
+	}
+	decoders := []runtime.Decoder{f.Decoder(true), unstructured.UnstructuredJSONScheme}
+	printOpts := kcmdutil.ExtractCmdPrintOptions(cmd, false)
+	printOpts.OutputFormatType = outputFormat
+	printOpts.OutputFormatArgument = templateFile
+	printOpts.AllowMissingKeys = kcmdutil.GetFlagBool(cmd, "allow-missing-template-keys")
+
+	mapper, typer := f.Object()
+	p, err := kprinters.GetStandardPrinter(
+		mapper, typer, legacyscheme.Codecs.LegacyCodec(outputVersion), decoders, *printOpts)
+
+	if err != nil {
+		return err
+	}
+	return p.PrintObj(result, out)
+}
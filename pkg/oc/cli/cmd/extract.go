@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,8 +11,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
@@ -31,7 +36,11 @@ var (
 		names of each key will be written to stdandard error.
 
 		You can limit which keys are extracted with the --keys=NAME flag, or set the directory to extract to
-		with --to=DIRECTORY.`)
+		with --to=DIRECTORY.
+
+		With --watch the command keeps running after the initial extraction and rewrites any key whose
+		contents change on the server, which keeps a local directory in lockstep with a secret or config
+		map - useful for certificate rotation. Only keys that actually changed are written.`)
 
 	extractExample = templates.Examples(`
 		# extract the secret "test" to the current directory
@@ -44,7 +53,10 @@ var (
 	  %[1]s extract configmap/nginx --to=-
 
 	  # extract only the key "nginx.conf" from config map "nginx" to the /tmp directory
-	  %[1]s extract configmap/nginx --to=/tmp --keys=nginx.conf`)
+	  %[1]s extract configmap/nginx --to=/tmp --keys=nginx.conf
+
+	  # extract the secret "test" to /tmp/test and keep it up to date as the secret changes
+	  %[1]s extract secret/test --to=/tmp/test --watch`)
 )
 
 type ExtractOptions struct {
@@ -53,6 +65,7 @@ type ExtractOptions struct {
 	OnlyKeys        []string
 	TargetDirectory string
 	Overwrite       bool
+	Watch           bool
 
 	VisitorFn             func(resource.VisitorFunc) error
 	ExtractFileContentsFn func(runtime.Object) (map[string][]byte, bool, error)
@@ -86,6 +99,7 @@ func NewCmdExtract(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to identify to extract the resource.")
 	cmd.MarkFlagFilename("filename")
 	cmd.Flags().StringSliceVar(&options.OnlyKeys, "keys", options.OnlyKeys, "An optional list of keys to extract (default is all keys).")
+	cmd.Flags().BoolVarP(&options.Watch, "watch", "w", options.Watch, "After the initial extraction, watch the resource for changes and rewrite keys whose contents change. Requires a single resource.")
 	kcmdutil.AddPrinterFlags(cmd)
 	return cmd
 }
@@ -127,6 +141,8 @@ func name(info *resource.Info) string {
 func (o *ExtractOptions) Run() error {
 	count := 0
 	contains := sets.NewString(o.OnlyKeys...)
+	hashes := make(map[string][sha256.Size]byte)
+	var infos []*resource.Info
 	err := o.VisitorFn(func(info *resource.Info, err error) error {
 		if err != nil {
 			return fmt.Errorf("%s: %v", name(info), err)
@@ -140,28 +156,8 @@ func (o *ExtractOptions) Run() error {
 			return nil
 		}
 		count++
-		var errs []error
-		for k, v := range contents {
-			if contains.Len() == 0 || contains.Has(k) {
-				switch {
-				case o.TargetDirectory == "-":
-					fmt.Fprintf(o.Err, "# %s\n", k)
-					o.Out.Write(v)
-					if !bytes.HasSuffix(v, []byte("\n")) {
-						fmt.Fprintln(o.Out)
-					}
-				default:
-					target := filepath.Join(o.TargetDirectory, k)
-					if err := writeToDisk(target, v, o.Overwrite, o.Out); err != nil {
-						if os.IsExist(err) {
-							err = fmt.Errorf("file exists, pass --confirm to overwrite")
-						}
-						errs = append(errs, fmt.Errorf("%s: %v", k, err))
-					}
-				}
-			}
-		}
-		if len(errs) > 0 {
+		infos = append(infos, info)
+		if errs := o.writeContents(contents, contains, hashes, o.Overwrite); len(errs) > 0 {
 			return fmt.Errorf(kcmdutil.MultipleErrors("error: ", errs))
 		}
 		return nil
@@ -172,9 +168,90 @@ func (o *ExtractOptions) Run() error {
 	if count == 0 {
 		return fmt.Errorf("you must specify at least one resource to extract")
 	}
+	if o.Watch {
+		if len(infos) != 1 {
+			return fmt.Errorf("--watch may only be used with a single resource")
+		}
+		return o.watchSource(infos[0], contains, hashes)
+	}
 	return nil
 }
 
+// writeContents writes the provided keys to the target, skipping any key whose contents have
+// not changed since the last write. The hash of each written key is recorded in hashes.
+func (o *ExtractOptions) writeContents(contents map[string][]byte, contains sets.String, hashes map[string][sha256.Size]byte, overwrite bool) []error {
+	var errs []error
+	for k, v := range contents {
+		if contains.Len() > 0 && !contains.Has(k) {
+			continue
+		}
+		hash := sha256.Sum256(v)
+		if previous, ok := hashes[k]; ok && previous == hash {
+			continue
+		}
+		switch {
+		case o.TargetDirectory == "-":
+			fmt.Fprintf(o.Err, "# %s\n", k)
+			o.Out.Write(v)
+			if !bytes.HasSuffix(v, []byte("\n")) {
+				fmt.Fprintln(o.Out)
+			}
+			hashes[k] = hash
+		default:
+			target := filepath.Join(o.TargetDirectory, k)
+			if err := writeToDisk(target, v, overwrite, o.Out); err != nil {
+				if os.IsExist(err) {
+					err = fmt.Errorf("file exists, pass --confirm to overwrite")
+				}
+				errs = append(errs, fmt.Errorf("%s: %v", k, err))
+				continue
+			}
+			hashes[k] = hash
+		}
+	}
+	return errs
+}
+
+// watchSource watches the extracted resource and rewrites keys whose contents change until the
+// resource is deleted or an error occurs.
+func (o *ExtractOptions) watchSource(info *resource.Info, contains sets.String, hashes map[string][sha256.Size]byte) error {
+	resourceVersion := info.ResourceVersion
+	for {
+		w, err := resource.NewHelper(info.Client, info.Mapping).Watch(info.Namespace, resourceVersion, &metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", info.Name).String(),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %v", name(info), err)
+		}
+		for event := range w.ResultChan() {
+			switch event.Type {
+			case watch.Deleted:
+				return fmt.Errorf("%s has been deleted", name(info))
+			case watch.Error:
+				return fmt.Errorf("error while watching %s: %#v", name(info), event.Object)
+			case watch.Added, watch.Modified:
+				m, err := meta.Accessor(event.Object)
+				if err != nil {
+					return err
+				}
+				resourceVersion = m.GetResourceVersion()
+				contents, ok, err := o.ExtractFileContentsFn(event.Object)
+				if err != nil {
+					return fmt.Errorf("%s: %v", name(info), err)
+				}
+				if !ok {
+					continue
+				}
+				// updates always overwrite - the initial extraction already required --confirm
+				// for files the command did not create
+				if errs := o.writeContents(contents, contains, hashes, true); len(errs) > 0 {
+					return fmt.Errorf(kcmdutil.MultipleErrors("error: ", errs))
+				}
+			}
+		}
+	}
+}
+
 func writeToDisk(path string, data []byte, overwrite bool, out io.Writer) error {
 	if overwrite {
 		if err := ioutil.WriteFile(path, data, 0600); err != nil {
@@ -35,8 +35,9 @@ var (
 	idleLong = templates.LongDesc(`
 		Idle scalable resources
 
-		Idling discovers the scalable resources (such as deployment configs and replication controllers)
-		associated with a series of services by examining the endpoints of the service.
+		Idling discovers the scalable resources (such as deployment configs, replication controllers,
+		deployments, and stateful sets) associated with a series of services by examining the endpoints
+		of the service.
 		Each service is then marked as idled, the associated resources are recorded, and the resources
 		are scaled down to zero replicas.
 
@@ -570,7 +571,7 @@ func (o *IdleOptions) RunIdle(f *clientcmd.Factory) error {
 
 	externalKubeExtensionClient := kextensionsclient.New(kclient.Extensions().RESTClient())
 	delegScaleGetter := appsmanualclient.NewDelegatingScaleNamespacer(appsV1Client, externalKubeExtensionClient)
-	scaleAnnotater := utilunidling.NewScaleAnnotater(delegScaleGetter, appClient.Apps(), kclient.Core(), func(currentReplicas int32, annotations map[string]string) {
+	scaleAnnotater := utilunidling.NewScaleAnnotater(delegScaleGetter, appClient.Apps(), kclient.Core(), kclient.Extensions(), kclient.Apps(), func(currentReplicas int32, annotations map[string]string) {
 		annotations[unidlingapi.IdledAtAnnotation] = nowTime.UTC().Format(time.RFC3339)
 		annotations[unidlingapi.PreviousScaleAnnotation] = fmt.Sprintf("%v", currentReplicas)
 	})
@@ -672,7 +673,7 @@ func (o *IdleOptions) RunIdle(f *clientcmd.Factory) error {
 	for scaleRef, info := range toScale {
 		if !o.dryRun {
 			info.scale.Spec.Replicas = 0
-			scaleUpdater := utilunidling.NewScaleUpdater(f.JSONEncoder(), info.namespace, appClient.Apps(), kclient.Core())
+			scaleUpdater := utilunidling.NewScaleUpdater(f.JSONEncoder(), info.namespace, appClient.Apps(), kclient.Core(), kclient.Extensions(), kclient.Apps())
 			if err := scaleAnnotater.UpdateObjectScale(scaleUpdater, info.namespace, scaleRef.CrossGroupObjectReference, info.obj, info.scale); err != nil {
 				fmt.Fprintf(o.errOut, "error: unable to scale %s %s/%s to 0, but still listed as target for unidling: %v\n", scaleRef.Kind, info.namespace, scaleRef.Name, err)
 				hadError = true
@@ -0,0 +1,222 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	configcmd "github.com/openshift/origin/pkg/bulk"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+	"github.com/openshift/origin/pkg/oc/generate/app"
+	appcmd "github.com/openshift/origin/pkg/oc/generate/cmd"
+	"github.com/openshift/origin/pkg/oc/generate/dockercompose"
+	templateinternalclient "github.com/openshift/origin/pkg/template/client/internalversion"
+	templateclient "github.com/openshift/origin/pkg/template/generated/internalclientset/typed/template/internalversion"
+)
+
+const DockerComposeV3GeneratorName = "docker-compose/v3"
+
+var (
+	dockerComposeLong = templates.LongDesc(`
+		Import docker-compose files as OpenShift objects
+
+		docker-compose files describe a set of cooperating services and how they are built, scaled,
+		and connected. This command transforms a version 3 compose file into its OpenShift
+		equivalent: services become deployment configs with services and routes, build sections
+		become build configs, deploy.replicas sets the deployment scale, healthchecks become
+		container probes, and compose secrets become secret objects mounted under /run/secrets.
+
+		The command will create objects unless you pass the -o yaml or --as-template flags to
+		generate a configuration file for later use.
+
+		Experimental: This command is under active development and may change without notice.`)
+
+	dockerComposeExample = templates.Examples(`
+		# Import a directory containing a docker-compose.yml file
+	  $ %[1]s docker-compose -f .
+
+	  # Turn a docker-compose file into a template
+	  $ %[1]s docker-compose -f ./docker-compose.yml -o yaml --as-template`)
+)
+
+type DockerComposeOptions struct {
+	Action configcmd.BulkAction
+
+	In        io.Reader
+	Filenames []string
+
+	Generator  string
+	AsTemplate string
+
+	PrintObject    func(runtime.Object) error
+	OutputVersions []schema.GroupVersion
+
+	Namespace string
+	Client    templateclient.TemplateInterface
+}
+
+// NewCmdDockerCompose imports a docker-compose v3 file (schema described here:
+// https://docs.docker.com/compose/compose-file/) as a template.
+func NewCmdDockerCompose(fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &DockerComposeOptions{
+		Action: configcmd.BulkAction{
+			Out:    out,
+			ErrOut: errout,
+		},
+		In:        in,
+		Generator: DockerComposeV3GeneratorName,
+	}
+	cmd := &cobra.Command{
+		Use:     "docker-compose -f COMPOSEFILE",
+		Short:   "Import a docker-compose file into OpenShift (experimental)",
+		Long:    dockerComposeLong,
+		Example: fmt.Sprintf(dockerComposeExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			if err := options.Run(); err != nil {
+				// TODO: move me to kcmdutil
+				if err == kcmdutil.ErrExit {
+					os.Exit(1)
+				}
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+	usage := "Filename, directory, or URL to docker-compose.yml file to use"
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	cmd.MarkFlagRequired("filename")
+
+	cmd.Flags().String("generator", options.Generator, "The name of the generator strategy to use - specify this value to for backwards compatibility.")
+	cmd.Flags().StringVar(&options.AsTemplate, "as-template", "", "If set, generate a template with the provided name")
+
+	options.Action.BindForOutput(cmd.Flags())
+	cmd.Flags().String("output-version", "", "The preferred API versions of the output objects")
+
+	return cmd
+}
+
+func (o *DockerComposeOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	version, _ := cmd.Flags().GetString("output-version")
+	for _, v := range strings.Split(version, ",") {
+		gv, err := schema.ParseGroupVersion(v)
+		if err != nil {
+			return fmt.Errorf("provided output-version %q is not valid: %v", v, err)
+		}
+		o.OutputVersions = append(o.OutputVersions, gv)
+	}
+	o.OutputVersions = append(o.OutputVersions, legacyscheme.Registry.EnabledVersions()...)
+
+	o.Action.Bulk.Mapper = clientcmd.ResourceMapper(f)
+	o.Action.Bulk.Op = configcmd.Create
+	mapper, _ := f.Object()
+	o.PrintObject = cmdutil.VersionedPrintObject(f.PrintObject, cmd, mapper, o.Action.Out)
+
+	o.Generator, _ = cmd.Flags().GetString("generator")
+
+	ns, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = ns
+
+	templateClient, err := f.OpenshiftInternalTemplateClient()
+	if err != nil {
+		return err
+	}
+	o.Client = templateClient.Template()
+
+	return nil
+}
+
+func (o *DockerComposeOptions) Validate() error {
+	if len(o.Filenames) != 1 {
+		return fmt.Errorf("you must provide the path to a docker-compose file or directory containing docker-compose.yml")
+	}
+	switch o.Generator {
+	case DockerComposeV3GeneratorName:
+	default:
+		return fmt.Errorf("the generator %q is not supported, use: %s", o.Generator, DockerComposeV3GeneratorName)
+	}
+	return nil
+}
+
+func (o *DockerComposeOptions) Run() error {
+	localPath, contents, err := contentsForPathOrURL(o.Filenames[0], o.In, "docker-compose.yml", "docker-compose.yaml")
+	if err != nil {
+		return err
+	}
+
+	// build contexts and secret files are resolved relative to the directory
+	// that contains the compose file
+	localDir := localPath
+	if stat, err := os.Stat(localPath); err == nil && !stat.IsDir() {
+		localDir = filepath.Dir(localPath)
+	}
+
+	g := &dockercompose.Generator{
+		LocalPath: localDir,
+	}
+	switch {
+	case len(o.AsTemplate) > 0:
+		g.Name = o.AsTemplate
+	case len(localDir) > 0:
+		g.Name = filepath.Base(localDir)
+	}
+	if len(g.Name) == 0 {
+		g.Name = "compose"
+	}
+
+	template, err := g.Generate(contents)
+	if err != nil {
+		return err
+	}
+
+	template.ObjectLabels = map[string]string{"compose.service": template.Name}
+
+	// all the types generated into the template should be known
+	if errs := app.AsVersionedObjects(template.Objects, legacyscheme.Scheme, legacyscheme.Scheme, o.OutputVersions...); len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Fprintf(o.Action.ErrOut, "error: %v\n", err)
+		}
+	}
+
+	if o.Action.ShouldPrint() || (o.Action.Output == "name" && len(o.AsTemplate) > 0) {
+		var out runtime.Object
+		if len(o.AsTemplate) > 0 {
+			template.Name = o.AsTemplate
+			out = template
+		} else {
+			out = &kapi.List{Items: template.Objects}
+		}
+		return o.PrintObject(out)
+	}
+
+	templateProcessor := templateinternalclient.NewTemplateProcessorClient(o.Client.RESTClient(), o.Namespace)
+	result, err := appcmd.TransformTemplate(template, templateProcessor, o.Namespace, nil, false)
+	if err != nil {
+		return err
+	}
+
+	if o.Action.Verbose() {
+		appcmd.DescribeGeneratedTemplate(o.Action.Out, "", result, o.Namespace)
+	}
+
+	if errs := o.Action.WithMessage("Importing docker-compose", "creating").Run(&kapi.List{Items: result.Objects}, o.Namespace); len(errs) > 0 {
+		return kcmdutil.ErrExit
+	}
+	return nil
+}
@@ -92,6 +92,7 @@ func NewCmdLogin(fullName string, f *osclientcmd.Factory, reader io.Reader, out,
 	// Login is the only command that can negotiate a session token against the auth server using basic auth
 	cmds.Flags().StringVarP(&options.Username, "username", "u", "", "Username, will prompt if not provided")
 	cmds.Flags().StringVarP(&options.Password, "password", "p", "", "Password, will prompt if not provided")
+	cmds.Flags().BoolVar(&options.WebLogin, "web", false, "Login using a browser on another machine, printing a URL and code to enter there")
 
 	return cmds
 }
@@ -176,6 +177,10 @@ func (o LoginOptions) Validate(args []string, serverFlag string) error {
 		return errors.New("--token and --username are mutually exclusive")
 	}
 
+	if o.WebLogin && (len(o.Username) > 0 || len(o.Password) > 0 || len(o.Token) > 0) {
+		return errors.New("--web is mutually exclusive with --username, --password, and --token")
+	}
+
 	if o.StartingKubeConfig == nil {
 		return errors.New("Must have a config file already created")
 	}
@@ -66,6 +66,10 @@ type LoginOptions struct {
 
 	Token string
 
+	// WebLogin requests a token via the OAuth device authorization grant, printing a
+	// verification URL and code so the browser step can happen on another machine
+	WebLogin bool
+
 	PathOptions *kclientcmd.PathOptions
 
 	CommandName    string
@@ -226,7 +230,12 @@ func (o *LoginOptions) gatherAuthInfo() error {
 	clientConfig.KeyData = []byte{}
 	clientConfig.CertFile = o.CertFile
 	clientConfig.KeyFile = o.KeyFile
-	token, err := tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	var token string
+	if o.WebLogin {
+		token, err = tokencmd.RequestTokenWithDeviceFlow(o.Config, o.Out)
+	} else {
+		token, err = tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	}
 	if err != nil {
 		return err
 	}
@@ -1,21 +1,28 @@
 package cmd
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/spf13/cobra"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
 	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset/typed/build/internalversion"
 	buildutil "github.com/openshift/origin/pkg/build/util"
@@ -54,7 +61,10 @@ var (
 	  %[1]s %[2]s backend -c ruby-container
 
 	  # Start streaming of ruby-container logs from pod backend.
-	  %[1]s %[2]s -f pod/backend -c ruby-container`)
+	  %[1]s %[2]s -f pod/backend -c ruby-container
+
+	  # Stream merged, prefix-labeled logs from all current pods of the mysql deployment config.
+	  %[1]s %[2]s -f dc/mysql --all-pods --prefix`)
 )
 
 // OpenShiftLogsOptions holds all the necessary options for running oc logs.
@@ -70,6 +80,20 @@ type OpenShiftLogsOptions struct {
 	// Namespace is a required parameter when accessing the Build object when processing
 	// build logs for Jenkins Pipeline Strategy builds
 	Namespace string
+
+	// KubeClient is used to list and stream the logs of individual pods when
+	// aggregating logs across all pods of a resource.
+	KubeClient kclientset.Interface
+	// AllPods streams the merged logs of every current pod of a deployment config
+	// or daemon set instead of a single pod.
+	AllPods bool
+	// Prefix labels every aggregated log line with the pod and container it came from.
+	Prefix bool
+	// IncludeDeployer additionally streams the logs of the deployer (and hook) pods
+	// of the latest deployment when aggregating deployment config logs.
+	IncludeDeployer bool
+	// PodLogOptions holds the per pod log options used for aggregated streaming.
+	PodLogOptions *kapi.PodLogOptions
 }
 
 // NewCmdLogs creates a new logs command that supports OpenShift resources.
@@ -94,6 +118,9 @@ func NewCmdLogs(name, baseName string, f *clientcmd.Factory, out io.Writer) *cob
 	}
 
 	cmd.Flags().Int64("version", 0, "View the logs of a particular build or deployment by version if greater than zero")
+	cmd.Flags().BoolVar(&o.AllPods, "all-pods", o.AllPods, "Stream the merged logs of every current pod of the given deployment config or daemon set")
+	cmd.Flags().BoolVar(&o.Prefix, "prefix", o.Prefix, "Prefix every aggregated log line with [pod/NAME/CONTAINER]. Only used with --all-pods.")
+	cmd.Flags().BoolVar(&o.IncludeDeployer, "include-deployer", o.IncludeDeployer, "Also stream the logs of the deployer and hook pods of the latest deployment. Only used with --all-pods on deployment configs.")
 
 	return cmd
 }
@@ -145,6 +172,11 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command
 	}
 	o.Client = client.Build()
 
+	if o.KubeClient, err = f.ClientSet(); err != nil {
+		return err
+	}
+	o.PodLogOptions = podLogOptions
+
 	version := kcmdutil.GetFlagInt64(cmd, "version")
 	_, resource := meta.UnsafeGuessKindToResource(infos[0].Mapping.GroupVersionKind)
 
@@ -191,6 +223,20 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command
 // Validate runs the upstream validation for the logs command and then it
 // will validate any OpenShift-specific log options.
 func (o OpenShiftLogsOptions) Validate() error {
+	if o.Prefix && !o.AllPods {
+		return errors.New("--prefix can only be used with --all-pods")
+	}
+	if o.IncludeDeployer && !o.AllPods {
+		return errors.New("--include-deployer can only be used with --all-pods")
+	}
+	if o.AllPods {
+		switch o.KubeLogOptions.Object.(type) {
+		case *appsapi.DeploymentConfig, *extensions.DaemonSet:
+		default:
+			return errors.New("--all-pods is only supported for deployment configs and daemon sets")
+		}
+		return nil
+	}
 	if err := o.KubeLogOptions.Validate(); err != nil {
 		return err
 	}
@@ -215,6 +261,9 @@ func (o OpenShiftLogsOptions) Validate() error {
 // RunLog will run the upstream logs command and may use an OpenShift
 // logOptions object.
 func (o OpenShiftLogsOptions) RunLog() error {
+	if o.AllPods {
+		return o.runAggregatedLogs()
+	}
 	if o.Options != nil {
 		// Use our own options object.
 		o.KubeLogOptions.Options = o.Options
@@ -244,3 +293,99 @@ func (o OpenShiftLogsOptions) RunLog() error {
 
 	return nil
 }
+
+// runAggregatedLogs streams the logs of every pod that currently belongs to the
+// requested deployment config or daemon set, merging them onto a single writer.
+// With --prefix every line is labeled with the pod and container it came from.
+func (o OpenShiftLogsOptions) runAggregatedLogs() error {
+	pods := []kapi.Pod{}
+	switch t := o.KubeLogOptions.Object.(type) {
+	case *appsapi.DeploymentConfig:
+		selector := labels.SelectorFromSet(t.Spec.Selector)
+		list, err := o.KubeClient.Core().Pods(o.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		pods = append(pods, list.Items...)
+		if o.IncludeDeployer {
+			deployerSelector := labels.SelectorFromSet(map[string]string{appsapi.DeployerPodForDeploymentLabel: appsutil.LatestDeploymentNameForConfig(t)})
+			deployers, err := o.KubeClient.Core().Pods(o.Namespace).List(metav1.ListOptions{LabelSelector: deployerSelector.String()})
+			if err != nil {
+				return err
+			}
+			pods = append(pods, deployers.Items...)
+		}
+	case *extensions.DaemonSet:
+		selector, err := metav1.LabelSelectorAsSelector(t.Spec.Selector)
+		if err != nil {
+			return err
+		}
+		list, err := o.KubeClient.Core().Pods(o.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		pods = append(pods, list.Items...)
+	default:
+		return errors.New("--all-pods is only supported for deployment configs and daemon sets")
+	}
+	if len(pods) == 0 {
+		return errors.New("no pods found for the provided resource")
+	}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	errCh := make(chan error, len(pods)*2)
+	for i := range pods {
+		pod := &pods[i]
+		containers := []string{}
+		if len(o.PodLogOptions.Container) > 0 {
+			containers = append(containers, o.PodLogOptions.Container)
+		} else {
+			for _, container := range pod.Spec.Containers {
+				containers = append(containers, container.Name)
+			}
+		}
+		for _, container := range containers {
+			wg.Add(1)
+			go func(podName, container string) {
+				defer wg.Done()
+				if err := o.streamContainerLog(podName, container, &lock); err != nil {
+					errCh <- fmt.Errorf("error streaming logs from pod/%s container %q: %v", podName, container, err)
+				}
+			}(pod.Name, container)
+		}
+	}
+	wg.Wait()
+	close(errCh)
+
+	errs := []error{}
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// streamContainerLog copies the log of a single container to the output writer,
+// holding the lock per line so output from concurrent streams does not interleave
+// mid-line.
+func (o OpenShiftLogsOptions) streamContainerLog(podName, container string, lock *sync.Mutex) error {
+	opts := *o.PodLogOptions
+	opts.Container = container
+	stream, err := o.KubeClient.Core().Pods(o.Namespace).GetLogs(podName, &opts).Stream()
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	prefix := ""
+	if o.Prefix {
+		prefix = fmt.Sprintf("[pod/%s/%s] ", podName, container)
+	}
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		lock.Lock()
+		fmt.Fprintf(o.KubeLogOptions.Out, "%s%s\n", prefix, scanner.Text())
+		lock.Unlock()
+	}
+	return scanner.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
@@ -30,6 +32,7 @@ import (
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
 	configcmd "github.com/openshift/origin/pkg/bulk"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
@@ -41,6 +44,7 @@ import (
 	newcmd "github.com/openshift/origin/pkg/oc/generate/cmd"
 	dockerutil "github.com/openshift/origin/pkg/oc/util/docker"
 	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
 	"github.com/openshift/origin/pkg/util"
 )
 
@@ -141,6 +145,10 @@ type ObjectGeneratorOptions struct {
 
 type NewAppOptions struct {
 	*ObjectGeneratorOptions
+
+	// OutputTemplate, if set, causes the generated objects to be emitted as a
+	// parameterized template with the provided name instead of raw objects.
+	OutputTemplate string
 }
 
 //Complete sets all common default options for commands (new-app and new-build)
@@ -260,6 +268,7 @@ func NewCmdNewApplication(name, baseName string, f *clientcmd.Factory, in io.Rea
 	cmd.Flags().BoolVar(&config.AllowSecretUse, "grant-install-rights", false, "If true, a component that requires access to your account may use your token to install software into your project. Only grant images you trust the right to run with your token.")
 	cmd.Flags().StringVar(&config.SourceSecret, "source-secret", "", "The name of an existing secret that should be used for cloning a private git repository.")
 	cmd.Flags().BoolVar(&config.SkipGeneration, "no-install", false, "Do not attempt to run images that describe themselves as being installable")
+	cmd.Flags().StringVar(&o.OutputTemplate, "output-template", "", "If set, emit the generated objects as a parameterized template with the provided name. Use with -o yaml or -o json.")
 
 	o.Action.BindForOutput(cmd.Flags(), "template")
 	cmd.Flags().String("output-version", "", "The preferred API versions of the output objects")
@@ -327,6 +336,17 @@ func (o *NewAppOptions) RunNewApp() error {
 		return err
 	}
 
+	if len(o.OutputTemplate) > 0 {
+		if !o.Action.ShouldPrint() {
+			return fmt.Errorf("--output-template requires an output format, pass -o yaml or -o json")
+		}
+		template, err := asOutputTemplate(result, o.OutputTemplate)
+		if err != nil {
+			return err
+		}
+		return o.PrintObject(template)
+	}
+
 	if o.Action.ShouldPrint() {
 		return o.PrintObject(result.List)
 	}
@@ -680,6 +700,129 @@ func hasLabel(labels map[string]string, result *newcmd.AppResult) (bool, error)
 	return false, nil
 }
 
+var invalidParameterNameChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// parameterizeName turns an arbitrary identifier into a valid template
+// parameter name.
+func parameterizeName(name string) string {
+	return invalidParameterNameChars.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+// asOutputTemplate converts the generated objects into a parameterized
+// template. The application name, container images that are not driven by
+// image change triggers, and literal environment variable values become
+// template parameters so the output can be committed and reused across
+// environments.
+func asOutputTemplate(result *newcmd.AppResult, name string) (*templateapi.Template, error) {
+	template := &templateapi.Template{}
+	template.Name = name
+	template.Objects = result.List.Items
+
+	params := map[string]*templateapi.Parameter{}
+	addParam := func(suggested, value, description string) string {
+		paramName := parameterizeName(suggested)
+		for i := 1; ; i++ {
+			existing, ok := params[paramName]
+			if !ok || existing.Value == value {
+				break
+			}
+			paramName = fmt.Sprintf("%s_%d", parameterizeName(suggested), i)
+		}
+		if _, ok := params[paramName]; !ok {
+			params[paramName] = &templateapi.Parameter{
+				Name:        paramName,
+				Description: description,
+				Value:       value,
+			}
+		}
+		return fmt.Sprintf("${%s}", paramName)
+	}
+
+	parameterizeEnv := func(env []kapi.EnvVar) {
+		for i := range env {
+			if env[i].ValueFrom != nil || len(env[i].Value) == 0 {
+				continue
+			}
+			env[i].Value = addParam(env[i].Name, env[i].Value, fmt.Sprintf("The value of the %s environment variable.", env[i].Name))
+		}
+	}
+
+	if len(result.Name) > 0 {
+		ref := addParam("NAME", result.Name, "The name assigned to all of the application objects.")
+		for _, item := range result.List.Items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return nil, err
+			}
+			if accessor.GetName() == result.Name {
+				accessor.SetName(ref)
+			}
+			labels := accessor.GetLabels()
+			for k, v := range labels {
+				if v == result.Name {
+					labels[k] = ref
+				}
+			}
+			accessor.SetLabels(labels)
+			switch t := item.(type) {
+			case *appsapi.DeploymentConfig:
+				for k, v := range t.Spec.Selector {
+					if v == result.Name {
+						t.Spec.Selector[k] = ref
+					}
+				}
+			case *kapi.Service:
+				for k, v := range t.Spec.Selector {
+					if v == result.Name {
+						t.Spec.Selector[k] = ref
+					}
+				}
+			}
+		}
+	}
+
+	for _, item := range result.List.Items {
+		switch t := item.(type) {
+		case *appsapi.DeploymentConfig:
+			triggered := sets.NewString()
+			for _, trigger := range t.Spec.Triggers {
+				if trigger.Type == appsapi.DeploymentTriggerOnImageChange && trigger.ImageChangeParams != nil {
+					triggered.Insert(trigger.ImageChangeParams.ContainerNames...)
+				}
+			}
+			if t.Spec.Template == nil {
+				continue
+			}
+			for i := range t.Spec.Template.Spec.Containers {
+				c := &t.Spec.Template.Spec.Containers[i]
+				if len(c.Image) > 0 && !triggered.Has(c.Name) {
+					c.Image = addParam(fmt.Sprintf("%s_IMAGE", c.Name), c.Image, fmt.Sprintf("The image used by the %s container.", c.Name))
+				}
+				parameterizeEnv(c.Env)
+			}
+		case *buildapi.BuildConfig:
+			switch {
+			case t.Spec.Strategy.DockerStrategy != nil:
+				parameterizeEnv(t.Spec.Strategy.DockerStrategy.Env)
+			case t.Spec.Strategy.SourceStrategy != nil:
+				parameterizeEnv(t.Spec.Strategy.SourceStrategy.Env)
+			case t.Spec.Strategy.JenkinsPipelineStrategy != nil:
+				parameterizeEnv(t.Spec.Strategy.JenkinsPipelineStrategy.Env)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for paramName := range params {
+		names = append(names, paramName)
+	}
+	sort.Strings(names)
+	for _, paramName := range names {
+		template.Parameters = append(template.Parameters, *params[paramName])
+	}
+	return template, nil
+}
+
 // isInvalidTriggerError returns true if the given error is
 // a validation error that contains 'invalid trigger type' in its
 // error message. This error is returned from older servers that
@@ -8,7 +8,10 @@ import (
 
 	"github.com/openshift/origin/pkg/oc/generate/app"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
 
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	configcmd "github.com/openshift/origin/pkg/bulk"
 	imageapi "github.com/openshift/origin/pkg/image/apis/image"
 	imagefake "github.com/openshift/origin/pkg/image/generated/internalclientset/fake"
@@ -480,3 +483,130 @@ func fakeImagestreamList() *imageapi.ImageStreamList {
 		},
 	}
 }
+
+func TestAsOutputTemplate(t *testing.T) {
+	result := &newcmd.AppResult{
+		Name: "myapp",
+		List: &kapi.List{
+			Items: []runtime.Object{
+				&appsapi.DeploymentConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "myapp",
+						Labels: map[string]string{"app": "myapp"},
+					},
+					Spec: appsapi.DeploymentConfigSpec{
+						Selector: map[string]string{"deploymentconfig": "myapp"},
+						Template: &kapi.PodTemplateSpec{
+							Spec: kapi.PodSpec{
+								Containers: []kapi.Container{
+									{
+										Name:  "myapp",
+										Image: "mysql:5.7",
+										Env: []kapi.EnvVar{
+											{Name: "MYSQL_USER", Value: "user"},
+											{Name: "FROM_FIELD", ValueFrom: &kapi.EnvVarSource{}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&kapi.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "myapp"},
+					Spec: kapi.ServiceSpec{
+						Selector: map[string]string{"deploymentconfig": "myapp"},
+					},
+				},
+			},
+		},
+	}
+
+	template, err := asOutputTemplate(result, "exported")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if template.Name != "exported" {
+		t.Errorf("expected template name %q, got %q", "exported", template.Name)
+	}
+
+	params := map[string]string{}
+	for _, p := range template.Parameters {
+		params[p.Name] = p.Value
+	}
+	if params["NAME"] != "myapp" {
+		t.Errorf("expected a NAME parameter with value myapp, got %#v", params)
+	}
+	if params["MYAPP_IMAGE"] != "mysql:5.7" {
+		t.Errorf("expected a MYAPP_IMAGE parameter with value mysql:5.7, got %#v", params)
+	}
+	if params["MYSQL_USER"] != "user" {
+		t.Errorf("expected a MYSQL_USER parameter with value user, got %#v", params)
+	}
+	if _, ok := params["FROM_FIELD"]; ok {
+		t.Errorf("environment variables with a valueFrom should not be parameterized")
+	}
+
+	dc := template.Objects[0].(*appsapi.DeploymentConfig)
+	if dc.Name != "${NAME}" {
+		t.Errorf("expected the object name to reference the NAME parameter, got %q", dc.Name)
+	}
+	if dc.Labels["app"] != "${NAME}" {
+		t.Errorf("expected the app label to reference the NAME parameter, got %q", dc.Labels["app"])
+	}
+	container := dc.Spec.Template.Spec.Containers[0]
+	if container.Image != "${MYAPP_IMAGE}" {
+		t.Errorf("expected the container image to reference the image parameter, got %q", container.Image)
+	}
+	if container.Env[0].Value != "${MYSQL_USER}" {
+		t.Errorf("expected the env value to reference the parameter, got %q", container.Env[0].Value)
+	}
+	svc := template.Objects[1].(*kapi.Service)
+	if svc.Spec.Selector["deploymentconfig"] != "${NAME}" {
+		t.Errorf("expected the service selector to reference the NAME parameter, got %q", svc.Spec.Selector["deploymentconfig"])
+	}
+}
+
+func TestAsOutputTemplateSkipsTriggeredImages(t *testing.T) {
+	result := &newcmd.AppResult{
+		Name: "myapp",
+		List: &kapi.List{
+			Items: []runtime.Object{
+				&appsapi.DeploymentConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "myapp"},
+					Spec: appsapi.DeploymentConfigSpec{
+						Triggers: []appsapi.DeploymentTriggerPolicy{
+							{
+								Type: appsapi.DeploymentTriggerOnImageChange,
+								ImageChangeParams: &appsapi.DeploymentTriggerImageChangeParams{
+									ContainerNames: []string{"myapp"},
+								},
+							},
+						},
+						Template: &kapi.PodTemplateSpec{
+							Spec: kapi.PodSpec{
+								Containers: []kapi.Container{
+									{Name: "myapp", Image: "myapp:latest"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	template, err := asOutputTemplate(result, "exported")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range template.Parameters {
+		if p.Name == "MYAPP_IMAGE" {
+			t.Errorf("images resolved by image change triggers should not be parameterized")
+		}
+	}
+	dc := template.Objects[0].(*appsapi.DeploymentConfig)
+	if dc.Spec.Template.Spec.Containers[0].Image != "myapp:latest" {
+		t.Errorf("expected the triggered container image to be unchanged, got %q", dc.Spec.Template.Spec.Containers[0].Image)
+	}
+}
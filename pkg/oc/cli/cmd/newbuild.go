@@ -51,6 +51,9 @@ var (
 	  # Create a build config using a Dockerfile specified as an argument
 	  %[1]s %[2]s -D $'FROM centos:7\nRUN yum install -y httpd'
 
+	  # Create a pipeline build config that uses a Jenkinsfile from a subdirectory of the repository
+	  %[1]s %[2]s https://github.com/openshift/ruby-hello-world --pipeline-file=ci/Jenkinsfile
+
 	  # Create a build config from a remote repository and add custom environment variables
 	  %[1]s %[2]s https://github.com/openshift/ruby-hello-world -e RACK_ENV=development
 
@@ -128,6 +131,7 @@ func NewCmdNewBuild(name, baseName string, f *clientcmd.Factory, in io.Reader, o
 	cmd.MarkFlagFilename("env-file")
 	cmd.Flags().Var(&config.Strategy, "strategy", "Specify the build strategy to use if you don't want to detect (docker|pipeline|source).")
 	cmd.Flags().StringVarP(&config.Dockerfile, "dockerfile", "D", "", "Specify the contents of a Dockerfile to build directly, implies --strategy=docker. Pass '-' to read from STDIN.")
+	cmd.Flags().StringVar(&config.PipelineFilePath, "pipeline-file", "", "Relative path within the source repository to a Jenkinsfile to use as the pipeline definition, implies --strategy=pipeline.")
 	cmd.Flags().StringArrayVar(&config.BuildArgs, "build-arg", config.BuildArgs, "Specify a key-value pair to pass to Docker during the build.")
 	cmd.Flags().BoolVar(&config.BinaryBuild, "binary", false, "Instead of expecting a source URL, set the build to expect binary contents. Will disable triggers.")
 	cmd.Flags().StringP("labels", "l", "", "Label to set in all generated resources.")
@@ -149,6 +149,11 @@ var (
 		complex your integration, the more important it is to record enough data in the
 		remote system that you can identify when resources on either side are deleted.
 
+		If your commands must not run twice for the same object revision across restarts,
+		use --state-file to persist the resource version of each processed object between
+		runs. Events whose resource version is already recorded in the file are skipped
+		when the command starts again.
+
 		Experimental: This command is under active development and may change without notice.`)
 
 	observeExample = templates.Examples(`
@@ -212,6 +217,8 @@ func NewCmdObserve(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().BoolVar(&options.once, "once", false, "If true, exit with a status code 0 after all current objects have been processed.")
 	cmd.Flags().DurationVar(&options.exitAfterPeriod, "exit-after", 0, "Exit with status code 0 after the provided duration, optional.")
 	cmd.Flags().DurationVar(&options.resyncPeriod, "resync-period", 0, "When non-zero, periodically reprocess every item from the server as a Sync event. Use to ensure external systems are kept up to date.")
+	cmd.Flags().StringVar(&options.stateFile, "state-file", "", "Path to a file where observe records the resource version of each processed object. On restart, objects recorded in the file are not reprocessed unless they have changed.")
+	cmd.Flags().DurationVar(&options.dedupWindow, "names-only-dedup-window", 0, "When non-zero, skip reprocessing an object that was processed within this window, keyed by name only. Use when commands are idempotent and only membership changes matter.")
 	cmd.Flags().BoolVar(&options.printMetricsOnExit, "print-metrics-on-exit", false, "If true, on exit write all metrics to stdout.")
 	cmd.Flags().StringVar(&options.listenAddr, "listen-addr", options.listenAddr, "The name of an interface to listen on to expose metrics and health checking.")
 
@@ -258,6 +265,12 @@ type ObserveOptions struct {
 	resyncPeriod       time.Duration
 	printMetricsOnExit bool
 
+	// persistence and deduplication of processed events
+	stateFile   string
+	dedupWindow time.Duration
+	state       *observeState
+	lastRun     map[string]time.Time
+
 	// control the output of the command
 	templateType    string
 	templates       stringSliceFlag
@@ -343,6 +356,17 @@ func (o *ObserveOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args
 		o.debugOut = out
 	}
 
+	if len(o.stateFile) > 0 {
+		state, err := loadObserveState(o.stateFile)
+		if err != nil {
+			return err
+		}
+		o.state = state
+	}
+	if o.dedupWindow > 0 {
+		o.lastRun = make(map[string]time.Time)
+	}
+
 	o.argumentStore = &objectArgumentsStore{}
 	switch {
 	case len(o.nameSyncCommand) > 0:
@@ -628,6 +652,27 @@ func (o *ObserveOptions) next(deltaType cache.DeltaType, obj runtime.Object, out
 	}
 	resourceVersion := m.GetResourceVersion()
 
+	key := m.GetName()
+	if o.includeNamespace {
+		key = m.GetNamespace() + "/" + m.GetName()
+	}
+	switch {
+	case deltaType == cache.Deleted:
+		// a deleted object may be recreated later with the same name, forget it
+		o.forgetProcessed(key)
+	default:
+		if o.state != nil && o.state.Processed(key, resourceVersion) {
+			glog.V(4).Infof("Skipping %s: resource version %s was already processed", key, resourceVersion)
+			return nil
+		}
+		if o.lastRun != nil {
+			if last, ok := o.lastRun[key]; ok && time.Since(last) < o.dedupWindow {
+				glog.V(4).Infof("Skipping %s: processed %s ago, within the dedup window", key, time.Since(last))
+				return nil
+			}
+		}
+	}
+
 	outType := string(deltaType)
 
 	var args []string
@@ -652,6 +697,9 @@ func (o *ObserveOptions) next(deltaType cache.DeltaType, obj runtime.Object, out
 
 	if len(command) == 0 {
 		fmt.Fprintf(o.debugOut, "# %s %s %s\t%s\n", time.Now().Format(time.RFC3339), outType, resourceVersion, printCommandLine(command, args...))
+		if deltaType != cache.Deleted {
+			return o.markProcessed(key, resourceVersion)
+		}
 		return nil
 	}
 
@@ -681,9 +729,39 @@ func (o *ObserveOptions) next(deltaType cache.DeltaType, obj runtime.Object, out
 		}
 		return o.handleCommandError(err)
 	}
+	if deltaType != cache.Deleted {
+		return o.markProcessed(key, resourceVersion)
+	}
 	return nil
 }
 
+// markProcessed records that the object was handled at its current resource version so
+// repeated or replayed events for it are skipped.
+func (o *ObserveOptions) markProcessed(key, resourceVersion string) error {
+	if o.lastRun != nil {
+		o.lastRun[key] = time.Now()
+	}
+	if o.state == nil {
+		return nil
+	}
+	o.state.Record(key, resourceVersion)
+	return o.state.Save()
+}
+
+// forgetProcessed drops any recorded state for the object.
+func (o *ObserveOptions) forgetProcessed(key string) {
+	if o.lastRun != nil {
+		delete(o.lastRun, key)
+	}
+	if o.state == nil {
+		return
+	}
+	o.state.Forget(key)
+	if err := o.state.Save(); err != nil {
+		glog.Errorf("Unable to save state file: %v", err)
+	}
+}
+
 func (o *ObserveOptions) handleCommandError(err error) error {
 	if err == nil {
 		return nil
@@ -934,6 +1012,69 @@ func objectArgumentsKeyFunc(obj interface{}) (string, error) {
 	return cache.MetaNamespaceKeyFunc(obj)
 }
 
+// observeState persists the resource version of each processed object so that a restarted
+// observe process does not replay events it has already handled.
+type observeState struct {
+	path string
+
+	lock     sync.Mutex
+	versions map[string]string
+}
+
+// loadObserveState reads a previously persisted state file. A missing file is not an error.
+func loadObserveState(path string) (*observeState, error) {
+	s := &observeState{path: path, versions: make(map[string]string)}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if err := json.Unmarshal(data, &s.versions); err != nil {
+		return nil, fmt.Errorf("unable to parse state file %s: %v", path, err)
+	}
+	return s, nil
+}
+
+// Processed returns true if the object was already handled at this resource version.
+func (s *observeState) Processed(key, resourceVersion string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(resourceVersion) > 0 && s.versions[key] == resourceVersion
+}
+
+func (s *observeState) Record(key, resourceVersion string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.versions[key] = resourceVersion
+}
+
+func (s *observeState) Forget(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.versions, key)
+}
+
+// Save writes the state to disk, replacing the file atomically so a crash cannot leave a
+// partially written state behind.
+func (s *observeState) Save() error {
+	s.lock.Lock()
+	data, err := json.Marshal(s.versions)
+	s.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
 type objectArgumentsStore struct {
 	keyFn func() ([]string, error)
 
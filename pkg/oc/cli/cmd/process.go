@@ -67,6 +67,9 @@ var (
 	  # Convert stored template into resource list by setting/overriding parameter values
 	  %[1]s process foo PARM1=VALUE1 PARM2=VALUE2
 
+	  # Layer parameter files, values in later files overriding values in earlier ones
+	  %[1]s process -f template.json --param-file=base.env --param-file=prod.env
+
 	  # Convert template stored in different namespace into a resource list
 	  %[1]s process openshift//foo
 
@@ -89,9 +92,10 @@ func NewCmdProcess(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 	cmd.Flags().StringP("filename", "f", "", "Filename or URL to file to read a template")
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
 	cmd.Flags().StringArrayP("param", "p", nil, "Specify a key-value pair (eg. -p FOO=BAR) to set/override a parameter value in the template.")
-	cmd.Flags().StringArray("param-file", []string{}, "File containing template parameter values to set/override in the template.")
+	cmd.Flags().StringArray("param-file", []string{}, "File containing template parameter values to set/override in the template. May be specified multiple times; files are layered in the order given, with values in later files overriding values in earlier ones. Values set with --param or as arguments override all files.")
 	cmd.MarkFlagFilename("param-file")
 	cmd.Flags().Bool("ignore-unknown-parameters", false, "If true, will not stop processing if a provided parameter does not exist in the template.")
+	cmd.Flags().Bool("strict", false, "If true, fail when a provided parameter does not exist in the template or when a template parameter is left without a value, default, or generator.")
 	cmd.Flags().BoolP("local", "", false, "If true process the template locally instead of contacting the server.")
 	cmd.Flags().BoolP("parameters", "", false, "If true, do not process but only print available parameters")
 	cmd.Flags().StringP("labels", "l", "", "Label to set in all resources for this template")
@@ -136,13 +140,14 @@ func RunProcess(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *
 		templateParams = append(templateParams, flagValues...)
 	}
 
+	strict := kcmdutil.GetFlagBool(cmd, "strict")
+	if strict && kcmdutil.GetFlagBool(cmd, "ignore-unknown-parameters") {
+		return kcmdutil.UsageErrorf(cmd, "--strict may not be combined with --ignore-unknown-parameters")
+	}
+
 	duplicatedKeys := sets.NewString()
-	params, paramErr := app.ParseAndCombineEnvironment(templateParams, getFlagStringArray(cmd, "param-file"), in, func(key, file string) error {
-		if file == "" {
-			duplicatedKeys.Insert(key)
-		} else {
-			fmt.Fprintf(errout, "warning: Template parameter %q already defined, ignoring value from file %q", key, file)
-		}
+	params, paramErr := app.ParseAndLayerEnvironment(templateParams, getFlagStringArray(cmd, "param-file"), in, func(key string) error {
+		duplicatedKeys.Insert(key)
 		return nil
 	})
 	if len(duplicatedKeys) != 0 {
@@ -276,6 +281,11 @@ func RunProcess(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *
 	if errs := injectUserVars(params, obj, kcmdutil.GetFlagBool(cmd, "ignore-unknown-parameters")); errs != nil {
 		return kerrors.NewAggregate(errs)
 	}
+	if strict {
+		if errs := checkMissingParameterValues(params, obj); errs != nil {
+			return kerrors.NewAggregate(errs)
+		}
+	}
 
 	resultObj := obj
 	if local {
@@ -367,6 +377,24 @@ func injectUserVars(values app.Environment, t *templateapi.Template, ignoreUnkno
 	return errors
 }
 
+// checkMissingParameterValues returns an error for every template parameter that the
+// user did not provide and that has no value, default generator, or value source of
+// its own. It is used by --strict to surface incomplete parameterization before the
+// template is processed.
+func checkMissingParameterValues(values app.Environment, t *templateapi.Template) []error {
+	var errors []error
+	for i := range t.Parameters {
+		p := &t.Parameters[i]
+		if _, provided := values[p.Name]; provided {
+			continue
+		}
+		if len(p.Value) == 0 && len(p.Generate) == 0 && p.ValueFrom == nil {
+			errors = append(errors, fmt.Errorf("parameter %q has no value and no default or generator\n", p.Name))
+		}
+	}
+	return errors
+}
+
 // processTemplateLocally applies the same logic that a remote call would make but makes no
 // connection to the server.
 func processTemplateLocally(tpl *templateapi.Template) error {
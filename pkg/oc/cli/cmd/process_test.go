@@ -41,3 +41,27 @@ func TestInjectUserVars(t *testing.T) {
 			"parameter_foo_bar_2", "value_foo_bar_2", template.Parameters[1].Name, template.Parameters[1].Value)
 	}
 }
+
+func TestCheckMissingParameterValues(t *testing.T) {
+	template := &templateapi.Template{
+		Parameters: []templateapi.Parameter{
+			{Name: "parameter_with_default", Value: "default"},
+			{Name: "parameter_with_generator", Generate: "expression", From: "[a-z]{8}"},
+			{Name: "parameter_provided"},
+			{Name: "parameter_missing"}},
+	}
+	testParam := map[string]string{
+		"parameter_provided": "value_provided",
+	}
+
+	errors := checkMissingParameterValues(testParam, template)
+	if len(errors) != 1 {
+		for index, err := range errors {
+			fmt.Printf("errors[%d] : %v\n", index, err)
+		}
+		t.Fatalf("expect a single missing parameter error, got %d", len(errors))
+	}
+	if expected := `parameter "parameter_missing" has no value and no default or generator` + "\n"; errors[0].Error() != expected {
+		t.Errorf("expect error %q, got %q", expected, errors[0].Error())
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
@@ -36,7 +38,10 @@ var (
 	%[1]s rollout latest dc/nginx
 
 	# Print the rolled out deployment config
-	%[1]s rollout latest dc/nginx -o json`)
+	%[1]s rollout latest dc/nginx -o json
+
+	# Record why the rollout was started and block until it finishes
+	%[1]s rollout latest dc/nginx --message="pick up hotfix image" --wait`)
 )
 
 // RolloutLatestOptions holds all the options for the `rollout latest` command.
@@ -45,10 +50,12 @@ type RolloutLatestOptions struct {
 	typer  runtime.ObjectTyper
 	infos  []*resource.Info
 
-	DryRun bool
-	out    io.Writer
-	output string
-	again  bool
+	DryRun  bool
+	out     io.Writer
+	output  string
+	again   bool
+	wait    bool
+	message string
 
 	appsClient      appsclientinternal.DeploymentConfigsGetter
 	kc              kclientset.Interface
@@ -86,6 +93,8 @@ func NewCmdRolloutLatest(fullName string, f *clientcmd.Factory, out io.Writer) *
 	kcmdutil.AddPrinterFlags(cmd)
 	kcmdutil.AddDryRunFlag(cmd)
 	cmd.Flags().Bool("again", false, "If true, deploy the current pod template without updating state from triggers")
+	cmd.Flags().Bool("wait", false, "If true, wait for the new rollout to complete before returning. The command exits with a nonzero code if the rollout fails.")
+	cmd.Flags().String("message", "", "A human readable message describing why the rollout was started, recorded on the new deployment")
 
 	return cmd
 }
@@ -127,6 +136,8 @@ func (o *RolloutLatestOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command
 	o.out = out
 	o.output = kcmdutil.GetFlagString(cmd, "output")
 	o.again = kcmdutil.GetFlagBool(cmd, "again")
+	o.wait = kcmdutil.GetFlagBool(cmd, "wait")
+	o.message = kcmdutil.GetFlagString(cmd, "message")
 
 	if o.output != "revision" {
 		o.printObj = func(obj runtime.Object, mapping *meta.RESTMapping, out io.Writer) error {
@@ -146,6 +157,9 @@ func (o RolloutLatestOptions) Validate() error {
 	if len(o.infos) != 1 {
 		return errors.New("a deployment config name is required.")
 	}
+	if o.DryRun && (o.wait || len(o.message) > 0) {
+		return errors.New("--wait and --message cannot be used with --dry-run.")
+	}
 	return nil
 }
 
@@ -199,13 +213,78 @@ func (o RolloutLatestOptions) RunRolloutLatest() error {
 		info.Refresh(dc, true)
 	}
 
-	if o.output == "revision" {
+	if !o.DryRun && len(o.message) > 0 {
+		if err := o.recordCauseMessage(dc); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case o.output == "revision":
 		fmt.Fprintf(o.out, fmt.Sprintf("%d", dc.Status.LatestVersion))
-		return nil
-	} else if len(o.output) > 0 {
-		return o.printObj(dc, info.Mapping, o.out)
+	case len(o.output) > 0:
+		if err := o.printObj(dc, info.Mapping, o.out); err != nil {
+			return err
+		}
+	default:
+		kcmdutil.PrintSuccess(o.mapper, o.output == "name", o.out, info.Mapping.Resource, info.Name, o.DryRun, "rolled out")
 	}
 
-	kcmdutil.PrintSuccess(o.mapper, o.output == "name", o.out, info.Mapping.Resource, info.Name, o.DryRun, "rolled out")
+	if !o.DryRun && o.wait {
+		return o.waitForRollout(dc)
+	}
 	return nil
 }
+
+// recordCauseMessage annotates the new deployment with the user-supplied cause message. The
+// deployment may not exist yet when the instantiation returns, so poll for it briefly.
+func (o RolloutLatestOptions) recordCauseMessage(config *appsapi.DeploymentConfig) error {
+	deploymentName := appsutil.LatestDeploymentNameForConfig(config)
+	return wait.PollImmediate(time.Second, time.Minute, func() (bool, error) {
+		deployment, err := o.kc.Core().ReplicationControllers(config.Namespace).Get(deploymentName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		deployment.Annotations[appsapi.DeploymentCauseMessageAnnotation] = o.message
+		_, err = o.kc.Core().ReplicationControllers(config.Namespace).Update(deployment)
+		if kerrors.IsConflict(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// waitForRollout blocks until the new deployment reaches a terminal state and returns an
+// error if it did not complete successfully.
+func (o RolloutLatestOptions) waitForRollout(config *appsapi.DeploymentConfig) error {
+	deploymentName := appsutil.LatestDeploymentNameForConfig(config)
+	return wait.PollImmediate(time.Second, 20*time.Minute, func() (bool, error) {
+		deployment, err := o.kc.Core().ReplicationControllers(config.Namespace).Get(deploymentName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch appsutil.DeploymentStatusFor(deployment) {
+		case appsapi.DeploymentStatusComplete:
+			return true, nil
+		case appsapi.DeploymentStatusFailed:
+			reason := appsutil.DeploymentStatusReasonFor(deployment)
+			if len(reason) == 0 {
+				reason = "unknown reason"
+			}
+			return false, fmt.Errorf("rollout #%d failed: %s", config.Status.LatestVersion, reason)
+		}
+		return false, nil
+	})
+}
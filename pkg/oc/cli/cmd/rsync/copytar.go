@@ -2,6 +2,7 @@ package rsync
 
 import (
 	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +31,8 @@ import (
 type tarStrategy struct {
 	Quiet          bool
 	Delete         bool
+	Compress       bool
+	Bwlimit        int
 	Tar            tar.Tar
 	RemoteExecutor executor
 	IgnoredFlags   []string
@@ -50,6 +53,8 @@ func newTarStrategy(f *clientcmd.Factory, c *cobra.Command, o *RsyncOptions) (co
 	return &tarStrategy{
 		Quiet:          o.Quiet,
 		Delete:         o.Delete,
+		Compress:       o.Compress,
+		Bwlimit:        o.Bwlimit,
 		Tar:            tarHelper,
 		RemoteExecutor: remoteExec,
 		IgnoredFlags:   ignoredFlags,
@@ -137,14 +142,20 @@ func (r *tarStrategy) Copy(source, destination *pathSpec, out, errOut io.Writer)
 	// Create tar
 	if source.Local() {
 		glog.V(4).Infof("Creating local tar file %s from local path %s", tmp.Name(), source.Path)
-		err = tarLocal(r.Tar, source.Path, tmp)
+		err = tarLocal(r.Tar, source.Path, tmp, r.Compress)
 		if err != nil {
 			return fmt.Errorf("error creating local tar of source directory: %v", err)
 		}
 	} else {
 		glog.V(4).Infof("Creating local tar file %s from remote path %s", tmp.Name(), source.Path)
+		// the remote tar output is the download leg of the transfer, so apply the
+		// bandwidth limit to it
+		var tmpWriter io.Writer = tmp
+		if r.Bwlimit > 0 {
+			tmpWriter = &rateLimitedWriter{writer: tmpWriter, limit: int64(r.Bwlimit) * 1024}
+		}
 		errBuf := &bytes.Buffer{}
-		err = tarRemote(r.RemoteExecutor, source.Path, tmp, errBuf)
+		err = tarRemote(r.RemoteExecutor, source.Path, r.Compress, tmpWriter, errBuf)
 		if err != nil {
 			if checkTar(r.RemoteExecutor) != nil {
 				return strategySetupError("tar not available in container")
@@ -167,11 +178,17 @@ func (r *tarStrategy) Copy(source, destination *pathSpec, out, errOut io.Writer)
 	// Extract tar
 	if destination.Local() {
 		glog.V(4).Infof("Untarring temp file %s to local directory %s", tmp.Name(), destination.Path)
-		err = untarLocal(r.Tar, destination.Path, tmp, r.Quiet, out)
+		err = untarLocal(r.Tar, destination.Path, tmp, r.Compress, r.Quiet, out)
 	} else {
 		glog.V(4).Infof("Untarring temp file %s to remote directory %s", tmp.Name(), destination.Path)
+		// streaming the tar to the remote shell is the upload leg of the transfer,
+		// so apply the bandwidth limit to it
+		var tmpReader io.Reader = tmp
+		if r.Bwlimit > 0 {
+			tmpReader = &rateLimitedReader{reader: tmpReader, limit: int64(r.Bwlimit) * 1024}
+		}
 		errBuf := &bytes.Buffer{}
-		err = untarRemote(r.RemoteExecutor, destination.Path, r.Quiet, tmp, out, errBuf)
+		err = untarRemote(r.RemoteExecutor, destination.Path, r.Compress, r.Quiet, tmpReader, out, errBuf)
 		if err != nil {
 			if checkTar(r.RemoteExecutor) != nil {
 				return strategySetupError("tar not available in container")
@@ -203,19 +220,23 @@ func (r *tarStrategy) String() string {
 	return "tar"
 }
 
-func tarRemote(exec executor, sourceDir string, out, errOut io.Writer) error {
+func tarRemote(exec executor, sourceDir string, compress bool, out, errOut io.Writer) error {
 	glog.V(4).Infof("Tarring %s remotely", sourceDir)
+	createFlags := "-c"
+	if compress {
+		createFlags += "z"
+	}
 	var cmd []string
 	if strings.HasSuffix(sourceDir, "/") {
-		cmd = []string{"tar", "-C", sourceDir, "-c", "."}
+		cmd = []string{"tar", "-C", sourceDir, createFlags, "."}
 	} else {
-		cmd = []string{"tar", "-C", path.Dir(sourceDir), "-c", path.Base(sourceDir)}
+		cmd = []string{"tar", "-C", path.Dir(sourceDir), createFlags, path.Base(sourceDir)}
 	}
 	glog.V(4).Infof("Remote tar command: %s", strings.Join(cmd, " "))
 	return exec.Execute(cmd, nil, out, errOut)
 }
 
-func tarLocal(tar tar.Tar, sourceDir string, w io.Writer) error {
+func tarLocal(tar tar.Tar, sourceDir string, w io.Writer, compress bool) error {
 	glog.V(4).Infof("Tarring %s locally", sourceDir)
 	// includeParent mimics rsync's behavior. When the source path ends in a path
 	// separator, then only the contents of the directory are copied. Otherwise,
@@ -225,19 +246,38 @@ func tarLocal(tar tar.Tar, sourceDir string, w io.Writer) error {
 		includeParent = false
 		sourceDir = sourceDir[:len(sourceDir)-1]
 	}
+	if compress {
+		gw := gzip.NewWriter(w)
+		if err := tar.CreateTarStream(sourceDir, includeParent, gw); err != nil {
+			return err
+		}
+		return gw.Close()
+	}
 	return tar.CreateTarStream(sourceDir, includeParent, w)
 }
 
-func untarLocal(tar tar.Tar, destinationDir string, r io.Reader, quiet bool, logger io.Writer) error {
+func untarLocal(tar tar.Tar, destinationDir string, r io.Reader, compress bool, quiet bool, logger io.Writer) error {
 	glog.V(4).Infof("Extracting tar locally to %s", destinationDir)
+	if compress {
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("error reading gzipped tar stream: %v", err)
+		}
+		defer gr.Close()
+		r = gr
+	}
 	if quiet {
 		return tar.ExtractTarStream(destinationDir, r)
 	}
 	return tar.ExtractTarStreamWithLogging(destinationDir, r, logger)
 }
 
-func untarRemote(exec executor, destinationDir string, quiet bool, in io.Reader, out, errOut io.Writer) error {
-	cmd := []string{"tar", "-C", destinationDir, "-ox"}
+func untarRemote(exec executor, destinationDir string, compress bool, quiet bool, in io.Reader, out, errOut io.Writer) error {
+	extractFlags := "-ox"
+	if compress {
+		extractFlags += "z"
+	}
+	cmd := []string{"tar", "-C", destinationDir, extractFlags}
 	if !quiet {
 		cmd = append(cmd, "-v")
 	}
@@ -79,6 +79,8 @@ type RsyncOptions struct {
 	Quiet             bool
 	Delete            bool
 	Watch             bool
+	Compress          bool
+	Bwlimit           int
 	SuggestedCmdUsage string
 
 	RsyncInclude  []string
@@ -122,6 +124,8 @@ func NewCmdRsync(name, parent string, f *clientcmd.Factory, out, errOut io.Write
 	cmd.Flags().BoolVar(&o.RsyncProgress, "progress", false, "If true, show progress during transfer")
 	cmd.Flags().BoolVar(&o.RsyncNoPerms, "no-perms", false, "If true, do not transfer permissions")
 	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "Watch directory for changes and resync automatically")
+	cmd.Flags().BoolVarP(&o.Compress, "compress", "z", false, "If true, compress file data during the transfer")
+	cmd.Flags().IntVar(&o.Bwlimit, "bwlimit", 0, "Limit the transfer bandwidth in KB/s; 0 means no limit")
 	return cmd
 }
 
@@ -255,6 +259,9 @@ func (o *RsyncOptions) Validate() error {
 	if o.Destination.Local() && o.Watch {
 		return errors.New("\"--watch\" can only be used with a local source directory")
 	}
+	if o.Bwlimit < 0 {
+		return errors.New("\"--bwlimit\" must be a positive number of KB/s")
+	}
 	if err := o.Strategy.Validate(); err != nil {
 		return err
 	}
@@ -3,8 +3,10 @@ package rsync
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os/exec"
 	"runtime"
+	"time"
 
 	"github.com/golang/glog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,6 +83,12 @@ func rsyncFlagsFromOptions(o *RsyncOptions) []string {
 	if o.RsyncNoPerms {
 		flags = append(flags, "--no-perms")
 	}
+	if o.Compress {
+		flags = append(flags, "-z")
+	}
+	if o.Bwlimit > 0 {
+		flags = append(flags, fmt.Sprintf("--bwlimit=%d", o.Bwlimit))
+	}
 	return flags
 }
 
@@ -101,6 +109,54 @@ func rsyncSpecificFlags(o *RsyncOptions) []string {
 	return flags
 }
 
+// rateLimitedReader throttles reads to roughly limit bytes per second. It is used
+// by the tar strategy to honor --bwlimit, which rsync implements natively.
+type rateLimitedReader struct {
+	reader io.Reader
+	limit  int64
+
+	start time.Time
+	read  int64
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	if r.start.IsZero() {
+		r.start = time.Now()
+	}
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	throttle(r.start, r.read, r.limit)
+	return n, err
+}
+
+// rateLimitedWriter throttles writes to roughly limit bytes per second.
+type rateLimitedWriter struct {
+	writer io.Writer
+	limit  int64
+
+	start   time.Time
+	written int64
+}
+
+func (w *rateLimitedWriter) Write(p []byte) (int, error) {
+	if w.start.IsZero() {
+		w.start = time.Now()
+	}
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	throttle(w.start, w.written, w.limit)
+	return n, err
+}
+
+// throttle sleeps until the average rate since start drops to at most limit bytes
+// per second.
+func throttle(start time.Time, transferred, limit int64) {
+	expected := time.Duration(float64(transferred) / float64(limit) * float64(time.Second))
+	if elapsed := time.Since(start); elapsed < expected {
+		time.Sleep(expected - elapsed)
+	}
+}
+
 type podAPIChecker struct {
 	client    kclientset.Interface
 	namespace string
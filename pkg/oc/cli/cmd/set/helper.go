@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/kubectl"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 )
@@ -158,6 +159,22 @@ func CalculatePatches(infos []*resource.Info, encoder runtime.Encoder, mutateFn
 			continue
 		}
 
+		// read the last recorded configuration before it is replaced so it can serve as the
+		// common ancestor for a three-way merge
+		original, err := kubectl.GetOriginalConfiguration(info.Mapping, info.Object)
+		if err != nil {
+			patch.Err = err
+			continue
+		}
+		// refresh the recorded baseline only when one already exists: kubectl apply
+		// owns the annotation, and stamping it onto objects that were never applied
+		// would give apply a fabricated ancestor for its three-way merge
+		if len(original) > 0 {
+			if patch.Err = kubectl.CreateApplyAnnotation(info, versionedEncoder); patch.Err != nil {
+				continue
+			}
+		}
+
 		patch.After, patch.Err = runtime.Encode(versionedEncoder, info.Object)
 		if patch.Err != nil {
 			continue
@@ -170,7 +187,14 @@ func CalculatePatches(infos []*resource.Info, encoder runtime.Encoder, mutateFn
 			continue
 		}
 
-		patch.Patch, patch.Err = strategicpatch.CreateTwoWayMergePatch(patch.Before, patch.After, versioned)
+		// when a last-applied configuration was previously recorded, compute a three-way merge
+		// so fields written by controllers since then (e.g. lastTriggeredImage) are left alone
+		// instead of being reverted to the stale client copy
+		if len(original) > 0 {
+			patch.Patch, patch.Err = strategicpatch.CreateThreeWayMergePatch(original, patch.After, patch.Before, versioned, true)
+		} else {
+			patch.Patch, patch.Err = strategicpatch.CreateTwoWayMergePatch(patch.Before, patch.After, versioned)
+		}
 	}
 	return patches
 }
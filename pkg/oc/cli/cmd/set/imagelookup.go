@@ -55,6 +55,12 @@ var (
 
 		Which should trigger a deployment pointing to the imported mysql:v1 tag.
 
+		Image lookup may also be enabled for an entire namespace, which causes every new workload
+		created in that namespace - including jobs, cron jobs, stateful sets, and daemon sets - to
+		resolve images against image streams without each object carrying the annotation:
+
+				$ %[1]s image-lookup namespace/myproject
+
 		Experimental: This feature is under active development and may change without notice.`)
 
 	imageLookupExample = templates.Examples(`
@@ -70,6 +76,9 @@ var (
 		# Show the current status of the deployment lookup
 		%[1]s image-lookup deploy/mysql --list
 
+		# Enable local name lookup for all new workloads in the current project
+		%[1]s image-lookup namespace/myproject
+
 		# Disable local name lookup on image stream mysql
 		%[1]s image-lookup mysql --enabled=false
 
@@ -67,6 +67,9 @@ var (
 	  %[1]s triggers bc/webapp --from-github
 	  %[1]s triggers bc/webapp --from-webhook
 
+	  # Reference the webhook secret from a secret object so it can be rotated centrally
+	  %[1]s triggers bc/webapp --from-github --from-webhook-secret=github-hook
+
 	  # Remove all triggers
 	  %[1]s triggers bc/webapp --remove-all
 
@@ -116,7 +119,10 @@ type TriggersOptions struct {
 	FromWebHookAllowEnv *bool
 	FromGitLab          *bool
 	FromBitbucket       *bool
-	FromImage           string
+	// FromWebHookSecret is the name of a secret the webhook trigger references for its
+	// secret value instead of embedding a generated literal in the spec
+	FromWebHookSecret string
+	FromImage         string
 	// FromImageNamespace is the namespace for the FromImage
 	FromImageNamespace string
 }
@@ -164,6 +170,7 @@ func NewCmdTriggers(fullName string, f *clientcmd.Factory, out, errOut io.Writer
 	options.FromWebHookAllowEnv = cmd.Flags().Bool("from-webhook-allow-env", false, "If true, a generic webhook which can provide environment variables - a secret value will be generated automatically")
 	options.FromGitLab = cmd.Flags().Bool("from-gitlab", false, "If true, a GitLab webhook - a secret value will be generated automatically")
 	options.FromBitbucket = cmd.Flags().Bool("from-bitbucket", false, "If true, a Bitbucket webhook - a secret value will be generated automatically")
+	cmd.Flags().StringVar(&options.FromWebHookSecret, "from-webhook-secret", options.FromWebHookSecret, fmt.Sprintf("The name of a secret in the same namespace the webhook trigger references for its secret value instead of generating one. The secret must contain a %q key.", buildapi.WebHookSecretKey))
 
 	kcmdutil.AddDryRunFlag(cmd)
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
@@ -287,6 +294,10 @@ func (o *TriggersOptions) Validate() error {
 		return fmt.Errorf("you may only set one trigger type at a time")
 	case count == 0 && !o.Remove && !o.RemoveAll && !o.Auto && !o.Manual && !o.PrintTable:
 		return fmt.Errorf("specify one of the --from-* flags to add a trigger, --remove to remove, or --auto|--manual to control existing triggers")
+	case len(o.FromWebHookSecret) > 0 && o.FromWebHook == nil && o.FromWebHookAllowEnv == nil && o.FromGitHub == nil && o.FromGitLab == nil && o.FromBitbucket == nil:
+		return fmt.Errorf("--from-webhook-secret may only be used with one of the webhook trigger flags")
+	case len(o.FromWebHookSecret) > 0 && o.Remove:
+		return fmt.Errorf("--from-webhook-secret may not be used with --remove")
 	}
 	return nil
 }
@@ -475,47 +486,36 @@ func (o *TriggersOptions) updateTriggers(triggers *TriggerDefinition) {
 		}
 	}
 	if o.FromWebHook != nil && *o.FromWebHook {
-		secret := app.GenerateSecret(20)
-		triggers.GenericWebHooks = append(triggers.GenericWebHooks,
-			buildapi.WebHookTrigger{
-				Secret:   secret,
-				AllowEnv: false,
-			},
-		)
+		triggers.GenericWebHooks = append(triggers.GenericWebHooks, o.newWebHookTrigger(false))
 	}
 	if o.FromWebHookAllowEnv != nil && *o.FromWebHookAllowEnv {
-		secret := app.GenerateSecret(20)
-		triggers.GenericWebHooks = append(triggers.GenericWebHooks,
-			buildapi.WebHookTrigger{
-				Secret:   secret,
-				AllowEnv: true,
-			},
-		)
+		triggers.GenericWebHooks = append(triggers.GenericWebHooks, o.newWebHookTrigger(true))
 	}
 	if o.FromGitHub != nil && *o.FromGitHub {
-		secret := app.GenerateSecret(20)
-		triggers.GitHubWebHooks = append(triggers.GitHubWebHooks,
-			buildapi.WebHookTrigger{
-				Secret: secret,
-			},
-		)
+		triggers.GitHubWebHooks = append(triggers.GitHubWebHooks, o.newWebHookTrigger(false))
 	}
 	if o.FromGitLab != nil && *o.FromGitLab {
-		secret := app.GenerateSecret(20)
-		triggers.GitLabWebHooks = append(triggers.GitLabWebHooks,
-			buildapi.WebHookTrigger{
-				Secret: secret,
-			},
-		)
+		triggers.GitLabWebHooks = append(triggers.GitLabWebHooks, o.newWebHookTrigger(false))
 	}
 	if o.FromBitbucket != nil && *o.FromBitbucket {
-		secret := app.GenerateSecret(20)
-		triggers.BitbucketWebHooks = append(triggers.BitbucketWebHooks,
-			buildapi.WebHookTrigger{
-				Secret: secret,
-			},
-		)
+		triggers.BitbucketWebHooks = append(triggers.BitbucketWebHooks, o.newWebHookTrigger(false))
+	}
+}
+
+// newWebHookTrigger creates a webhook trigger for the options, referencing the secret
+// named by --from-webhook-secret when set and generating a secret literal otherwise.
+func (o *TriggersOptions) newWebHookTrigger(allowEnv bool) buildapi.WebHookTrigger {
+	trigger := buildapi.WebHookTrigger{
+		AllowEnv: allowEnv,
+	}
+	if len(o.FromWebHookSecret) > 0 {
+		trigger.SecretReference = &buildapi.SecretLocalReference{
+			Name: o.FromWebHookSecret,
+		}
+	} else {
+		trigger.Secret = app.GenerateSecret(20)
 	}
+	return trigger
 }
 
 // ImageChangeTrigger represents the capabilities present in deployment config and build
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 
 	"github.com/golang/glog"
@@ -38,11 +39,13 @@ type TagOptions struct {
 	namespace    string
 
 	referencePolicy string
+	copyAnnotations []string
 
-	ref            imageapi.DockerImageReference
-	sourceKind     string
-	destNamespace  []string
-	destNameAndTag []string
+	ref               imageapi.DockerImageReference
+	sourceKind        string
+	sourceAnnotations map[string]string
+	destNamespace     []string
+	destNameAndTag    []string
 }
 
 var (
@@ -73,6 +76,9 @@ var (
 	  # Tag an external Docker image and request pullthrough for it.
 	  %[1]s tag --source=docker openshift/origin:latest yourproject/ruby:tip --reference-policy=local
 
+	  # Tag an image and copy its scan result annotations to the destination tag.
+	  %[1]s tag yourproject/ruby:tip yourproject/ruby:prod --copy-annotations='scan.example.com/*'
+
 	  # Remove the specified spec tag from an image stream.
 	  %[1]s tag openshift/origin:latest -d`)
 )
@@ -105,6 +111,7 @@ func NewCmdTag(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Comm
 	cmd.Flags().BoolVar(&opts.scheduleTag, "scheduled", false, "Set a Docker image to be periodically imported from a remote repository. Defaults to false.")
 	cmd.Flags().BoolVar(&opts.insecureTag, "insecure", false, "Set to true if importing the specified Docker image requires HTTP or has a self-signed certificate. Defaults to false.")
 	cmd.Flags().StringVar(&opts.referencePolicy, "reference-policy", sourceReferencePolicy, "Allow to request pullthrough for external image when set to 'local'. Defaults to 'source'.")
+	cmd.Flags().StringSliceVar(&opts.copyAnnotations, "copy-annotations", opts.copyAnnotations, "Copy the source tag annotations whose keys match the provided patterns to the destination tag. The destination stream may restrict accepted annotations with a propagation policy annotation. Only valid when the source is an image stream tag.")
 
 	return cmd
 }
@@ -226,6 +233,10 @@ func (o *TagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 			sourceKind = "DockerImage"
 		}
 
+		if len(o.copyAnnotations) > 0 && sourceKind != "ImageStreamTag" {
+			return fmt.Errorf("--copy-annotations is only supported when the source is an image stream tag")
+		}
+
 		// if we are not aliasing the tag, specify the exact value to copy
 		if sourceKind == "ImageStreamTag" && !o.aliasTag {
 			srcNamespace := ref.Namespace
@@ -236,6 +247,11 @@ func (o *TagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 			if err != nil {
 				return err
 			}
+			if len(o.copyAnnotations) > 0 {
+				if tagRef, ok := is.Spec.Tags[ref.Tag]; ok {
+					o.sourceAnnotations = tagRef.Annotations
+				}
+			}
 			event := imageapi.LatestTaggedImage(is, ref.Tag)
 			if event == nil {
 				return fmt.Errorf("%q is not currently pointing to an image, cannot use it as the source of a tag", args[0])
@@ -307,6 +323,10 @@ func (o TagOptions) Validate() error {
 		return errors.New("--alias and --delete may not be both specified")
 	}
 
+	if len(o.copyAnnotations) > 0 && (o.deleteTag || o.aliasTag) {
+		return errors.New("--copy-annotations cannot be combined with --delete or --alias")
+	}
+
 	if o.referencePolicy != sourceReferencePolicy && o.referencePolicy != localReferencePolicy {
 		return errors.New("reference policy must be set to 'source' or 'local'")
 	}
@@ -357,6 +377,24 @@ func (o TagOptions) Validate() error {
 	return nil
 }
 
+// selectedAnnotations returns the source tag annotations whose keys match one
+// of the --copy-annotations patterns.
+func (o TagOptions) selectedAnnotations() map[string]string {
+	selected := map[string]string{}
+	for key, value := range o.sourceAnnotations {
+		for _, pattern := range o.copyAnnotations {
+			if ok, err := path.Match(pattern, key); err == nil && ok {
+				selected[key] = value
+				break
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+	return selected
+}
+
 // Run contains all the necessary functionality for the OpenShift cli tag command.
 func (o TagOptions) Run() error {
 	var tagReferencePolicy imageapi.TagReferencePolicyType
@@ -437,6 +475,19 @@ func (o TagOptions) Run() error {
 					},
 				},
 			}
+			if len(o.sourceAnnotations) > 0 {
+				annotations := o.selectedAnnotations()
+				if len(annotations) > 0 {
+					// honor the propagation policy of the destination stream, if any
+					if target, err := isc.Get(destName, metav1.GetOptions{}); err == nil {
+						annotations = imageapi.FilterPropagatedAnnotations(target, annotations)
+					} else if !kerrors.IsNotFound(err) {
+						return err
+					}
+				}
+				istag.Tag.Annotations = annotations
+			}
+
 			localRef := o.ref
 			switch o.sourceKind {
 			case "DockerImage":
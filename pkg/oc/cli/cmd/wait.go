@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	appsclient "github.com/openshift/origin/pkg/apps/generated/internalclientset"
+	appsutil "github.com/openshift/origin/pkg/apps/util"
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	routeclient "github.com/openshift/origin/pkg/route/generated/internalclientset"
+)
+
+const WaitRecommendedName = "wait"
+
+var (
+	waitLong = templates.LongDesc(`
+		Wait for a condition on an OpenShift resource
+
+		This command blocks until the requested condition is reached on a build, route,
+		or deployment config, or until the timeout expires. The supported conditions are:
+
+		* builds: complete (fails if the build ends in any other terminal phase)
+		* routes: admitted (the route was admitted by at least one router)
+		* deploymentconfigs: rollout-complete (the latest rollout finished successfully)
+
+		The command exits non-zero if the condition can no longer be reached, for
+		example when a build fails or a rollout is cancelled.`)
+
+	waitExample = templates.Examples(`
+	  # Wait for a build to finish
+	  %[1]s build/ruby-sample-1 --for=complete
+
+	  # Wait at most two minutes for a route to be admitted by a router
+	  %[1]s route/frontend --for=admitted --timeout=2m
+
+	  # Wait for the latest rollout of a deployment config to complete
+	  %[1]s dc/frontend --for=rollout-complete`)
+)
+
+// WaitOptions contains the inputs for the wait command.
+type WaitOptions struct {
+	Namespace string
+	Resource  string
+	Name      string
+	For       string
+	Timeout   time.Duration
+	Interval  time.Duration
+
+	AppsClient  appsclient.Interface
+	BuildClient buildclient.Interface
+	RouteClient routeclient.Interface
+
+	Out io.Writer
+}
+
+// NewCmdWait implements waiting for conditions on OpenShift resources.
+func NewCmdWait(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &WaitOptions{
+		Out:      out,
+		Timeout:  10 * time.Minute,
+		Interval: 2 * time.Second,
+	}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s (TYPE/NAME | TYPE NAME) --for=CONDITION", name),
+		Short:   "Wait for a condition on a build, route, or deployment config",
+		Long:    waitLong,
+		Example: fmt.Sprintf(waitExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(opts.Complete(f, cmd, args))
+			kcmdutil.CheckErr(opts.Validate())
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.For, "for", opts.For, "The condition to wait for: complete, admitted, or rollout-complete.")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "The maximum time to wait before giving up.")
+
+	return cmd
+}
+
+func (o *WaitOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	switch len(args) {
+	case 1:
+		parts := strings.SplitN(args[0], "/", 2)
+		if len(parts) != 2 {
+			return kcmdutil.UsageErrorf(cmd, "expected TYPE/NAME or TYPE NAME arguments")
+		}
+		o.Resource, o.Name = parts[0], parts[1]
+	case 2:
+		o.Resource, o.Name = args[0], args[1]
+	default:
+		return kcmdutil.UsageErrorf(cmd, "expected TYPE/NAME or TYPE NAME arguments")
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	if o.AppsClient, err = f.OpenshiftInternalAppsClient(); err != nil {
+		return err
+	}
+	if o.BuildClient, err = f.OpenshiftInternalBuildClient(); err != nil {
+		return err
+	}
+	if o.RouteClient, err = f.OpenshiftInternalRouteClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *WaitOptions) Validate() error {
+	switch normalizeResource(o.Resource) {
+	case "build":
+		if o.For != "complete" {
+			return fmt.Errorf("builds support only --for=complete")
+		}
+	case "route":
+		if o.For != "admitted" {
+			return fmt.Errorf("routes support only --for=admitted")
+		}
+	case "deploymentconfig":
+		if o.For != "rollout-complete" {
+			return fmt.Errorf("deployment configs support only --for=rollout-complete")
+		}
+	default:
+		return fmt.Errorf("unsupported resource %q - supported resources are builds, routes, and deploymentconfigs", o.Resource)
+	}
+	return nil
+}
+
+func (o *WaitOptions) Run() error {
+	var check func() (bool, error)
+	switch normalizeResource(o.Resource) {
+	case "build":
+		check = o.buildComplete
+	case "route":
+		check = o.routeAdmitted
+	case "deploymentconfig":
+		check = o.rolloutComplete
+	}
+
+	err := wait.PollImmediate(o.Interval, o.Timeout, check)
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out after %s waiting for %s/%s to be %s", o.Timeout, o.Resource, o.Name, o.For)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "%s/%s condition met: %s\n", o.Resource, o.Name, o.For)
+	return nil
+}
+
+func (o *WaitOptions) buildComplete() (bool, error) {
+	build, err := o.BuildClient.Build().Builds(o.Namespace).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if !buildutil.IsTerminalPhase(build.Status.Phase) {
+		return false, nil
+	}
+	if build.Status.Phase != buildapi.BuildPhaseComplete {
+		return false, fmt.Errorf("build %s/%s ended in phase %s: %s", build.Namespace, build.Name, build.Status.Phase, build.Status.Message)
+	}
+	return true, nil
+}
+
+func (o *WaitOptions) routeAdmitted() (bool, error) {
+	route, err := o.RouteClient.Route().Routes(o.Namespace).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, ingress := range route.Status.Ingress {
+		for _, condition := range ingress.Conditions {
+			if condition.Type == routeapi.RouteAdmitted && condition.Status == kapi.ConditionTrue {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (o *WaitOptions) rolloutComplete() (bool, error) {
+	config, err := o.AppsClient.Apps().DeploymentConfigs(o.Namespace).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if config.Generation > config.Status.ObservedGeneration {
+		return false, nil
+	}
+	cond := appsutil.GetDeploymentCondition(config.Status, appsapi.DeploymentProgressing)
+	if cond == nil {
+		return false, nil
+	}
+	switch cond.Reason {
+	case appsapi.NewRcAvailableReason:
+		return true, nil
+	case appsapi.TimedOutReason, appsapi.CancelledRolloutReason:
+		return false, fmt.Errorf("rollout of deployment config %s/%s cannot complete: %s", config.Namespace, config.Name, cond.Message)
+	}
+	return false, nil
+}
+
+func normalizeResource(resource string) string {
+	switch strings.ToLower(resource) {
+	case "build", "builds":
+		return "build"
+	case "route", "routes":
+		return "route"
+	case "dc", "deploymentconfig", "deploymentconfigs":
+		return "deploymentconfig"
+	}
+	return resource
+}
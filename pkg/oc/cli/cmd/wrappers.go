@@ -47,12 +47,20 @@ var (
 		Possible resources include builds, buildConfigs, services, pods, etc. To see
 		a list of common resources, use '%[1]s get'. Some resources may omit advanced
 		details that you can see with '-o wide'.  If you want an even more detailed
-		view, use '%[1]s describe'.`)
+		view, use '%[1]s describe'.
+
+		Pass --export-clean to strip status, generated fields, and other cluster-specific
+		values (such as a service's clusterIP, a build config trigger's last triggered
+		image ID, or a build's status) so the output can be re-applied in another
+		namespace or cluster.`)
 
 	getExample = templates.Examples(`
 		# List all pods in ps output format.
 		%[1]s get pods
 
+		# Print the deployment configuration labeled name=test, cleaned for reuse elsewhere
+		%[1]s get dc -l name=test --export-clean
+
 		# List a single replication controller with specified ID in ps output format.
 		%[1]s get rc redis
 
@@ -72,6 +80,15 @@ func NewCmdGet(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *co
 	cmd.Long = fmt.Sprintf(getLong, fullName)
 	cmd.Example = fmt.Sprintf(getExample, fullName)
 	cmd.SuggestFor = []string{"list"}
+	cmd.Flags().Bool("export-clean", false, "If true, strip status, generated fields, and other cluster-specific values so the output can be re-applied in another namespace or cluster.")
+	defaultRun := cmd.Run
+	cmd.Run = func(c *cobra.Command, args []string) {
+		if kcmdutil.GetFlagBool(c, "export-clean") {
+			kcmdutil.CheckErr(RunGetExportClean(f, out, c, args))
+			return
+		}
+		defaultRun(c, args)
+	}
 	return cmd
 }
 
@@ -1389,6 +1389,9 @@ func (d *UserDescriber) Describe(namespace, name string, settings kprinters.Desc
 		if len(user.FullName) > 0 {
 			formatString(out, "Full Name", user.FullName)
 		}
+		if user.Disabled {
+			formatString(out, "Disabled", "true")
+		}
 
 		if len(user.Identities) == 0 {
 			formatString(out, "Identities", "<none>")
@@ -1966,10 +1969,37 @@ func (d *RoleBindingRestrictionDescriber) Describe(namespace, name string, setti
 			}
 		}
 
+		if rbr.Spec.UserRestriction != nil && len(rbr.Spec.UserRestriction.GroupSelectors) != 0 {
+			if err := describeLabelSelectors(out, "Users in groups matching",
+				rbr.Spec.UserRestriction.GroupSelectors); err != nil {
+				return err
+			}
+		}
+
+		if rbr.Spec.ServiceAccountRestriction != nil && len(rbr.Spec.ServiceAccountRestriction.NamespaceSelectors) != 0 {
+			if err := describeLabelSelectors(out, "Namespaces matching",
+				rbr.Spec.ServiceAccountRestriction.NamespaceSelectors); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 }
 
+// describeLabelSelectors writes the given label selectors under the given heading.
+func describeLabelSelectors(out *tabwriter.Writer, heading string, labelSelectors []metav1.LabelSelector) error {
+	fmt.Fprintf(out, "%s:\n", heading)
+	for _, labelSelector := range labelSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\t%s\n", selector)
+	}
+	return nil
+}
+
 // SecurityContextConstraintsDescriber generates information about an SCC
 type SecurityContextConstraintsDescriber struct {
 	c securityclient.SecurityContextConstraintsGetter
@@ -1190,6 +1190,10 @@ func printRoleBindingRestriction(rbr *authorizationapi.RoleBindingRestriction, w
 			subjectList = append(subjectList,
 				metav1.FormatLabelSelector(&selector))
 		}
+		for _, selector := range rbr.Spec.UserRestriction.GroupSelectors {
+			subjectList = append(subjectList,
+				fmt.Sprintf("group(%s)", metav1.FormatLabelSelector(&selector)))
+		}
 	case rbr.Spec.GroupRestriction != nil:
 		for _, group := range rbr.Spec.GroupRestriction.Groups {
 			subjectList = append(subjectList, group)
@@ -1206,6 +1210,10 @@ func printRoleBindingRestriction(rbr *authorizationapi.RoleBindingRestriction, w
 		for _, ns := range rbr.Spec.ServiceAccountRestriction.Namespaces {
 			subjectList = append(subjectList, fmt.Sprintf("%s/*", ns))
 		}
+		for _, selector := range rbr.Spec.ServiceAccountRestriction.NamespaceSelectors {
+			subjectList = append(subjectList,
+				fmt.Sprintf("%s/*", metav1.FormatLabelSelector(&selector)))
+		}
 	}
 
 	if options.WithNamespace {
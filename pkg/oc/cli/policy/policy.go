@@ -33,5 +33,8 @@ func NewCmdPolicy(name, fullName string, f *clientcmd.Factory, out, errOut io.Wr
 	cmds.AddCommand(adminpolicy.NewCmdRemoveRoleFromGroup(adminpolicy.RemoveRoleFromGroupRecommendedName, fullName+" "+adminpolicy.RemoveRoleFromGroupRecommendedName, f, out))
 	cmds.AddCommand(adminpolicy.NewCmdRemoveGroupFromProject(adminpolicy.RemoveGroupRecommendedName, fullName+" "+adminpolicy.RemoveGroupRecommendedName, f, out))
 
+	cmds.AddCommand(adminpolicy.NewCmdAddImageStreamAccess(adminpolicy.AddImageStreamAccessRecommendedName, fullName+" "+adminpolicy.AddImageStreamAccessRecommendedName, f, out))
+	cmds.AddCommand(adminpolicy.NewCmdRemoveImageStreamAccess(adminpolicy.RemoveImageStreamAccessRecommendedName, fullName+" "+adminpolicy.RemoveImageStreamAccessRecommendedName, f, out))
+
 	return cmds
 }
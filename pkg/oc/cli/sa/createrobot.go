@@ -0,0 +1,242 @@
+package sa
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
+	authorizationclient "github.com/openshift/origin/pkg/authorization/generated/internalclientset/typed/authorization/internalversion"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+	"github.com/openshift/origin/pkg/serviceaccounts"
+)
+
+const (
+	CreateRegistryRobotRecommendedName = "create-registry-robot"
+
+	createRegistryRobotShort = `Create a registry credential scoped to a single image stream.`
+
+	createRegistryRobotUsage = `%s IMAGESTREAM`
+
+	// robotTokenTimeout is how long to wait for the token controller to mint a
+	// token secret for a newly created robot service account.
+	robotTokenTimeout = 30 * time.Second
+)
+
+var (
+	createRegistryRobotLong = templates.LongDesc(`
+    Create a registry credential scoped to a single image stream.
+
+    This command creates a robot service account along with a role and role binding that only
+    allow pulling (and optionally pushing, with --push) the named image stream through the
+    integrated registry. Unlike granting a service account the registry-viewer or
+    registry-editor role, the robot cannot read or write any other image stream in the
+    project. The robot's token is printed and can be used as the password for 'docker login'
+    against the integrated registry with any username.`)
+
+	createRegistryRobotExamples = templates.Examples(`
+    # Create a pull-only robot for the image stream 'ruby'
+    %[1]s ruby
+
+    # Create a robot that can also push the image stream 'ruby'
+    %[1]s ruby --push`)
+)
+
+type CreateRegistryRobotOptions struct {
+	StreamName string
+	RobotName  string
+	Push       bool
+	Namespace  string
+
+	SAClient          kcoreclient.ServiceAccountInterface
+	SecretsClient     kcoreclient.SecretInterface
+	RoleClient        authorizationclient.RoleInterface
+	RoleBindingClient authorizationclient.RoleBindingInterface
+
+	Out io.Writer
+	Err io.Writer
+}
+
+func NewCommandCreateRegistryRobot(name, fullname string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &CreateRegistryRobotOptions{
+		Out: out,
+		Err: os.Stderr,
+	}
+
+	createRegistryRobotCommand := &cobra.Command{
+		Use:     fmt.Sprintf(createRegistryRobotUsage, name),
+		Short:   createRegistryRobotShort,
+		Long:    createRegistryRobotLong,
+		Example: fmt.Sprintf(createRegistryRobotExamples, fullname),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.Complete(args, f, cmd))
+			cmdutil.CheckErr(options.Validate())
+			cmdutil.CheckErr(options.Run())
+		},
+	}
+
+	createRegistryRobotCommand.Flags().StringVar(&options.RobotName, "robot", "", "The name of the robot service account to create. Defaults to registry-robot-<imagestream>.")
+	createRegistryRobotCommand.Flags().BoolVar(&options.Push, "push", false, "If true, also allow the robot to push the image stream. Defaults to pull-only.")
+
+	return createRegistryRobotCommand
+}
+
+func (o *CreateRegistryRobotOptions) Complete(args []string, f *clientcmd.Factory, cmd *cobra.Command) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, "expected one image stream name as an argument, got %q", args)
+	}
+
+	o.StreamName = args[0]
+	if len(o.RobotName) == 0 {
+		o.RobotName = "registry-robot-" + o.StreamName
+	}
+
+	client, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+	authorizationClient, err := f.OpenshiftInternalAuthorizationClient()
+	if err != nil {
+		return err
+	}
+
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	o.SAClient = client.Core().ServiceAccounts(o.Namespace)
+	o.SecretsClient = client.Core().Secrets(o.Namespace)
+	o.RoleClient = authorizationClient.Authorization().Roles(o.Namespace)
+	o.RoleBindingClient = authorizationClient.Authorization().RoleBindings(o.Namespace)
+	return nil
+}
+
+func (o *CreateRegistryRobotOptions) Validate() error {
+	if len(o.StreamName) == 0 {
+		return errors.New("image stream name cannot be empty")
+	}
+
+	if o.SAClient == nil || o.SecretsClient == nil || o.RoleClient == nil || o.RoleBindingClient == nil {
+		return errors.New("API clients must not be nil in order to create a registry robot")
+	}
+
+	if o.Out == nil || o.Err == nil {
+		return errors.New("cannot proceed if output or error writers are nil")
+	}
+
+	return nil
+}
+
+func (o *CreateRegistryRobotOptions) Run() error {
+	// The registry authorizes pulls with a get check and pushes with an update
+	// check against the imagestreams/layers subresource, passing the stream as
+	// the resource name, so a role with resource names confines the robot to
+	// the single stream.
+	verbs := []string{"get"}
+	if o.Push {
+		verbs = append(verbs, "update")
+	}
+	rule, err := authorizationapi.NewRule(verbs...).
+		Groups(kapi.GroupName, "image.openshift.io").
+		Resources("imagestreams/layers").
+		Names(o.StreamName).
+		Rule()
+	if err != nil {
+		return err
+	}
+
+	if _, err := o.SAClient.Create(&kapi.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Namespace: o.Namespace, Name: o.RobotName},
+	}); err != nil && !kapierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	role := &authorizationapi.Role{
+		ObjectMeta: metav1.ObjectMeta{Namespace: o.Namespace, Name: o.RobotName},
+		Rules:      []authorizationapi.PolicyRule{rule},
+	}
+	if _, err := o.RoleClient.Create(role); err != nil {
+		if !kapierrors.IsAlreadyExists(err) {
+			return err
+		}
+		existing, err := o.RoleClient.Get(role.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		existing.Rules = role.Rules
+		if _, err := o.RoleClient.Update(existing); err != nil {
+			return err
+		}
+	}
+
+	roleBinding := &authorizationapi.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: o.Namespace, Name: o.RobotName},
+		Subjects: []kapi.ObjectReference{
+			{Kind: authorizationapi.ServiceAccountKind, Namespace: o.Namespace, Name: o.RobotName},
+		},
+		RoleRef: kapi.ObjectReference{Namespace: o.Namespace, Name: o.RobotName},
+	}
+	if _, err := o.RoleBindingClient.Create(roleBinding); err != nil && !kapierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	token, err := o.waitForToken()
+	if err != nil {
+		return err
+	}
+
+	mode := "pull"
+	if o.Push {
+		mode = "pull+push"
+	}
+	fmt.Fprintf(o.Err, "Robot service account %q can %s image stream %q. Use the following token as the docker password:\n", o.RobotName, mode, o.StreamName)
+	fmt.Fprintf(o.Out, "%s\n", token)
+	return nil
+}
+
+// waitForToken waits until the token controller has minted a token secret for
+// the robot service account and returns the token.
+func (o *CreateRegistryRobotOptions) waitForToken() (string, error) {
+	token := ""
+	err := wait.PollImmediate(time.Second, robotTokenTimeout, func() (bool, error) {
+		serviceAccount, err := o.SAClient.Get(o.RobotName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, reference := range serviceAccount.Secrets {
+			secret, err := o.SecretsClient.Get(reference.Name, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+			if serviceaccounts.IsValidServiceAccountToken(serviceAccount, secret) {
+				data, exists := secret.Data[kapi.ServiceAccountTokenKey]
+				if !exists {
+					continue
+				}
+				token = string(data)
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return "", fmt.Errorf("timed out waiting for a token for service account %q", o.RobotName)
+	}
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
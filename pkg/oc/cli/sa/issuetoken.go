@@ -0,0 +1,194 @@
+package sa
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverserviceaccount "k8s.io/apiserver/pkg/authentication/serviceaccount"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/term"
+	oauthapi "github.com/openshift/origin/pkg/oauth/apis/oauth"
+	oauthclient "github.com/openshift/origin/pkg/oauth/generated/internalclientset/typed/oauth/internalversion"
+	"github.com/openshift/origin/pkg/oc/cli/util/clientcmd"
+)
+
+const (
+	IssueServiceAccountTokenRecommendedName = "issue-token"
+
+	issueServiceAccountTokenShort = `Issue a short-lived OAuth token for a service account.`
+
+	issueServiceAccountTokenUsage = `%s SA-NAME`
+
+	// issueTokenCLIClientID is the name of the CLI OAuth client, copied from pkg/oauth/apiserver/auth.go
+	issueTokenCLIClientID = "openshift-challenging-client"
+)
+
+var (
+	issueServiceAccountTokenLong = templates.LongDesc(`
+    Issue a short-lived OAuth token for a service account.
+
+    Unlike the long-lived API token secrets managed by the service account token controller,
+    tokens issued by this command are stored as OAuth access tokens with a server-side enforced
+    expiry and an optional set of scopes restricting what the token may do. They are intended
+    to replace long-lived token secrets for automation that only needs temporary access.`)
+
+	issueServiceAccountTokenExamples = templates.Examples(`
+    # Issue a token for service account 'default' that expires in one hour
+    %[1]s 'default' --duration=1h
+
+    # Issue a token that may only check access
+    %[1]s 'default' --duration=30m --scopes=user:check-access`)
+)
+
+type IssueServiceAccountTokenOptions struct {
+	SAName    string
+	Namespace string
+
+	SAClient    kcoreclient.ServiceAccountInterface
+	TokenClient oauthclient.OAuthAccessTokenInterface
+
+	Scopes   []string
+	Duration time.Duration
+
+	Out io.Writer
+	Err io.Writer
+}
+
+func NewCommandIssueServiceAccountToken(name, fullname string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &IssueServiceAccountTokenOptions{
+		Out: out,
+		Err: os.Stderr,
+	}
+
+	issueServiceAccountTokenCommand := &cobra.Command{
+		Use:     fmt.Sprintf(issueServiceAccountTokenUsage, name),
+		Short:   issueServiceAccountTokenShort,
+		Long:    issueServiceAccountTokenLong,
+		Example: fmt.Sprintf(issueServiceAccountTokenExamples, fullname),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.Complete(args, f, cmd))
+			cmdutil.CheckErr(options.Validate())
+			cmdutil.CheckErr(options.Run())
+		},
+	}
+
+	issueServiceAccountTokenCommand.Flags().DurationVar(&options.Duration, "duration", time.Hour, "the amount of time until the token expires, enforced server-side")
+	issueServiceAccountTokenCommand.Flags().StringSliceVar(&options.Scopes, "scopes", nil, "scopes restricting what the token may do, given as a comma-delimited list. If empty, the token has the full power of the service account")
+	return issueServiceAccountTokenCommand
+}
+
+func (o *IssueServiceAccountTokenOptions) Complete(args []string, f *clientcmd.Factory, cmd *cobra.Command) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, fmt.Sprintf("expected one service account name as an argument, got %q", args))
+	}
+
+	o.SAName = args[0]
+
+	client, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+
+	oauthClient, err := f.OpenshiftInternalOAuthClient()
+	if err != nil {
+		return err
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return fmt.Errorf("could not retrieve default namespace: %v", err)
+	}
+
+	o.Namespace = namespace
+	o.SAClient = client.Core().ServiceAccounts(namespace)
+	o.TokenClient = oauthClient.Oauth().OAuthAccessTokens()
+	return nil
+}
+
+func (o *IssueServiceAccountTokenOptions) Validate() error {
+	if o.SAName == "" {
+		return errors.New("service account name cannot be empty")
+	}
+
+	if o.SAClient == nil || o.TokenClient == nil {
+		return errors.New("API clients must not be nil in order to issue a service account token")
+	}
+
+	if o.Duration < time.Second {
+		return errors.New("a token must be valid for at least one second")
+	}
+
+	for _, scope := range o.Scopes {
+		if len(strings.TrimSpace(scope)) == 0 {
+			return errors.New("scopes cannot be empty")
+		}
+	}
+
+	if o.Out == nil || o.Err == nil {
+		return errors.New("cannot proceed if output or error writers are nil")
+	}
+
+	return nil
+}
+
+// Run creates an OAuth access token for the service account. Expiry and scopes are
+// recorded on the token, so both are enforced server-side on every authentication.
+func (o *IssueServiceAccountTokenOptions) Run() error {
+	serviceAccount, err := o.SAClient.Get(o.SAName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	token := &oauthapi.OAuthAccessToken{
+		ObjectMeta: metav1.ObjectMeta{Name: randomToken()},
+		ClientName: issueTokenCLIClientID,
+		ExpiresIn:  int64(o.Duration / time.Second),
+		Scopes:     o.Scopes,
+		UserName:   apiserverserviceaccount.MakeUsername(serviceAccount.Namespace, serviceAccount.Name),
+		UserUID:    string(serviceAccount.UID),
+	}
+
+	persistedToken, err := o.TokenClient.Create(token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, persistedToken.Name)
+	if term.IsTerminalWriter(o.Out) {
+		// pretty-print for a TTY
+		fmt.Fprintf(o.Out, "\n")
+	}
+	return nil
+}
+
+// randomToken generates a random token string, copied from pkg/oauthserver/osinserver/tokengen.go
+func randomToken() string {
+	for {
+		// 32 bytes (256 bits) = 43 base64-encoded characters
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			// rand.Read should never fail
+			panic(err)
+		}
+		// Use URLEncoding to ensure we don't get / characters
+		s := base64.URLEncoding.EncodeToString(b)
+		// Don't generate tokens with leading dashes... they're hard to use on the command line
+		if strings.HasPrefix(s, "-") {
+			continue
+		}
+		// Strip trailing ='s... they're ugly
+		return strings.TrimRight(s, "=")
+	}
+}
@@ -32,6 +32,8 @@ func NewCmdServiceAccounts(name, fullName string, f *clientcmd.Factory, out, err
 	cmds.AddCommand(NewCommandCreateKubeconfig(CreateKubeconfigRecommendedName, fullName+" "+CreateKubeconfigRecommendedName, f, out))
 	cmds.AddCommand(NewCommandGetServiceAccountToken(GetServiceAccountTokenRecommendedName, fullName+" "+GetServiceAccountTokenRecommendedName, f, out))
 	cmds.AddCommand(NewCommandNewServiceAccountToken(NewServiceAccountTokenRecommendedName, fullName+" "+NewServiceAccountTokenRecommendedName, f, out))
+	cmds.AddCommand(NewCommandIssueServiceAccountToken(IssueServiceAccountTokenRecommendedName, fullName+" "+IssueServiceAccountTokenRecommendedName, f, out))
+	cmds.AddCommand(NewCommandCreateRegistryRobot(CreateRegistryRobotRecommendedName, fullName+" "+CreateRegistryRobotRecommendedName, f, out))
 
 	return cmds
 }
@@ -0,0 +1,201 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/spf13/cobra"
+)
+
+// CheckLinksRecommendedName `oc secrets check-links`
+const CheckLinksRecommendedName = "check-links"
+
+var (
+	checkLinksLong = templates.LongDesc(`
+    Audit secret links in the current project
+
+    For every secret in the project this command shows which service accounts have it linked
+    for mounting or for pulling images, and which pods currently reference it through volumes,
+    environment variables or image pull secrets. Secrets that are neither linked nor referenced
+    are reported as unused so stale pull secrets can be removed safely.`)
+
+	checkLinksExample = templates.Examples(`
+    # Show how every secret in the current project is linked and used
+    %[1]s
+
+    # Audit a single secret
+    %[1]s pull-secret`)
+)
+
+// NewCmdCheckLinks creates a command object for auditing secret links in a project
+func NewCmdCheckLinks(name, fullName string, f kcmdutil.Factory, out io.Writer) *cobra.Command {
+	o := &CheckLinksOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [secret-name]...", name),
+		Short:   "Show how secrets are linked to service accounts and pods",
+		Long:    checkLinksLong,
+		Example: fmt.Sprintf(checkLinksExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := o.Complete(f, args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(c, err.Error()))
+			}
+
+			if err := o.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageErrorf(c, err.Error()))
+			}
+
+			kcmdutil.CheckErr(o.CheckLinks())
+		},
+	}
+
+	return cmd
+}
+
+type CheckLinksOptions struct {
+	SecretNames []string
+
+	Namespace string
+
+	KubeCoreClient kcoreclient.CoreInterface
+
+	Out io.Writer
+}
+
+func (o *CheckLinksOptions) Complete(f kcmdutil.Factory, args []string) error {
+	o.SecretNames = args
+
+	kubeClientSet, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+	o.KubeCoreClient = kubeClientSet.Core()
+
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o CheckLinksOptions) Validate() error {
+	if o.KubeCoreClient == nil {
+		return errors.New("KubeCoreClient must be present")
+	}
+	return nil
+}
+
+// CheckLinks prints a per secret report of service account links and pod usage.
+func (o CheckLinksOptions) CheckLinks() error {
+	secretList, err := o.KubeCoreClient.Secrets(o.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	serviceAccounts, err := o.KubeCoreClient.ServiceAccounts(o.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pods, err := o.KubeCoreClient.Pods(o.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	selected := sets.NewString(o.SecretNames...)
+	mountedBy := map[string][]string{}
+	pullBy := map[string][]string{}
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		for _, secret := range sa.Secrets {
+			mountedBy[secret.Name] = append(mountedBy[secret.Name], sa.Name)
+		}
+		for _, secret := range sa.ImagePullSecrets {
+			pullBy[secret.Name] = append(pullBy[secret.Name], sa.Name)
+		}
+	}
+	usedByPods := map[string][]string{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, secretName := range podSecretNames(pod).List() {
+			usedByPods[secretName] = append(usedByPods[secretName], pod.Name)
+		}
+	}
+
+	unused := []string{}
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintln(w, "NAME\tTYPE\tMOUNTED-BY\tPULLED-BY\tUSED-BY-PODS")
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if selected.Len() > 0 && !selected.Has(secret.Name) {
+			continue
+		}
+		mounts := mountedBy[secret.Name]
+		pulls := pullBy[secret.Name]
+		podNames := usedByPods[secret.Name]
+		if len(mounts) == 0 && len(pulls) == 0 && len(podNames) == 0 {
+			// token secrets belong to their service account even when not listed as
+			// a mount secret, do not report them as unused
+			if secret.Type != kapi.SecretTypeServiceAccountToken {
+				unused = append(unused, secret.Name)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", secret.Name, secret.Type, joinOrNone(mounts), joinOrNone(pulls), joinOrNone(podNames))
+	}
+
+	if len(unused) > 0 {
+		w.Flush()
+		sort.Strings(unused)
+		fmt.Fprintf(o.Out, "\n%d secret(s) are not linked to any service account and not used by any pod: %s\n", len(unused), strings.Join(unused, ", "))
+	}
+
+	return nil
+}
+
+// podSecretNames returns the names of every secret the pod depends on through
+// volumes, image pull secrets, environment variables or envFrom sources.
+func podSecretNames(pod *kapi.Pod) sets.String {
+	names := sets.String{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil {
+			names.Insert(volume.Secret.SecretName)
+		}
+	}
+	for _, secret := range pod.Spec.ImagePullSecrets {
+		names.Insert(secret.Name)
+	}
+	containers := append([]kapi.Container{}, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				names.Insert(env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				names.Insert(envFrom.SecretRef.Name)
+			}
+		}
+	}
+	return names
+}
+
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "<none>"
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"path"
 	"strings"
 
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kvalidation "k8s.io/apimachinery/pkg/util/validation"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
@@ -69,6 +71,10 @@ type CreateSecretOptions struct {
 	Quiet bool
 
 	AllowUnknownTypes bool
+
+	// Apply updates an existing secret instead of failing, replacing only the keys whose
+	// contents differ from the sources. Keys not present in the sources are left untouched.
+	Apply bool
 }
 
 func NewCmdCreateSecret(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
@@ -107,6 +113,7 @@ func NewCmdCreateSecret(name, fullName string, f *clientcmd.Factory, out io.Writ
 	cmd.Flags().BoolVarP(&options.Quiet, "quiet", "q", options.Quiet, "If true, suppress warnings")
 	cmd.Flags().BoolVar(&options.AllowUnknownTypes, "confirm", options.AllowUnknownTypes, "If true, allow unknown secret types.")
 	cmd.Flags().StringVar(&options.SecretTypeName, "type", "", "The type of secret")
+	cmd.Flags().BoolVar(&options.Apply, "apply", options.Apply, "If true, update an existing secret in place, replacing only the keys whose contents changed.")
 	kcmdutil.AddPrinterFlags(cmd)
 
 	return cmd
@@ -184,10 +191,42 @@ func (o *CreateSecretOptions) CreateSecret() (*kapi.Secret, error) {
 	if err == nil {
 		fmt.Fprintf(o.Out, "secret/%s\n", persistedSecret.Name)
 	}
+	if kapierrors.IsAlreadyExists(err) && o.Apply {
+		return o.applySecret(secret)
+	}
 
 	return persistedSecret, err
 }
 
+// applySecret merges the bundled keys into an existing secret, updating only the keys whose
+// contents differ. Keys on the existing secret that are not in the sources are preserved.
+func (o *CreateSecretOptions) applySecret(secret *kapi.Secret) (*kapi.Secret, error) {
+	existing, err := o.SecretsInterface.Get(secret.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	changed := []string{}
+	for key, value := range secret.Data {
+		if existingValue, ok := existing.Data[key]; ok && bytes.Equal(existingValue, value) {
+			continue
+		}
+		if existing.Data == nil {
+			existing.Data = make(map[string][]byte)
+		}
+		existing.Data[key] = value
+		changed = append(changed, key)
+	}
+	if len(changed) == 0 {
+		fmt.Fprintf(o.Out, "secret/%s unchanged\n", existing.Name)
+		return existing, nil
+	}
+	persistedSecret, err := o.SecretsInterface.Update(existing)
+	if err == nil {
+		fmt.Fprintf(o.Out, "secret/%s updated (%d keys changed)\n", persistedSecret.Name, len(changed))
+	}
+	return persistedSecret, err
+}
+
 func (o *CreateSecretOptions) BundleSecret() (*kapi.Secret, error) {
 	secretData := make(map[string][]byte)
 
@@ -6,9 +6,63 @@ import (
 	"os"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgotesting "k8s.io/client-go/testing"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
 )
 
+func TestApplySecret(t *testing.T) {
+	existing := &kapi.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "testSecret", Namespace: "test"},
+		Type:       kapi.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"unchanged": []byte("same"),
+			"rotated":   []byte("old"),
+			"preserved": []byte("keep"),
+		},
+	}
+	client := fake.NewSimpleClientset(existing)
+	options := NewCreateSecretOptions()
+	options.Out = &bytes.Buffer{}
+	options.Name = "testSecret"
+	options.Apply = true
+	options.SecretsInterface = client.Core().Secrets("test")
+
+	secret, err := options.applySecret(&kapi.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "testSecret"},
+		Type:       kapi.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"unchanged": []byte("same"),
+			"rotated":   []byte("new"),
+			"added":     []byte("value"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(secret.Data["rotated"]) != "new" {
+		t.Errorf("expected rotated key to be updated, got %q", secret.Data["rotated"])
+	}
+	if string(secret.Data["added"]) != "value" {
+		t.Errorf("expected added key to be set, got %q", secret.Data["added"])
+	}
+	if string(secret.Data["preserved"]) != "keep" {
+		t.Errorf("expected key missing from sources to be preserved, got %q", secret.Data["preserved"])
+	}
+
+	// identical sources must not issue an update
+	client.ClearActions()
+	if _, err := options.applySecret(secret.DeepCopy()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range client.Actions() {
+		if _, ok := action.(clientgotesting.UpdateAction); ok {
+			t.Errorf("unexpected update for unchanged secret: %#v", action)
+		}
+	}
+}
+
 func TestValidate(t *testing.T) {
 	tests := []struct {
 		testName string
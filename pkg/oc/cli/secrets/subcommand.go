@@ -46,6 +46,7 @@ func NewCmdSecrets(name, fullName string, f *clientcmd.Factory, out, errOut io.W
 
 	cmds.AddCommand(NewCmdLinkSecret(LinkSecretRecommendedName, fullName+" "+LinkSecretRecommendedName, f, out))
 	cmds.AddCommand(NewCmdUnlinkSecret(UnlinkSecretRecommendedName, fullName+" "+UnlinkSecretRecommendedName, f, out))
+	cmds.AddCommand(NewCmdCheckLinks(CheckLinksRecommendedName, fullName+" "+CheckLinksRecommendedName, f, out))
 
 	return cmds
 }
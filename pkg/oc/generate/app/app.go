@@ -192,6 +192,10 @@ func (r *SourceRef) BuildSource() (*buildapi.BuildSource, []buildapi.BuildTrigge
 type BuildStrategyRef struct {
 	Strategy generate.Strategy
 	Base     *ImageRef
+
+	// JenkinsfilePath is the relative path within the source repository to the
+	// Jenkinsfile used by a pipeline strategy. Empty means the repository root.
+	JenkinsfilePath string
 }
 
 // BuildStrategy builds an OpenShift BuildStrategy from a BuildStrategyRef
@@ -199,7 +203,9 @@ func (s *BuildStrategyRef) BuildStrategy(env Environment, dockerStrategyOptions
 	switch s.Strategy {
 	case generate.StrategyPipeline:
 		return &buildapi.BuildStrategy{
-			JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{},
+			JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: s.JenkinsfilePath,
+			},
 		}, s.Base.BuildTriggers()
 
 	case generate.StrategyDocker:
@@ -211,3 +211,39 @@ func ParseAndCombineEnvironment(envs []string, filenames []string, stdin io.Read
 
 	return vars, nil
 }
+
+// ParseAndLayerEnvironment parses key=value records the same way as
+// ParseAndCombineEnvironment, but layers the given files in order instead of
+// treating later occurrences of a key as duplicates: when a key appears in
+// several files, the value from the file listed last wins. Key=value pairs
+// from the envs slice still take precedence over all files.
+//
+// The dupfn function is called for keys duplicated within the envs slice. If
+// the function returns an error this error is returned by
+// ParseAndLayerEnvironment.
+//
+// If a file is "-" the file contents will be read from argument stdin (unless
+// it's nil).
+func ParseAndLayerEnvironment(envs []string, filenames []string, stdin io.Reader, dupfn func(string) error) (Environment, error) {
+	vars, duplicates, errs := ParseEnvironment(envs...)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	for _, s := range duplicates {
+		if err := dupfn(s); err != nil {
+			return nil, err
+		}
+	}
+
+	combined := make(Environment)
+	for _, fname := range filenames {
+		fileVars, err := LoadEnvironmentFile(fname, stdin)
+		if err != nil {
+			return nil, err
+		}
+		combined.Add(fileVars)
+	}
+	combined.Add(vars)
+
+	return combined, nil
+}
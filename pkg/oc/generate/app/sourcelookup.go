@@ -108,6 +108,7 @@ type SourceRepository struct {
 
 	usedBy           []ComponentReference
 	strategy         generate.Strategy
+	jenkinsfilePath  string
 	ignoreRepository bool
 	binary           bool
 
@@ -211,6 +212,13 @@ func (r *SourceRepository) GetStrategy() generate.Strategy {
 	return r.strategy
 }
 
+// SetJenkinsfilePath sets the relative path within the repository to the
+// Jenkinsfile that a pipeline build should use instead of the default
+// Jenkinsfile at the repository root.
+func (r *SourceRepository) SetJenkinsfilePath(path string) {
+	r.jenkinsfilePath = path
+}
+
 func (r *SourceRepository) String() string {
 	return r.location
 }
@@ -534,8 +542,9 @@ func (e SourceRepositoryEnumerator) Detect(dir string, noSourceDetection bool) (
 // more info
 func StrategyAndSourceForRepository(repo *SourceRepository, image *ImageRef) (*BuildStrategyRef, *SourceRef, error) {
 	strategy := &BuildStrategyRef{
-		Base:     image,
-		Strategy: repo.strategy,
+		Base:            image,
+		Strategy:        repo.strategy,
+		JenkinsfilePath: repo.jenkinsfilePath,
 	}
 	source := &SourceRef{
 		Binary:       repo.binary,
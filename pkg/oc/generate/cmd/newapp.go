@@ -76,11 +76,12 @@ type GenerationInputs struct {
 	To       string
 	NoOutput bool
 
-	OutputDocker  bool
-	Dockerfile    string
-	ExpectToBuild bool
-	BinaryBuild   bool
-	ContextDir    string
+	OutputDocker     bool
+	Dockerfile       string
+	PipelineFilePath string
+	ExpectToBuild    bool
+	BinaryBuild      bool
+	ContextDir       string
 
 	SourceImage     string
 	SourceImagePath string
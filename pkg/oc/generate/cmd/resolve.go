@@ -3,6 +3,8 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/golang/glog"
@@ -227,6 +229,9 @@ func AddSourceRepositoriesToRefBuilder(b *app.ReferenceBuilder, c *ComponentInpu
 			return nil, err
 		}
 	}
+	if len(g.PipelineFilePath) > 0 && g.Strategy != generate.StrategyUnspecified && g.Strategy != generate.StrategyPipeline {
+		return nil, errors.New("when specifying a pipeline file, the strategy must be 'pipeline'")
+	}
 	_, result, errs := b.Result()
 	return result, kutilerrors.NewAggregate(errs)
 }
@@ -269,6 +274,18 @@ func DetectSource(repositories []*app.SourceRepository, d app.Detector, g *Gener
 			errs = append(errs, err)
 			continue
 		}
+		// a pipeline file at a custom location takes the place of the Jenkinsfile
+		// that detection looks for at the repository root
+		if len(g.PipelineFilePath) > 0 {
+			if info := repo.Info(); info != nil {
+				if _, err := os.Stat(filepath.Join(info.Path, g.PipelineFilePath)); err != nil {
+					errs = append(errs, fmt.Errorf("no pipeline file was found at %q in the repository", g.PipelineFilePath))
+					continue
+				}
+				info.Jenkinsfile = true
+				repo.SetJenkinsfilePath(g.PipelineFilePath)
+			}
+		}
 		switch g.Strategy {
 		case generate.StrategyDocker:
 			if repo.Info().Dockerfile == nil {
@@ -0,0 +1,407 @@
+package dockercompose
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	"github.com/openshift/origin/pkg/oc/generate"
+	"github.com/openshift/origin/pkg/oc/generate/app"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+	"github.com/openshift/origin/pkg/util/docker/dockerfile"
+)
+
+// Generator accepts a docker-compose v3 file and produces a template that
+// contains the equivalent OpenShift objects - deployment configs, build
+// configs, image streams, services, routes, and secrets.
+type Generator struct {
+	LocalPath string
+	Name      string
+}
+
+// Generate accepts the contents of a docker-compose v3 file and generates a
+// template from it.
+func (g *Generator) Generate(body []byte) (*templateapi.Template, error) {
+	config := &Config{}
+	if err := yaml.Unmarshal(body, config); err != nil {
+		return nil, err
+	}
+
+	glog.V(4).Infof("docker-compose: %#v", config)
+
+	if !strings.HasPrefix(config.Version, "3") {
+		return nil, fmt.Errorf("only docker-compose version 3 files are supported, got version %q", config.Version)
+	}
+	if len(config.Services) == 0 {
+		return nil, fmt.Errorf("the compose file does not define any services")
+	}
+
+	name := g.Name
+	if len(name) == 0 && len(g.LocalPath) > 0 {
+		name = filepath.Base(g.LocalPath)
+	}
+
+	template := &templateapi.Template{}
+	template.Name = name
+
+	warnings := make(map[string][]string)
+
+	serviceNames := sets.NewString()
+	for k := range config.Services {
+		serviceNames.Insert(k)
+	}
+
+	var errs []error
+	var pipelines app.PipelineGroup
+	composeServiceByDeployment := make(map[string]*Service)
+	imageGen := app.NewImageRefGenerator()
+
+	for _, serviceName := range serviceNames.List() {
+		service := config.Services[serviceName]
+		warnUnusableComposeElements(serviceName, &service, warnings)
+
+		var ports []string
+		for _, p := range service.Ports {
+			ports = append(ports, fmt.Sprintf("%d", p.Target))
+		}
+
+		var pipeline *app.Pipeline
+		switch {
+		case service.Build != nil:
+			buildPath := service.Build.Context
+			if !filepath.IsAbs(buildPath) {
+				buildPath = filepath.Join(g.LocalPath, buildPath)
+			}
+			repo, err := app.NewSourceRepository(buildPath, generate.StrategyDocker)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			dockerfileName := service.Build.Dockerfile
+			if len(dockerfileName) == 0 {
+				dockerfileName = "Dockerfile"
+			}
+			baseImage := serviceName
+			dockerfilePath := filepath.Join(buildPath, dockerfileName)
+			if df, err := app.NewDockerfileFromFile(dockerfilePath); err == nil {
+				repo.Info().Dockerfile = df
+				repo.Info().Path = dockerfilePath
+				if from := dockerfile.LastBaseImage(df.AST()); len(from) > 0 {
+					baseImage = from
+				}
+			}
+			image, err := imageGen.FromNameAndPorts(baseImage, ports)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			image.AsImageStream = true
+			image.TagDirectly = true
+			pipeline, err = app.NewPipelineBuilder(serviceName, app.Environment(service.Build.Args), nil, false).To(serviceName).NewBuildPipeline(serviceName, image, repo, false)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		case len(service.Image) > 0:
+			image, err := imageGen.FromNameAndPorts(service.Image, ports)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			image.AsImageStream = true
+			image.TagDirectly = true
+			pipeline, err = app.NewPipelineBuilder(serviceName, nil, nil, true).To(serviceName).NewImagePipeline(serviceName, image)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		default:
+			errs = append(errs, fmt.Errorf("service %q requires an image or a build section", serviceName))
+			continue
+		}
+
+		containerPorts := service.Ports
+		containerService := service
+		pipeline.Image.ContainerFn = func(c *kapi.Container) {
+			for _, p := range containerPorts {
+				protocol := kapi.ProtocolTCP
+				if strings.EqualFold(p.Protocol, "udp") {
+					protocol = kapi.ProtocolUDP
+				}
+				c.Ports = append(c.Ports, kapi.ContainerPort{ContainerPort: int32(p.Target), Protocol: protocol})
+			}
+			if len(containerService.Entrypoint) > 0 {
+				c.Command = containerService.Entrypoint
+			}
+			if len(containerService.Command) > 0 {
+				c.Args = containerService.Command
+			}
+			c.Env = append(c.Env, app.Environment(containerService.Environment).List()...)
+			if probe := probeForHealthCheck(containerService.HealthCheck); probe != nil {
+				c.LivenessProbe = probe
+			}
+		}
+
+		var deployLabels map[string]string
+		if service.Deploy != nil {
+			deployLabels = service.Deploy.Labels
+		}
+		if err := pipeline.NeedsDeployment(nil, deployLabels, false); err != nil {
+			return nil, err
+		}
+		composeServiceByDeployment[pipeline.Deployment.Name] = &service
+
+		pipelines = append(pipelines, pipeline)
+	}
+
+	if len(errs) > 0 {
+		return nil, utilerrs.NewAggregate(errs)
+	}
+
+	acceptors := app.Acceptors{app.NewAcceptUnique(legacyscheme.Scheme), app.AcceptNew}
+	objects := app.Objects{}
+	accept := app.NewAcceptFirst()
+	for _, p := range pipelines {
+		accepted, err := p.Objects(accept, acceptors)
+		if err != nil {
+			return nil, fmt.Errorf("can't setup %q: %v", p.From, err)
+		}
+		objects = append(objects, accepted...)
+	}
+
+	// emit secrets for every secret referenced by a service
+	objects = append(objects, g.secretObjects(config, warnings)...)
+
+	// apply pod level compose settings to the generated deployment configs
+	// and create services for their ports
+	var services []*kapi.Service
+	for _, obj := range objects {
+		dc, ok := obj.(*appsapi.DeploymentConfig)
+		if !ok {
+			continue
+		}
+		composeService, ok := composeServiceByDeployment[dc.Name]
+		if !ok {
+			continue
+		}
+		if composeService.Deploy != nil && composeService.Deploy.Replicas != nil {
+			dc.Spec.Replicas = *composeService.Deploy.Replicas
+		}
+		addSecretMounts(dc, config, composeService.Secrets)
+
+		ports := app.UniqueContainerToServicePorts(app.AllContainerPorts(dc.Spec.Template.Spec.Containers...))
+		if len(ports) == 0 {
+			continue
+		}
+		svc := app.GenerateService(dc.ObjectMeta, dc.Spec.Selector)
+		svc.Spec.Ports = ports
+		services = append(services, svc)
+
+		// services that publish ports on a non-internal network are
+		// externally reachable, which a route approximates
+		if route := routeForService(svc, composeService, config); route != nil {
+			objects = append(objects, route)
+		}
+	}
+	for _, svc := range services {
+		objects = append(objects, svc)
+	}
+
+	template.Objects = objects
+
+	if len(warnings) > 0 {
+		allWarnings := sets.NewString()
+		for msg, services := range warnings {
+			allWarnings.Insert(fmt.Sprintf("%s: %s", strings.Join(services, ","), msg))
+		}
+		if template.Annotations == nil {
+			template.Annotations = make(map[string]string)
+		}
+		template.Annotations[app.GenerationWarningAnnotation] = fmt.Sprintf("not all docker-compose fields were honored:\n* %s", strings.Join(allWarnings.List(), "\n* "))
+	}
+
+	return template, nil
+}
+
+// secretObjects returns a secret for every secret defined in the compose file
+// that is referenced by at least one service and not marked external.
+func (g *Generator) secretObjects(config *Config, warnings map[string][]string) app.Objects {
+	referenced := sets.NewString()
+	for _, service := range config.Services {
+		for _, ref := range service.Secrets {
+			referenced.Insert(ref.Source)
+		}
+	}
+
+	objects := app.Objects{}
+	for _, source := range referenced.List() {
+		def, ok := config.Secrets[source]
+		if !ok {
+			msg := fmt.Sprintf("secret %q is referenced but not defined", source)
+			warnings[msg] = append(warnings[msg], "secrets")
+			continue
+		}
+		if def.External.External {
+			msg := fmt.Sprintf("external secret %q must be created separately", source)
+			warnings[msg] = append(warnings[msg], "secrets")
+			continue
+		}
+		secret := &kapi.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: source},
+			Type:       kapi.SecretTypeOpaque,
+		}
+		if len(def.File) > 0 {
+			file := def.File
+			if !filepath.IsAbs(file) && len(g.LocalPath) > 0 {
+				file = filepath.Join(g.LocalPath, file)
+			}
+			if data, err := ioutil.ReadFile(file); err == nil {
+				secret.Data = map[string][]byte{source: data}
+			} else {
+				msg := fmt.Sprintf("could not read file for secret %q, the secret will be empty", source)
+				warnings[msg] = append(warnings[msg], "secrets")
+			}
+		}
+		objects = append(objects, secret)
+	}
+	return objects
+}
+
+// addSecretMounts mounts every secret the service references under
+// /run/secrets, matching where the docker engine surfaces them.
+func addSecretMounts(dc *appsapi.DeploymentConfig, config *Config, refs []ServiceSecret) {
+	for _, ref := range refs {
+		name := ref.Source
+		if def, ok := config.Secrets[ref.Source]; ok && def.External.External && len(def.External.Name) > 0 {
+			name = def.External.Name
+		}
+		target := ref.Target
+		if len(target) == 0 {
+			target = ref.Source
+		}
+		dc.Spec.Template.Spec.Volumes = append(dc.Spec.Template.Spec.Volumes, kapi.Volume{
+			Name: ref.Source,
+			VolumeSource: kapi.VolumeSource{
+				Secret: &kapi.SecretVolumeSource{SecretName: name},
+			},
+		})
+		for i := range dc.Spec.Template.Spec.Containers {
+			dc.Spec.Template.Spec.Containers[i].VolumeMounts = append(dc.Spec.Template.Spec.Containers[i].VolumeMounts, kapi.VolumeMount{
+				Name:      ref.Source,
+				ReadOnly:  true,
+				MountPath: filepath.Join("/run/secrets", target),
+			})
+		}
+	}
+}
+
+// routeForService returns a route for the first published port of the compose
+// service, or nil if the service does not publish ports or is only attached
+// to internal networks.
+func routeForService(svc *kapi.Service, composeService *Service, config *Config) *routeapi.Route {
+	if len(composeService.Ports) == 0 {
+		return nil
+	}
+	if len(composeService.Networks) > 0 {
+		reachable := false
+		for _, name := range composeService.Networks {
+			if network, ok := config.Networks[name]; !ok || !network.Internal {
+				reachable = true
+				break
+			}
+		}
+		if !reachable {
+			return nil
+		}
+	}
+	return &routeapi.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   svc.Name,
+			Labels: svc.Labels,
+		},
+		Spec: routeapi.RouteSpec{
+			To: routeapi.RouteTargetReference{
+				Name: svc.Name,
+			},
+			Port: &routeapi.RoutePort{
+				TargetPort: intstr.FromInt(composeService.Ports[0].Target),
+			},
+		},
+	}
+}
+
+// probeForHealthCheck converts a compose healthcheck into a liveness probe.
+func probeForHealthCheck(check *HealthCheck) *kapi.Probe {
+	if check == nil || check.Disable || len(check.Test) == 0 {
+		return nil
+	}
+	command := check.Test
+	switch command[0] {
+	case "NONE":
+		return nil
+	case "CMD":
+		command = command[1:]
+	case "CMD-SHELL":
+		command = append([]string{"/bin/sh", "-c"}, command[1:]...)
+	}
+	if len(command) == 0 {
+		return nil
+	}
+	probe := &kapi.Probe{
+		Handler: kapi.Handler{
+			Exec: &kapi.ExecAction{Command: command},
+		},
+	}
+	probe.PeriodSeconds = secondsForDuration(check.Interval)
+	probe.TimeoutSeconds = secondsForDuration(check.Timeout)
+	probe.InitialDelaySeconds = secondsForDuration(check.StartPeriod)
+	if check.Retries > 0 {
+		probe.FailureThreshold = int32(check.Retries)
+	}
+	return probe
+}
+
+// secondsForDuration converts a compose duration such as "30s" or "1m30s"
+// into whole seconds, or zero if the value is empty or invalid.
+func secondsForDuration(value string) int32 {
+	if len(value) == 0 {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		glog.V(4).Infof("ignoring invalid duration %q: %v", value, err)
+		return 0
+	}
+	return int32(d / time.Second)
+}
+
+// warnUnusableComposeElements adds warnings for unsupported elements in the
+// provided service.
+func warnUnusableComposeElements(k string, v *Service, warnings map[string][]string) {
+	fn := func(msg string) {
+		warnings[msg] = append(warnings[msg], k)
+	}
+	for _, p := range v.Ports {
+		if p.Mode == "host" {
+			fn("host mode ports are not handled")
+			break
+		}
+	}
+	if v.HealthCheck != nil && v.HealthCheck.Disable {
+		fn("the disabled healthcheck is ignored")
+	}
+}
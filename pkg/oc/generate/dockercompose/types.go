@@ -0,0 +1,254 @@
+package dockercompose
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Config is the root of a docker-compose v3 file.
+type Config struct {
+	Version  string                  `json:"version"`
+	Services map[string]Service      `json:"services"`
+	Networks map[string]Network      `json:"networks"`
+	Secrets  map[string]SecretConfig `json:"secrets"`
+}
+
+// Service is a single service entry in a compose file.
+type Service struct {
+	Image       string          `json:"image"`
+	Build       *BuildConfig    `json:"build"`
+	Command     Command         `json:"command"`
+	Entrypoint  Command         `json:"entrypoint"`
+	Environment Environment     `json:"environment"`
+	Ports       []PortConfig    `json:"ports"`
+	Deploy      *Deploy         `json:"deploy"`
+	HealthCheck *HealthCheck    `json:"healthcheck"`
+	Secrets     []ServiceSecret `json:"secrets"`
+	Networks    NetworkList     `json:"networks"`
+	Labels      Labels          `json:"labels"`
+}
+
+// BuildConfig is the build section of a service, either a bare context string
+// or an object.
+type BuildConfig struct {
+	Context    string      `json:"context"`
+	Dockerfile string      `json:"dockerfile"`
+	Args       Environment `json:"args"`
+}
+
+func (b *BuildConfig) UnmarshalJSON(data []byte) error {
+	var context string
+	if err := json.Unmarshal(data, &context); err == nil {
+		b.Context = context
+		return nil
+	}
+	type buildConfig BuildConfig
+	out := buildConfig{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*b = BuildConfig(out)
+	return nil
+}
+
+// Command is a command or entrypoint, either a shell string or an exec array.
+type Command []string
+
+func (c *Command) UnmarshalJSON(data []byte) error {
+	var shell string
+	if err := json.Unmarshal(data, &shell); err == nil {
+		*c = []string{"/bin/sh", "-c", shell}
+		return nil
+	}
+	var args []string
+	if err := json.Unmarshal(data, &args); err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+// Environment is a set of environment variables, either a map or a list of
+// KEY=VALUE strings.
+type Environment map[string]string
+
+func (e *Environment) UnmarshalJSON(data []byte) error {
+	out := map[string]string{}
+	if err := json.Unmarshal(data, &out); err == nil {
+		*e = out
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	for _, entry := range list {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 1 {
+			out[parts[0]] = ""
+			continue
+		}
+		out[parts[0]] = parts[1]
+	}
+	*e = out
+	return nil
+}
+
+// Labels is a set of labels, either a map or a list of KEY=VALUE strings.
+type Labels map[string]string
+
+func (l *Labels) UnmarshalJSON(data []byte) error {
+	e := Environment{}
+	if err := e.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*l = Labels(e)
+	return nil
+}
+
+// PortConfig is a published port, either in the short "[HOST:]CONTAINER[/PROTO]"
+// string form or the long object form introduced in compose v3.2.
+type PortConfig struct {
+	Target    int    `json:"target"`
+	Published int    `json:"published"`
+	Protocol  string `json:"protocol"`
+	Mode      string `json:"mode"`
+}
+
+func (p *PortConfig) UnmarshalJSON(data []byte) error {
+	var number int
+	if err := json.Unmarshal(data, &number); err == nil {
+		p.Target = number
+		p.Published = number
+		return nil
+	}
+	var short string
+	if err := json.Unmarshal(data, &short); err == nil {
+		if i := strings.Index(short, "/"); i != -1 {
+			p.Protocol = short[i+1:]
+			short = short[:i]
+		}
+		parts := strings.Split(short, ":")
+		target, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			return fmt.Errorf("the port %q is not valid: %v", short, err)
+		}
+		p.Target = target
+		p.Published = target
+		if len(parts) > 1 {
+			published, err := strconv.Atoi(parts[len(parts)-2])
+			if err != nil {
+				return fmt.Errorf("the port %q is not valid: %v", short, err)
+			}
+			p.Published = published
+		}
+		return nil
+	}
+	type portConfig PortConfig
+	out := portConfig{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	if out.Published == 0 {
+		out.Published = out.Target
+	}
+	*p = PortConfig(out)
+	return nil
+}
+
+// Deploy is the deploy section of a service. Only fields that have an
+// equivalent on a deployment config are represented.
+type Deploy struct {
+	Replicas *int32 `json:"replicas"`
+	Labels   Labels `json:"labels"`
+}
+
+// HealthCheck is the healthcheck section of a service.
+type HealthCheck struct {
+	Test        Command `json:"test"`
+	Interval    string  `json:"interval"`
+	Timeout     string  `json:"timeout"`
+	Retries     int     `json:"retries"`
+	StartPeriod string  `json:"start_period"`
+	Disable     bool    `json:"disable"`
+}
+
+// ServiceSecret is a secret reference on a service, either a bare name or an
+// object with a source and target.
+type ServiceSecret struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func (s *ServiceSecret) UnmarshalJSON(data []byte) error {
+	var source string
+	if err := json.Unmarshal(data, &source); err == nil {
+		s.Source = source
+		return nil
+	}
+	type serviceSecret ServiceSecret
+	out := serviceSecret{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*s = ServiceSecret(out)
+	return nil
+}
+
+// NetworkList is the set of networks a service is attached to, either a list
+// of names or a map of name to service specific network config.
+type NetworkList []string
+
+func (n *NetworkList) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err == nil {
+		*n = names
+		return nil
+	}
+	byName := map[string]interface{}{}
+	if err := json.Unmarshal(data, &byName); err != nil {
+		return err
+	}
+	for name := range byName {
+		*n = append(*n, name)
+	}
+	return nil
+}
+
+// Network is a top level network definition.
+type Network struct {
+	Internal bool     `json:"internal"`
+	External External `json:"external"`
+}
+
+// SecretConfig is a top level secret definition.
+type SecretConfig struct {
+	File     string   `json:"file"`
+	External External `json:"external"`
+}
+
+// External marks a compose resource as managed outside the file, either as a
+// bare boolean or an object naming the existing resource.
+type External struct {
+	External bool   `json:"external"`
+	Name     string `json:"name"`
+}
+
+func (e *External) UnmarshalJSON(data []byte) error {
+	var external bool
+	if err := json.Unmarshal(data, &external); err == nil {
+		e.External = external
+		return nil
+	}
+	out := struct {
+		Name string `json:"name"`
+	}{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	e.External = true
+	e.Name = out.Name
+	return nil
+}
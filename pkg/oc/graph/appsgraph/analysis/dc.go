@@ -2,12 +2,15 @@ package analysis
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gonum/graph"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
 	kdeplutil "k8s.io/kubernetes/pkg/controller/deployment/util"
 
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	appsedges "github.com/openshift/origin/pkg/oc/graph/appsgraph"
 	appsgraph "github.com/openshift/origin/pkg/oc/graph/appsgraph/nodes"
@@ -19,9 +22,10 @@ import (
 )
 
 const (
-	MissingImageStreamErr        = "MissingImageStream"
-	MissingImageStreamTagWarning = "MissingImageStreamTag"
-	MissingReadinessProbeWarning = "MissingReadinessProbe"
+	MissingImageStreamErr          = "MissingImageStream"
+	MissingImageStreamTagWarning   = "MissingImageStreamTag"
+	MissingTriggerContainerWarning = "MissingTriggerContainer"
+	MissingReadinessProbeWarning   = "MissingReadinessProbe"
 
 	SingleHostVolumeWarning = "SingleHostVolume"
 	MissingPVCWarning       = "MissingPersistentVolumeClaim"
@@ -102,6 +106,56 @@ func doesImageStreamExist(g osgraph.Graph, istag graph.Node) (graph.Node, bool)
 	return nil, false
 }
 
+// FindDeploymentConfigTriggerContainerErrors checks for image change triggers that name
+// containers which do not exist in the pod template, making the trigger a silent no-op for
+// those containers.
+func FindDeploymentConfigTriggerContainerErrors(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastDcNode := range g.NodesByKind(appsgraph.DeploymentConfigNodeKind) {
+		dcNode := uncastDcNode.(*appsgraph.DeploymentConfigNode)
+		dc := dcNode.DeploymentConfig
+		if dc.Spec.Template == nil {
+			continue
+		}
+
+		containers := sets.NewString()
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			containers.Insert(container.Name)
+		}
+		for _, container := range dc.Spec.Template.Spec.InitContainers {
+			containers.Insert(container.Name)
+		}
+
+		for _, trigger := range dc.Spec.Triggers {
+			if trigger.Type != appsapi.DeploymentTriggerOnImageChange || trigger.ImageChangeParams == nil {
+				continue
+			}
+			missing := []string{}
+			for _, name := range trigger.ImageChangeParams.ContainerNames {
+				if !containers.Has(name) {
+					missing = append(missing, name)
+				}
+			}
+			if len(missing) == 0 {
+				continue
+			}
+			markers = append(markers, osgraph.Marker{
+				Node: uncastDcNode,
+
+				Severity: osgraph.WarningSeverity,
+				Key:      MissingTriggerContainerWarning,
+				Message: fmt.Sprintf("The image trigger for %s on %s names containers that do not exist in the pod template (%s), so those containers will never be updated.",
+					f.ResourceName(dcNode), trigger.ImageChangeParams.From.Name, strings.Join(missing, ", ")),
+				Suggestion: osgraph.Suggestion(fmt.Sprintf("oc set triggers %s --from-image=%s --containers=%s with a container that exists in the pod template",
+					f.ResourceName(dcNode), trigger.ImageChangeParams.From.Name, strings.Join(containers.List(), ","))),
+			})
+		}
+	}
+
+	return markers
+}
+
 // FindDeploymentConfigReadinessWarnings inspects deploymentconfigs and reports those that
 // don't have readiness probes set up.
 func FindDeploymentConfigReadinessWarnings(g osgraph.Graph, f osgraph.Namer, setProbeCommand string) []osgraph.Marker {
@@ -50,6 +50,22 @@ func TestMissingImageStream(t *testing.T) {
 	}
 }
 
+func TestMissingTriggerContainer(t *testing.T) {
+	g, _, err := osgraphtest.BuildGraph("../../../graph/genericgraph/test/dc-broken-trigger-container.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	markers := FindDeploymentConfigTriggerContainerErrors(g, osgraph.DefaultNamer)
+	if e, a := 1, len(markers); e != a {
+		t.Fatalf("expected %v, got %v", e, a)
+	}
+
+	if got, expected := markers[0].Key, MissingTriggerContainerWarning; got != expected {
+		t.Fatalf("expected marker key %q, got %q", expected, got)
+	}
+}
+
 func TestMissingReadinessProbe(t *testing.T) {
 	g, _, err := osgraphtest.BuildGraph("../../../graph/genericgraph/test/unpushable-build-2.yaml")
 	if err != nil {
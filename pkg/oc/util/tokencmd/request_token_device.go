@@ -0,0 +1,144 @@
+package tokencmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/oauth/util"
+)
+
+const (
+	// device authorization endpoints relative to the OAuth server root,
+	// copied from pkg/oauthserver/server/deviceauth/deviceauth.go
+	deviceAuthEndpoint  = "/device/auth"
+	deviceTokenEndpoint = "/device/token"
+
+	// defaultDevicePollInterval is used if the server does not suggest a polling interval
+	defaultDevicePollInterval = 5 * time.Second
+)
+
+// deviceAuthResponse follows the wire format of RFC 8628, section 3.2
+type deviceAuthResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int32  `json:"expires_in"`
+	Interval                int32  `json:"interval"`
+}
+
+// deviceTokenResponse follows the wire format of RFC 6749, sections 5.1 and 5.2
+type deviceTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+	Error       string `json:"error"`
+}
+
+// RequestTokenWithDeviceFlow locates an openshift oauth server and attempts to authenticate
+// via the device authorization grant.  It prints a verification URL and user code to out,
+// then polls the token endpoint until the user approves the request in a browser on any
+// machine, the request expires, or it is denied.  It returns the access token if it gets one
+// or an error if it does not.
+func RequestTokenWithDeviceFlow(clientCfg *restclient.Config, out io.Writer) (string, error) {
+	rt, err := restclient.TransportFor(clientCfg)
+	if err != nil {
+		return "", err
+	}
+
+	// get the OAuth metadata from the server
+	requestURL := strings.TrimRight(clientCfg.Host, "/") + oauthMetadataEndpoint
+	resp, err := request(rt, requestURL, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("couldn't get %v: unexpected response status %v", requestURL, resp.StatusCode)
+	}
+
+	metadata := &util.OauthAuthorizationServerMetadata{}
+	if err := json.NewDecoder(resp.Body).Decode(metadata); err != nil {
+		return "", err
+	}
+
+	// the device endpoints live next to the token endpoint
+	base := strings.TrimSuffix(metadata.TokenEndpoint, "/token")
+	authURL := base + deviceAuthEndpoint
+	tokenURL := base + deviceTokenEndpoint
+
+	auth := &deviceAuthResponse{}
+	if err := postForm(rt, authURL, url.Values{"client_id": {openShiftCLIClientID}}, auth); err != nil {
+		return "", err
+	}
+
+	verificationURI := auth.VerificationURIComplete
+	if len(verificationURI) == 0 {
+		verificationURI = auth.VerificationURI
+	}
+	fmt.Fprintf(out, "To complete login, open the following URL in a browser on any machine:\n\n    %s\n\nand enter the code: %s\n\n", verificationURI, auth.UserCode)
+
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	deadline := time.Now().Add(time.Duration(auth.ExpiresIn) * time.Second)
+
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+
+		token := &deviceTokenResponse{}
+		if err := postForm(rt, tokenURL, url.Values{"client_id": {openShiftCLIClientID}, "device_code": {auth.DeviceCode}}, token); err != nil {
+			return "", err
+		}
+
+		switch token.Error {
+		case "":
+			if len(token.AccessToken) > 0 {
+				return token.AccessToken, nil
+			}
+			return "", fmt.Errorf("token endpoint returned neither a token nor an error")
+		case "authorization_pending":
+			// the user has not approved the request yet, keep polling
+		case "slow_down":
+			interval += defaultDevicePollInterval
+		default:
+			return "", createOAuthError(token.Error, "")
+		}
+	}
+
+	return "", fmt.Errorf("timed out waiting for the device authorization to be approved")
+}
+
+// postForm posts the given values as a form and decodes the JSON response into data.
+// OAuth error responses are returned with their body decoded, so callers can inspect
+// the error field instead of failing on the status code.
+func postForm(rt http.RoundTripper, requestURL string, values url.Values, data interface{}) error {
+	req, err := http.NewRequest("POST", requestURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(csrfTokenHeader, "1")
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("couldn't post %v: unexpected response status %v", requestURL, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(data)
+}
@@ -0,0 +1,271 @@
+package internalversion
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1alpha1 "k8s.io/apimachinery/pkg/apis/meta/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/printers"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+// AddHandlers adds print handlers for origin types dealing with internal versions,
+// so the API server can answer Table requests with rich columns instead of relying
+// on client-side printers.
+func AddHandlers(h printers.PrintHandler) {
+	deploymentConfigColumnDefinitions := []metav1alpha1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Revision", Type: "integer", Description: "The latest deployed revision of this deployment config."},
+		{Name: "Desired", Type: "string", Description: "The desired number of replicas."},
+		{Name: "Current", Type: "integer", Description: "The number of replicas updated to the latest revision."},
+		{Name: "Triggers", Type: "string", Description: "The triggers that cause a new deployment to roll out."},
+	}
+	h.TableHandler(deploymentConfigColumnDefinitions, printDeploymentConfig)
+	h.TableHandler(deploymentConfigColumnDefinitions, printDeploymentConfigList)
+
+	imageStreamColumnDefinitions := []metav1alpha1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Docker Repo", Type: "string", Description: "The repository the image stream can be reached at."},
+		{Name: "Tags", Type: "string", Description: "The most recently updated tags and the total tag count."},
+		{Name: "Updated", Type: "string", Description: "The time at which the most recent image was imported or pushed."},
+	}
+	h.TableHandler(imageStreamColumnDefinitions, printImageStream)
+	h.TableHandler(imageStreamColumnDefinitions, printImageStreamList)
+
+	routeColumnDefinitions := []metav1alpha1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Host/Port", Type: "string", Description: "The host the route is exposed at, annotated with the admission state reported by routers."},
+		{Name: "Path", Type: "string", Description: "The path served by the route."},
+		{Name: "Services", Type: "string", Description: "The services the route sends traffic to."},
+		{Name: "Port", Type: "string", Description: "The target port on the backing service."},
+		{Name: "Termination", Type: "string", Description: "The TLS termination and insecure traffic policy of the route."},
+		{Name: "Wildcard", Type: "string", Priority: 1, Description: "The wildcard policy of the route."},
+	}
+	h.TableHandler(routeColumnDefinitions, printRoute)
+	h.TableHandler(routeColumnDefinitions, printRouteList)
+}
+
+// translateTimestamp returns the elapsed time since timestamp in human-readable approximation.
+func translateTimestamp(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return printers.ShortHumanDuration(time.Now().Sub(timestamp.Time))
+}
+
+func printDeploymentConfigList(list *appsapi.DeploymentConfigList, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	rows := make([]metav1alpha1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printDeploymentConfig(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printDeploymentConfig(dc *appsapi.DeploymentConfig, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	var desired string
+	if dc.Spec.Test {
+		desired = fmt.Sprintf("%d (during test)", dc.Spec.Replicas)
+	} else {
+		desired = fmt.Sprintf("%d", dc.Spec.Replicas)
+	}
+
+	containers := sets.NewString()
+	if dc.Spec.Template != nil {
+		for _, c := range dc.Spec.Template.Spec.Containers {
+			containers.Insert(c.Name)
+		}
+	}
+
+	triggers := sets.String{}
+	for _, trigger := range dc.Spec.Triggers {
+		switch t := trigger.Type; t {
+		case appsapi.DeploymentTriggerOnConfigChange:
+			triggers.Insert("config")
+		case appsapi.DeploymentTriggerOnImageChange:
+			if p := trigger.ImageChangeParams; p != nil && p.Automatic {
+				var prefix string
+				if len(containers) != 1 && !containers.HasAll(p.ContainerNames...) {
+					sort.Sort(sort.StringSlice(p.ContainerNames))
+					prefix = strings.Join(p.ContainerNames, ",") + ":"
+				}
+				switch p.From.Kind {
+				case "ImageStreamTag":
+					triggers.Insert(fmt.Sprintf("image(%s%s)", prefix, p.From.Name))
+				default:
+					triggers.Insert(fmt.Sprintf("%s(%s%s)", p.From.Kind, prefix, p.From.Name))
+				}
+			}
+		default:
+			triggers.Insert(string(t))
+		}
+	}
+
+	row := metav1alpha1.TableRow{
+		Object: runtime.RawExtension{Object: dc},
+	}
+	row.Cells = append(row.Cells, dc.Name, dc.Status.LatestVersion, desired, dc.Status.UpdatedReplicas, strings.Join(triggers.List(), ","))
+	return []metav1alpha1.TableRow{row}, nil
+}
+
+func printImageStreamList(list *imageapi.ImageStreamList, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	rows := make([]metav1alpha1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printImageStream(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printImageStream(stream *imageapi.ImageStream, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	const numOfTagsShown = 3
+
+	var latest metav1.Time
+	for _, list := range stream.Status.Tags {
+		if len(list.Items) > 0 {
+			if list.Items[0].Created.After(latest.Time) {
+				latest = list.Items[0].Created
+			}
+		}
+	}
+	latestTime := ""
+	if !latest.IsZero() {
+		latestTime = fmt.Sprintf("%s ago", translateTimestamp(latest))
+	}
+	list := imageapi.SortStatusTags(stream.Status.Tags)
+	more := false
+	if len(list) > numOfTagsShown {
+		list = list[:numOfTagsShown]
+		more = true
+	}
+	tags := strings.Join(list, ",")
+	if more {
+		tags = fmt.Sprintf("%s + %d more...", tags, len(stream.Status.Tags)-numOfTagsShown)
+	}
+
+	repo := stream.Spec.DockerImageRepository
+	if len(repo) == 0 {
+		repo = stream.Status.DockerImageRepository
+	}
+	if len(stream.Status.PublicDockerImageRepository) > 0 {
+		repo = stream.Status.PublicDockerImageRepository
+	}
+
+	row := metav1alpha1.TableRow{
+		Object: runtime.RawExtension{Object: stream},
+	}
+	row.Cells = append(row.Cells, stream.Name, repo, tags, latestTime)
+	return []metav1alpha1.TableRow{row}, nil
+}
+
+func printRouteList(list *routeapi.RouteList, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	rows := make([]metav1alpha1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printRoute(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printRoute(route *routeapi.Route, options printers.PrintOptions) ([]metav1alpha1.TableRow, error) {
+	tlsTerm := ""
+	insecurePolicy := ""
+	if route.Spec.TLS != nil {
+		tlsTerm = string(route.Spec.TLS.Termination)
+		insecurePolicy = string(route.Spec.TLS.InsecureEdgeTerminationPolicy)
+	}
+
+	var (
+		matchedHost bool
+		reason      string
+		host        = route.Spec.Host
+
+		admitted, errors = 0, 0
+	)
+	for _, ingress := range route.Status.Ingress {
+		switch status, condition := routeapi.IngressConditionStatus(&ingress, routeapi.RouteAdmitted); status {
+		case kapi.ConditionTrue:
+			admitted++
+			if !matchedHost {
+				matchedHost = ingress.Host == route.Spec.Host
+				host = ingress.Host
+			}
+		case kapi.ConditionFalse:
+			reason = condition.Reason
+			errors++
+		}
+	}
+	switch {
+	case route.Status.Ingress == nil:
+		// this is the legacy case, we should continue to show the host when talking to servers
+		// that have not set status ingress, since we can't distinguish this condition from there
+		// being no routers.
+	case admitted == 0 && errors > 0:
+		host = reason
+	case errors > 0:
+		host = fmt.Sprintf("%s ... %d rejected", host, errors)
+	case admitted == 0:
+		host = "Pending"
+	case admitted > 1:
+		host = fmt.Sprintf("%s ... %d more", host, admitted-1)
+	}
+	var policy string
+	switch {
+	case len(tlsTerm) != 0 && len(insecurePolicy) != 0:
+		policy = fmt.Sprintf("%s/%s", tlsTerm, insecurePolicy)
+	case len(tlsTerm) != 0:
+		policy = tlsTerm
+	case len(insecurePolicy) != 0:
+		policy = fmt.Sprintf("default/%s", insecurePolicy)
+	}
+
+	backends := append([]routeapi.RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...)
+	totalWeight := int32(0)
+	for _, backend := range backends {
+		if backend.Weight != nil {
+			totalWeight += *backend.Weight
+		}
+	}
+	var backendInfo []string
+	for _, backend := range backends {
+		switch {
+		case backend.Weight == nil, len(backends) == 1 && totalWeight != 0:
+			backendInfo = append(backendInfo, backend.Name)
+		case totalWeight == 0:
+			backendInfo = append(backendInfo, fmt.Sprintf("%s(0%%)", backend.Name))
+		default:
+			backendInfo = append(backendInfo, fmt.Sprintf("%s(%d%%)", backend.Name, *backend.Weight*100/totalWeight))
+		}
+	}
+
+	var port string
+	if route.Spec.Port != nil {
+		port = route.Spec.Port.TargetPort.String()
+	} else {
+		port = "<all>"
+	}
+
+	row := metav1alpha1.TableRow{
+		Object: runtime.RawExtension{Object: route},
+	}
+	row.Cells = append(row.Cells, route.Name, host, route.Spec.Path, strings.Join(backendInfo, ","), port, policy, string(route.Spec.WildcardPolicy))
+	return []metav1alpha1.TableRow{row}, nil
+}
@@ -0,0 +1,139 @@
+package internalversion
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/printers"
+
+	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
+	imageapi "github.com/openshift/origin/pkg/image/apis/image"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+func TestPrintDeploymentConfig(t *testing.T) {
+	dc := &appsapi.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: appsapi.DeploymentConfigSpec{
+			Replicas: 2,
+			Triggers: []appsapi.DeploymentTriggerPolicy{
+				{Type: appsapi.DeploymentTriggerOnConfigChange},
+				{
+					Type: appsapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &appsapi.DeploymentTriggerImageChangeParams{
+						Automatic:      true,
+						ContainerNames: []string{"test"},
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "test:latest"},
+					},
+				},
+			},
+		},
+		Status: appsapi.DeploymentConfigStatus{LatestVersion: 3, UpdatedReplicas: 2},
+	}
+
+	rows, err := printDeploymentConfig(dc, printers.PrintOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected one row, got %d", len(rows))
+	}
+	cells := rows[0].Cells
+	if e, a := "test", cells[0]; e != a {
+		t.Errorf("expected name %q, got %q", e, a)
+	}
+	if e, a := int64(3), cells[1]; e != a {
+		t.Errorf("expected revision %v, got %v", e, a)
+	}
+	if e, a := "config,image(test:latest)", cells[4]; e != a {
+		t.Errorf("expected triggers %q, got %q", e, a)
+	}
+}
+
+func TestPrintImageStream(t *testing.T) {
+	now := time.Now()
+	stream := &imageapi.ImageStream{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Status: imageapi.ImageStreamStatus{
+			DockerImageRepository: "registry.test/default/test",
+			Tags: map[string]imageapi.TagEventList{
+				"v1":     {Items: []imageapi.TagEvent{{Created: metav1.NewTime(now.Add(-4 * time.Hour))}}},
+				"v2":     {Items: []imageapi.TagEvent{{Created: metav1.NewTime(now.Add(-3 * time.Hour))}}},
+				"v3":     {Items: []imageapi.TagEvent{{Created: metav1.NewTime(now.Add(-2 * time.Hour))}}},
+				"latest": {Items: []imageapi.TagEvent{{Created: metav1.NewTime(now.Add(-time.Hour))}}},
+			},
+		},
+	}
+
+	rows, err := printImageStream(stream, printers.PrintOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cells := rows[0].Cells
+	if e, a := "registry.test/default/test", cells[1]; e != a {
+		t.Errorf("expected repo %q, got %q", e, a)
+	}
+	if e, a := "latest,v3,v2 + 1 more...", cells[2]; e != a {
+		t.Errorf("expected tags %q, got %q", e, a)
+	}
+}
+
+func TestPrintRouteAdmittedStatus(t *testing.T) {
+	weight := int32(100)
+	testCases := []struct {
+		name     string
+		ingress  []routeapi.RouteIngress
+		expected string
+	}{
+		{
+			name:     "no status",
+			ingress:  nil,
+			expected: "spec.example.com",
+		},
+		{
+			name: "admitted",
+			ingress: []routeapi.RouteIngress{
+				{
+					Host:       "admitted.example.com",
+					Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: "True"}},
+				},
+			},
+			expected: "admitted.example.com",
+		},
+		{
+			name: "rejected",
+			ingress: []routeapi.RouteIngress{
+				{
+					Host:       "rejected.example.com",
+					Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: "False", Reason: "HostAlreadyClaimed"}},
+				},
+			},
+			expected: "HostAlreadyClaimed",
+		},
+		{
+			name:     "pending",
+			ingress:  []routeapi.RouteIngress{{Host: "pending.example.com"}},
+			expected: "Pending",
+		},
+	}
+
+	for _, tc := range testCases {
+		route := &routeapi.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: routeapi.RouteSpec{
+				Host: "spec.example.com",
+				To:   routeapi.RouteTargetReference{Kind: "Service", Name: "svc", Weight: &weight},
+			},
+			Status: routeapi.RouteStatus{Ingress: tc.ingress},
+		}
+		rows, err := printRoute(route, printers.PrintOptions{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if e, a := tc.expected, rows[0].Cells[1]; e != a {
+			t.Errorf("%s: expected host %q, got %q", tc.name, e, a)
+		}
+	}
+}
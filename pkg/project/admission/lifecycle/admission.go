@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/origin/pkg/api/latest"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	projectapi "github.com/openshift/origin/pkg/project/apis/project"
 	"github.com/openshift/origin/pkg/project/cache"
 	projectutil "github.com/openshift/origin/pkg/project/util"
 )
@@ -58,20 +59,47 @@ var recommendedCreatableResources = map[schema.GroupResource]bool{
 var _ = oadmission.WantsProjectCache(&lifecycle{})
 var _ = kadmission.WantsInternalKubeClientSet(&lifecycle{})
 
-// Admit enforces that a namespace must have the openshift finalizer associated with it in order to create origin API objects within it
+// Admit enforces that a namespace must have the openshift finalizer associated with it in order to create origin API objects within it.
+// It also rejects creates of any kind, kube or origin, in projects held in the deletion trash.
 func (e *lifecycle) Admit(a admission.Attributes) (err error) {
 	if len(a.GetNamespace()) == 0 {
 		return nil
 	}
-	// only pay attention to origin resources
-	if !latest.OriginKind(a.GetKind()) {
-		return nil
-	}
 	// always allow creatable resources through.  These requests should always be allowed.
 	if e.creatableResources[a.GetResource().GroupResource()] {
 		return nil
 	}
 
+	if e.cache == nil || !e.cache.Running() {
+		// the cache is required to tell whether the project is in the trash;
+		// kube kinds are let through so the kube admission chain alone decides
+		// them during startup, matching the previous behavior of this plugin
+		if !latest.OriginKind(a.GetKind()) {
+			return nil
+		}
+		return admission.NewForbidden(a, err)
+	}
+
+	namespace, err := e.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		if !latest.OriginKind(a.GetKind()) {
+			return nil
+		}
+		return admission.NewForbidden(a, err)
+	}
+
+	// projects held in the trash are read-only until restored or reaped.  The
+	// annotation marks the whole project, so this applies to kube and origin
+	// kinds alike.
+	if _, trashed := namespace.Annotations[projectapi.ProjectDeleteAfter]; trashed {
+		return admission.NewForbidden(a, fmt.Errorf("project %s is pending deletion; remove the %s annotation to restore it", a.GetNamespace(), projectapi.ProjectDeleteAfter))
+	}
+
+	// only origin resources need the finalizer bookkeeping below
+	if !latest.OriginKind(a.GetKind()) {
+		return nil
+	}
+
 	groupMeta, err := legacyscheme.Registry.Group(a.GetKind().Group)
 	if err != nil {
 		return err
@@ -85,15 +113,6 @@ func (e *lifecycle) Admit(a admission.Attributes) (err error) {
 		return nil
 	}
 
-	if !e.cache.Running() {
-		return admission.NewForbidden(a, err)
-	}
-
-	namespace, err := e.cache.GetNamespace(a.GetNamespace())
-	if err != nil {
-		return admission.NewForbidden(a, err)
-	}
-
 	// in case of concurrency issues, we will retry this logic
 	numRetries := 10
 	interval := time.Duration(rand.Int63n(90)+int64(10)) * time.Millisecond
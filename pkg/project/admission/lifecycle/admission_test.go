@@ -14,6 +14,7 @@ import (
 
 	buildapi "github.com/openshift/origin/pkg/build/apis/build"
 	testtypes "github.com/openshift/origin/pkg/project/admission/lifecycle/testing"
+	projectapi "github.com/openshift/origin/pkg/project/apis/project"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
 
 	// install all APIs
@@ -74,6 +75,29 @@ func TestAdmissionExists(t *testing.T) {
 	}
 }
 
+// TestTrashedProjectRejectsKubeKinds verifies that creates of kube kinds are
+// rejected in projects that are pending deletion, not just origin kinds
+func TestTrashedProjectRejectsKubeKinds(t *testing.T) {
+	store := projectcache.NewCacheStore(cache.MetaNamespaceKeyFunc)
+	store.Add(&kapi.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "trashed",
+			Annotations: map[string]string{projectapi.ProjectDeleteAfter: "2026-01-01T00:00:00Z"},
+		},
+	})
+	mockClient := &fake.Clientset{}
+	projectCache := projectcache.NewFake(mockClient.Core().Namespaces(), store, "")
+
+	handler := &lifecycle{client: fake.NewSimpleClientset(), creatableResources: recommendedCreatableResources}
+	handler.SetProjectCache(projectCache)
+
+	pod := &kapi.Pod{ObjectMeta: metav1.ObjectMeta{Name: "podid"}}
+	err := handler.Admit(admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("v1"), "trashed", "podid", kapi.Resource("pods").WithVersion("v1"), "", "CREATE", nil))
+	if err == nil {
+		t.Errorf("Expected an error creating a kube kind in a project pending deletion")
+	}
+}
+
 func TestSAR(t *testing.T) {
 	store := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
 	mockClient := &fake.Clientset{}
@@ -60,7 +60,18 @@ func (p *podNodeEnvironment) Admit(a admission.Attributes) (err error) {
 	}
 	projectNodeSelector, err := p.cache.GetNodeSelectorMap(namespace)
 	if err != nil {
-		return err
+		// the project node selector may use set-based expressions (In, NotIn,
+		// Exists) which the equality parser rejects.  Those are enforced through
+		// node affinity rather than the pod node selector map.
+		requirements, exprErr := p.cache.GetNodeSelectorRequirements(namespace)
+		if exprErr != nil {
+			return err
+		}
+		if conflicts(requirements, pod.Spec.NodeSelector) {
+			return apierrors.NewForbidden(resource, name, fmt.Errorf("pod node label selector conflicts with its project node label selector"))
+		}
+		addNodeSelectorRequirements(pod, requirements)
+		return nil
 	}
 
 	if labelselector.Conflicts(projectNodeSelector, pod.Spec.NodeSelector) {
@@ -73,6 +84,63 @@ func (p *podNodeEnvironment) Admit(a admission.Attributes) (err error) {
 	return nil
 }
 
+// conflicts returns true if the pod node selector pins a label to a value that
+// can never satisfy one of the project node selector requirements.
+func conflicts(requirements []kapi.NodeSelectorRequirement, podNodeSelector map[string]string) bool {
+	for _, requirement := range requirements {
+		value, ok := podNodeSelector[requirement.Key]
+		if !ok {
+			continue
+		}
+		switch requirement.Operator {
+		case kapi.NodeSelectorOpIn:
+			if !hasValue(requirement.Values, value) {
+				return true
+			}
+		case kapi.NodeSelectorOpNotIn:
+			if hasValue(requirement.Values, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// addNodeSelectorRequirements appends the project node selector requirements
+// to every required node affinity term on the pod, creating the affinity
+// structure as needed.  Terms are ORed by the scheduler, so each term must
+// carry the project requirements.
+func addNodeSelectorRequirements(pod *kapi.Pod, requirements []kapi.NodeSelectorRequirement) {
+	if len(requirements) == 0 {
+		return
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &kapi.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &kapi.NodeAffinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &kapi.NodeSelector{
+			NodeSelectorTerms: []kapi.NodeSelectorTerm{{}},
+		}
+	}
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirements...)
+	}
+	pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+}
+
 func (p *podNodeEnvironment) SetProjectCache(c *cache.ProjectCache) {
 	p.cache = c
 }
@@ -1,6 +1,7 @@
 package nodeenv
 
 import (
+	"reflect"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -125,6 +126,97 @@ func TestPodAdmission(t *testing.T) {
 	}
 }
 
+// TestPodAdmissionExpressionSelector verifies that set-based project node
+// selectors are applied through required node affinity
+func TestPodAdmissionExpressionSelector(t *testing.T) {
+	project := &kapi.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testProject",
+			Namespace: "",
+		},
+	}
+	projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+	projectStore.Add(project)
+
+	mockClientset := fake.NewSimpleClientset()
+	handler := &podNodeEnvironment{client: mockClientset}
+
+	tests := []struct {
+		projectNodeSelector string
+		podNodeSelector     map[string]string
+		matchExpressions    []kapi.NodeSelectorRequirement
+		admit               bool
+		testName            string
+	}{
+		{
+			projectNodeSelector: "region in (east,west)",
+			podNodeSelector:     map[string]string{},
+			matchExpressions: []kapi.NodeSelectorRequirement{
+				{Key: "region", Operator: kapi.NodeSelectorOpIn, Values: []string{"east", "west"}},
+			},
+			admit:    true,
+			testName: "In expression becomes node affinity",
+		},
+		{
+			projectNodeSelector: "infra notin (true),gpu",
+			podNodeSelector:     map[string]string{},
+			matchExpressions: []kapi.NodeSelectorRequirement{
+				{Key: "gpu", Operator: kapi.NodeSelectorOpExists, Values: []string{}},
+				{Key: "infra", Operator: kapi.NodeSelectorOpNotIn, Values: []string{"true"}},
+			},
+			admit:    true,
+			testName: "NotIn and Exists expressions become node affinity",
+		},
+		{
+			projectNodeSelector: "region in (east,west)",
+			podNodeSelector:     map[string]string{"region": "north"},
+			admit:               false,
+			testName:            "Pod node selector conflicting with an In expression",
+		},
+		{
+			projectNodeSelector: "infra notin (true)",
+			podNodeSelector:     map[string]string{"infra": "true"},
+			admit:               false,
+			testName:            "Pod node selector conflicting with a NotIn expression",
+		},
+	}
+	for _, test := range tests {
+		cache := projectcache.NewFake(mockClientset.Core().Namespaces(), projectStore, "")
+		handler.SetProjectCache(cache)
+		project.ObjectMeta.Annotations = map[string]string{"openshift.io/node-selector": test.projectNodeSelector}
+		pod := &kapi.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "testPod"},
+			Spec:       kapi.PodSpec{NodeSelector: test.podNodeSelector},
+		}
+
+		err := handler.Admit(admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), "namespace", project.ObjectMeta.Name, kapi.Resource("pods").WithVersion("version"), "", admission.Create, nil))
+		if test.admit && err != nil {
+			t.Errorf("Test: %s, expected no error but got: %s", test.testName, err)
+			continue
+		} else if !test.admit && err == nil {
+			t.Errorf("Test: %s, expected an error", test.testName)
+			continue
+		}
+		if !test.admit {
+			continue
+		}
+
+		affinity := pod.Spec.Affinity
+		if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+			t.Errorf("Test: %s, expected required node affinity to be set", test.testName)
+			continue
+		}
+		terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		if len(terms) != 1 {
+			t.Errorf("Test: %s, expected a single node selector term, got %d", test.testName, len(terms))
+			continue
+		}
+		if !reflect.DeepEqual(test.matchExpressions, terms[0].MatchExpressions) {
+			t.Errorf("Test: %s, expected: %v but got: %v", test.testName, test.matchExpressions, terms[0].MatchExpressions)
+		}
+	}
+}
+
 func TestHandles(t *testing.T) {
 	for op, shouldHandle := range map[admission.Operation]bool{
 		admission.Create:  true,
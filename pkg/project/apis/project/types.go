@@ -64,4 +64,9 @@ const (
 	// ProjectRequester is the username that requested a given project.  Its not guaranteed to be present,
 	// but it is set by the default project template.
 	ProjectRequester = "openshift.io/requester"
+	// ProjectDeleteAfter holds the RFC3339 time after which a trashed project is finally deleted.
+	// Removing the annotation before the deadline restores the project.
+	ProjectDeleteAfter = "openshift.io/delete-after"
+	// ProjectTrashedBy is the username that requested deletion of a trashed project.
+	ProjectTrashedBy = "openshift.io/trashed-by"
 )
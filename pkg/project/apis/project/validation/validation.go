@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/validation/path"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/apis/core/validation"
 
@@ -107,8 +108,12 @@ func validateNodeSelector(p *projectapi.Project) field.ErrorList {
 	if len(p.Annotations) > 0 {
 		if selector, ok := p.Annotations[projectapi.ProjectNodeSelector]; ok {
 			if _, err := labelselector.Parse(selector); err != nil {
-				allErrs = append(allErrs, field.Invalid(field.NewPath("nodeSelector"),
-					p.Annotations[projectapi.ProjectNodeSelector], "must be a valid label selector"))
+				// fall back to the full label selector syntax, which allows
+				// set-based expressions such as In, NotIn and Exists
+				if _, exprErr := labels.Parse(selector); exprErr != nil {
+					allErrs = append(allErrs, field.Invalid(field.NewPath("nodeSelector"),
+						p.Annotations[projectapi.ProjectNodeSelector], "must be a valid label selector"))
+				}
 			}
 		}
 	}
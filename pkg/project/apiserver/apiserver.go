@@ -2,6 +2,7 @@ package apiserver
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -9,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	restclient "k8s.io/client-go/rest"
@@ -29,10 +31,12 @@ import (
 type ExtraConfig struct {
 	KubeAPIServerClientConfig *restclient.Config
 	KubeInternalInformers     kinternalinformers.SharedInformerFactory
-	ProjectAuthorizationCache *projectauth.AuthorizationCache
-	ProjectCache              *projectcache.ProjectCache
-	ProjectRequestTemplate    string
-	ProjectRequestMessage     string
+	ProjectAuthorizationCache       *projectauth.AuthorizationCache
+	ProjectCache                    *projectcache.ProjectCache
+	ProjectRequestTemplate          string
+	ProjectRequestTemplateSelectors []configapi.ProjectRequestTemplateSelector
+	ProjectRequestMessage           string
+	ProjectTrashGracePeriodMinutes  int
 
 	// TODO these should all become local eventually
 	Scheme   *runtime.Scheme
@@ -126,7 +130,7 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 		return nil, err
 	}
 
-	projectStorage := projectproxy.NewREST(kubeInternalClient.Core().Namespaces(), c.ExtraConfig.ProjectAuthorizationCache, c.ExtraConfig.ProjectAuthorizationCache, c.ExtraConfig.ProjectCache)
+	projectStorage := projectproxy.NewREST(kubeInternalClient.Core().Namespaces(), c.ExtraConfig.ProjectAuthorizationCache, c.ExtraConfig.ProjectAuthorizationCache, c.ExtraConfig.ProjectCache, time.Duration(c.ExtraConfig.ProjectTrashGracePeriodMinutes)*time.Minute)
 
 	namespace, templateName, err := configapi.ParseNamespaceAndName(c.ExtraConfig.ProjectRequestTemplate)
 	if err != nil {
@@ -134,9 +138,24 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 		// we can continue on, the storage that gets created will be valid, it simply won't work properly.  There's no reason to kill the master
 	}
 
+	var templateSelectors []projectrequeststorage.TemplateSelector
+	for _, selector := range c.ExtraConfig.ProjectRequestTemplateSelectors {
+		selectorNamespace, selectorName, err := configapi.ParseNamespaceAndName(selector.Template)
+		if err != nil {
+			glog.Errorf("Error parsing project request template selector value: %v", err)
+			continue
+		}
+		templateSelectors = append(templateSelectors, projectrequeststorage.TemplateSelector{
+			Groups:            sets.NewString(selector.Groups...),
+			TemplateNamespace: selectorNamespace,
+			TemplateName:      selectorName,
+		})
+	}
+
 	projectRequestStorage := projectrequeststorage.NewREST(
 		c.ExtraConfig.ProjectRequestMessage,
 		namespace, templateName,
+		templateSelectors,
 		projectClient.Project(),
 		templateClient,
 		authorizationClient.SubjectAccessReviews(),
@@ -7,6 +7,8 @@ import (
 	"github.com/golang/glog"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/cache"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
@@ -98,6 +100,47 @@ func (p *ProjectCache) GetNodeSelectorMap(namespace *kapi.Namespace) (map[string
 	return labelsMap, nil
 }
 
+// GetNodeSelectorRequirements parses the node selector for the namespace as a
+// full label selector, allowing set-based expressions (In, NotIn, Exists,
+// DoesNotExist) in addition to the equality form returned by
+// GetNodeSelectorMap.
+func (p *ProjectCache) GetNodeSelectorRequirements(namespace *kapi.Namespace) ([]kapi.NodeSelectorRequirement, error) {
+	selector, err := labels.Parse(p.GetNodeSelector(namespace))
+	if err != nil {
+		return nil, err
+	}
+	labelRequirements, selectable := selector.Requirements()
+	if !selectable {
+		return nil, nil
+	}
+
+	requirements := []kapi.NodeSelectorRequirement{}
+	for _, requirement := range labelRequirements {
+		nodeSelectorRequirement := kapi.NodeSelectorRequirement{
+			Key:    requirement.Key(),
+			Values: requirement.Values().List(),
+		}
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpIn
+		case selection.NotEquals, selection.NotIn:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpNotIn
+		case selection.Exists:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpExists
+		case selection.DoesNotExist:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpDoesNotExist
+		case selection.GreaterThan:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpGt
+		case selection.LessThan:
+			nodeSelectorRequirement.Operator = kapi.NodeSelectorOpLt
+		default:
+			return nil, fmt.Errorf("unsupported node selector operator %q", requirement.Operator())
+		}
+		requirements = append(requirements, nodeSelectorRequirement)
+	}
+	return requirements, nil
+}
+
 // Run waits until the cache has synced.
 func (c *ProjectCache) Run(stopCh <-chan struct{}) {
 	defer runtime.HandleCrash()
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	informers "k8s.io/client-go/informers/core/v1"
+	kclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/golang/glog"
+	projectapi "github.com/openshift/origin/pkg/project/apis/project"
+)
+
+// ProjectTrashController deletes namespaces whose trash grace period has
+// expired.  A project enters the trash when the project proxy annotates it
+// with a delete-after timestamp; removing the annotation before the deadline
+// restores the project.
+type ProjectTrashController struct {
+	client kclientset.Interface
+
+	queue      workqueue.RateLimitingInterface
+	maxRetries int
+
+	controller cache.Controller
+	cache      cache.Store
+
+	// extracted for testing
+	syncHandler func(key string) error
+}
+
+func NewProjectTrashController(namespaces informers.NamespaceInformer, client kclientset.Interface) *ProjectTrashController {
+	c := &ProjectTrashController{
+		client:     client,
+		controller: namespaces.Informer().GetController(),
+		cache:      namespaces.Informer().GetStore(),
+		queue:      workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		maxRetries: 10,
+	}
+	namespaces.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.enqueueNamespace(obj)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				c.enqueueNamespace(newObj)
+			},
+		},
+		10*time.Minute,
+	)
+
+	c.syncHandler = c.syncNamespace
+	return c
+}
+
+// Run starts the workers for this controller.
+func (c *ProjectTrashController) Run(stopCh <-chan struct{}, workers int) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Wait for the stores to fill
+	if !cache.WaitForCacheSync(stopCh, c.controller.HasSynced) {
+		return
+	}
+
+	glog.V(5).Infof("Starting workers")
+	for i := 0; i < workers; i++ {
+		go c.worker()
+	}
+	<-stopCh
+	glog.V(1).Infof("Shutting down")
+}
+
+func (c *ProjectTrashController) enqueueNamespace(obj interface{}) {
+	ns, ok := obj.(*v1.Namespace)
+	if !ok {
+		return
+	}
+	if _, trashed := ns.Annotations[projectapi.ProjectDeleteAfter]; !trashed {
+		return
+	}
+	c.queue.Add(ns.Name)
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (c *ProjectTrashController) worker() {
+	for {
+		if !c.work() {
+			return
+		}
+	}
+}
+
+// work returns true if the worker thread should continue
+func (c *ProjectTrashController) work() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncHandler(key.(string)); err == nil {
+		// this means the request was successfully handled.  We should "forget" the item so that any retry
+		// later on is reset
+		c.queue.Forget(key)
+	} else {
+		// if we had an error it means that we didn't handle it, which means that we want to requeue the work
+		runtime.HandleError(fmt.Errorf("error syncing namespace, it will be retried: %v", err))
+		c.queue.AddRateLimited(key)
+	}
+	return true
+}
+
+// syncNamespace will sync the namespace with the given key.
+// This function is not meant to be invoked concurrently with the same key.
+func (c *ProjectTrashController) syncNamespace(key string) error {
+	item, exists, err := c.cache.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	return c.reap(item.(*v1.Namespace))
+}
+
+// reap deletes the namespace if its trash deadline has passed.  Namespaces
+// whose deadline is still in the future are requeued for the remaining
+// duration, so a restored project (annotation removed) is simply dropped on
+// the next sync.
+func (c *ProjectTrashController) reap(namespace *v1.Namespace) error {
+	value, trashed := namespace.Annotations[projectapi.ProjectDeleteAfter]
+	if !trashed {
+		return nil
+	}
+	if namespace.Status.Phase == v1.NamespaceTerminating {
+		return nil
+	}
+
+	deleteAfter, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("namespace %s has an unparseable %s annotation: %v", namespace.Name, projectapi.ProjectDeleteAfter, err))
+		return nil
+	}
+
+	if remaining := deleteAfter.Sub(time.Now()); remaining > 0 {
+		c.queue.AddAfter(namespace.Name, remaining)
+		return nil
+	}
+
+	glog.V(2).Infof("Deleting namespace %s, its trash grace period expired at %s", namespace.Name, value)
+	err = c.client.Core().Namespaces().Delete(namespace.Name, metav1.NewPreconditionDeleteOptions(string(namespace.UID)))
+	if kerrors.IsNotFound(err) || kerrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
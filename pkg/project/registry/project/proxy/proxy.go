@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"fmt"
+	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -36,6 +37,11 @@ type REST struct {
 	// Allows extended behavior during updates, required
 	updateStrategy rest.RESTUpdateStrategy
 
+	// trashGracePeriod, if non-zero, causes Delete to hold the project in a
+	// restorable trash state for the given duration instead of deleting the
+	// namespace immediately.
+	trashGracePeriod time.Duration
+
 	authCache    *projectauth.AuthorizationCache
 	projectCache *projectcache.ProjectCache
 }
@@ -46,13 +52,15 @@ var _ rest.Deleter = &REST{}
 var _ rest.Watcher = &REST{}
 
 // NewREST returns a RESTStorage object that will work against Project resources
-func NewREST(client kcoreclient.NamespaceInterface, lister projectauth.Lister, authCache *projectauth.AuthorizationCache, projectCache *projectcache.ProjectCache) *REST {
+func NewREST(client kcoreclient.NamespaceInterface, lister projectauth.Lister, authCache *projectauth.AuthorizationCache, projectCache *projectcache.ProjectCache, trashGracePeriod time.Duration) *REST {
 	return &REST{
 		client:         client,
 		lister:         lister,
 		createStrategy: projectregistry.Strategy,
 		updateStrategy: projectregistry.Strategy,
 
+		trashGracePeriod: trashGracePeriod,
+
 		authCache:    authCache,
 		projectCache: projectCache,
 	}
@@ -185,8 +193,31 @@ func (s *REST) Update(ctx apirequest.Context, name string, objInfo rest.UpdatedO
 
 var _ = rest.Deleter(&REST{})
 
-// Delete deletes a Project specified by its name
+// Delete deletes a Project specified by its name.  If a trash grace period is
+// configured, the first delete only marks the project for deletion after the
+// grace period elapses; a cluster admin or the project owner can restore the
+// project within the window by removing the delete-after annotation.  Deleting
+// a project that is already trashed removes it immediately.
 func (s *REST) Delete(ctx apirequest.Context, name string) (runtime.Object, error) {
+	if s.trashGracePeriod > 0 {
+		namespace, err := s.client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if _, trashed := namespace.Annotations[projectapi.ProjectDeleteAfter]; !trashed {
+			if namespace.Annotations == nil {
+				namespace.Annotations = map[string]string{}
+			}
+			namespace.Annotations[projectapi.ProjectDeleteAfter] = time.Now().Add(s.trashGracePeriod).UTC().Format(time.RFC3339)
+			if user, ok := apirequest.UserFrom(ctx); ok {
+				namespace.Annotations[projectapi.ProjectTrashedBy] = user.GetName()
+			}
+			if _, err := s.client.Update(namespace); err != nil {
+				return nil, err
+			}
+			return &metav1.Status{Status: metav1.StatusSuccess}, nil
+		}
+	}
 	return &metav1.Status{Status: metav1.StatusSuccess}, s.client.Delete(name, nil)
 }
 
@@ -72,7 +72,7 @@ func TestCreateProjectBadObject(t *testing.T) {
 
 func TestCreateInvalidProject(t *testing.T) {
 	mockClient := &fake.Clientset{}
-	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil)
+	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil, 0)
 	_, err := storage.Create(apirequest.NewContext(), &projectapi.Project{
 		ObjectMeta: metav1.ObjectMeta{
 			Annotations: map[string]string{oapi.OpenShiftDisplayName: "h\t\ni"},
@@ -85,7 +85,7 @@ func TestCreateInvalidProject(t *testing.T) {
 
 func TestCreateProjectOK(t *testing.T) {
 	mockClient := &fake.Clientset{}
-	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil)
+	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil, 0)
 	_, err := storage.Create(apirequest.NewContext(), &projectapi.Project{
 		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
 	}, rest.ValidateAllObjectFunc, false)
@@ -102,7 +102,7 @@ func TestCreateProjectOK(t *testing.T) {
 
 func TestGetProjectOK(t *testing.T) {
 	mockClient := fake.NewSimpleClientset(&kapi.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})
-	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil)
+	storage := NewREST(mockClient.Core().Namespaces(), &mockLister{}, nil, nil, 0)
 	project, err := storage.Get(apirequest.NewContext(), "foo", &metav1.GetOptions{})
 	if project == nil {
 		t.Error("Unexpected nil project")
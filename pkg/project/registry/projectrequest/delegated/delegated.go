@@ -3,7 +3,10 @@ package delegated
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -40,10 +43,21 @@ import (
 	restutil "github.com/openshift/origin/pkg/util/rest"
 )
 
+// TemplateSelector selects a project request template for users that belong
+// to any of the listed groups.
+type TemplateSelector struct {
+	Groups            sets.String
+	TemplateNamespace string
+	TemplateName      string
+}
+
 type REST struct {
 	message           string
 	templateNamespace string
 	templateName      string
+	// templateSelectors is an ordered list of per-group template overrides;
+	// the first selector matching one of the requesting user's groups wins.
+	templateSelectors []TemplateSelector
 
 	sarClient      authorizationclient.SubjectAccessReviewInterface
 	projectGetter  projectclientinternal.ProjectsGetter
@@ -53,16 +67,25 @@ type REST struct {
 	// policyBindings is an auth cache that is shared with the authorizer for the API server.
 	// we use this cache to detect when the authorizer has observed the change for the auth rules
 	roleBindings rbaclisters.RoleBindingLister
+
+	// sequence numbers the project requests served by this process; it backs the
+	// PROJECT_REQUEST_SEQUENCE template parameter.  Accessed atomically.
+	sequence uint64
 }
 
+// requesterEmailExtraKey is the user extra info key consulted for the requester's
+// email.  Identity providers that know the email can surface it here.
+const requesterEmailExtraKey = "email"
+
 var _ rest.Lister = &REST{}
 var _ rest.Creater = &REST{}
 
-func NewREST(message, templateNamespace, templateName string, projectClient projectclientinternal.ProjectsGetter, templateClient templateclient.Interface, sarClient authorizationclient.SubjectAccessReviewInterface, restConfig *restclient.Config, roleBindings rbaclisters.RoleBindingLister) *REST {
+func NewREST(message, templateNamespace, templateName string, templateSelectors []TemplateSelector, projectClient projectclientinternal.ProjectsGetter, templateClient templateclient.Interface, sarClient authorizationclient.SubjectAccessReviewInterface, restConfig *restclient.Config, roleBindings rbaclisters.RoleBindingLister) *REST {
 	return &REST{
 		message:           message,
 		templateNamespace: templateNamespace,
 		templateName:      templateName,
+		templateSelectors: templateSelectors,
 		projectGetter:     projectClient,
 		templateClient:    templateClient,
 		sarClient:         sarClient,
@@ -117,12 +140,18 @@ func (r *REST) Create(ctx apirequest.Context, obj runtime.Object, createValidati
 	projectName := projectRequest.Name
 	projectAdmin := ""
 	projectRequester := ""
+	projectRequesterEmail := ""
+	var projectRequesterGroups []string
 	if userInfo, exists := apirequest.UserFrom(ctx); exists {
 		projectAdmin = userInfo.GetName()
 		projectRequester = userInfo.GetName()
+		projectRequesterGroups = userInfo.GetGroups()
+		if emails := userInfo.GetExtra()[requesterEmailExtraKey]; len(emails) > 0 {
+			projectRequesterEmail = emails[0]
+		}
 	}
 
-	template, err := r.getTemplate()
+	template, err := r.getTemplate(projectRequesterGroups)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +168,14 @@ func (r *REST) Create(ctx apirequest.Context, obj runtime.Object, createValidati
 			template.Parameters[i].Value = projectName
 		case ProjectRequesterParam:
 			template.Parameters[i].Value = projectRequester
+		case ProjectRequesterEmailParam:
+			template.Parameters[i].Value = projectRequesterEmail
+		case ProjectRequesterGroupsParam:
+			template.Parameters[i].Value = strings.Join(projectRequesterGroups, ",")
+		case ProjectTimestampParam:
+			template.Parameters[i].Value = time.Now().UTC().Format(time.RFC3339)
+		case ProjectSequenceParam:
+			template.Parameters[i].Value = strconv.FormatUint(atomic.AddUint64(&r.sequence, 1), 10)
 		}
 	}
 
@@ -245,7 +282,14 @@ func (r *REST) waitForRoleBinding(namespace, name string) {
 	}
 }
 
-func (r *REST) getTemplate() (*templateapi.Template, error) {
+func (r *REST) getTemplate(groups []string) (*templateapi.Template, error) {
+	for _, selector := range r.templateSelectors {
+		if !selector.Groups.HasAny(groups...) {
+			continue
+		}
+		return r.templateClient.Template().Templates(selector.TemplateNamespace).Get(selector.TemplateName, metav1.GetOptions{})
+	}
+
 	if len(r.templateNamespace) == 0 || len(r.templateName) == 0 {
 		return DefaultTemplate(), nil
 	}
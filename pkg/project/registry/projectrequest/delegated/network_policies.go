@@ -0,0 +1,89 @@
+package delegated
+
+import (
+	"fmt"
+
+	networkingapiv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	networkingapi "k8s.io/kubernetes/pkg/apis/networking"
+
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+)
+
+const (
+	// DenyAllPolicyName blocks all ingress traffic to pods in the project.
+	DenyAllPolicyName = "deny-all"
+	// AllowSameNamespacePolicyName permits traffic between pods in the same project.
+	AllowSameNamespacePolicyName = "allow-same-namespace"
+	// AllowFromRouterPolicyName permits traffic from namespaces labeled as hosting
+	// cluster ingress (such as the namespace running the router).
+	AllowFromRouterPolicyName = "allow-from-router"
+
+	// ingressPolicyGroupLabel is the label the networkpolicy plugin recognizes on
+	// namespaces that provide cluster ingress.
+	ingressPolicyGroupLabel = "network.openshift.io/policy-group"
+	ingressPolicyGroupValue = "ingress"
+)
+
+// DefaultNetworkPolicyNames lists the NetworkPolicies that may be added to a
+// project request template, in the order they are emitted.
+var DefaultNetworkPolicyNames = []string{DenyAllPolicyName, AllowSameNamespacePolicyName, AllowFromRouterPolicyName}
+
+// AddDefaultNetworkPolicies appends the NetworkPolicies named by policyNames to
+// the given project request template so that new projects are isolated by
+// default when the networkpolicy network plugin is in use.  The policy names
+// must come from DefaultNetworkPolicyNames.
+func AddDefaultNetworkPolicies(template *templateapi.Template, policyNames []string) error {
+	ns := "${" + ProjectNameParam + "}"
+
+	for _, policyName := range policyNames {
+		policy, err := defaultNetworkPolicy(policyName, ns)
+		if err != nil {
+			return err
+		}
+		if err := templateapi.AddObjectsToTemplate(template, []runtime.Object{policy}, networkingapiv1.SchemeGroupVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func defaultNetworkPolicy(policyName, ns string) (*networkingapi.NetworkPolicy, error) {
+	policy := &networkingapi.NetworkPolicy{}
+	policy.Name = policyName
+	policy.Namespace = ns
+
+	switch policyName {
+	case DenyAllPolicyName:
+		// an empty pod selector with no ingress rules isolates every pod in the namespace
+
+	case AllowSameNamespacePolicyName:
+		policy.Spec.Ingress = []networkingapi.NetworkPolicyIngressRule{
+			{
+				From: []networkingapi.NetworkPolicyPeer{
+					{PodSelector: &metav1.LabelSelector{}},
+				},
+			},
+		}
+
+	case AllowFromRouterPolicyName:
+		policy.Spec.Ingress = []networkingapi.NetworkPolicyIngressRule{
+			{
+				From: []networkingapi.NetworkPolicyPeer{
+					{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{ingressPolicyGroupLabel: ingressPolicyGroupValue},
+						},
+					},
+				},
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown default network policy %q, valid values are: %v", policyName, DefaultNetworkPolicyNames)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,21 @@
+package delegated
+
+import (
+	"testing"
+)
+
+func TestAddDefaultNetworkPolicies(t *testing.T) {
+	template := DefaultTemplate()
+	baseObjects := len(template.Objects)
+
+	if err := AddDefaultNetworkPolicies(template, DefaultNetworkPolicyNames); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := baseObjects + len(DefaultNetworkPolicyNames); len(template.Objects) != expected {
+		t.Errorf("expected %d template objects, got %d", expected, len(template.Objects))
+	}
+
+	if err := AddDefaultNetworkPolicies(DefaultTemplate(), []string{"bogus"}); err == nil {
+		t.Error("expected an error for an unknown policy name")
+	}
+}
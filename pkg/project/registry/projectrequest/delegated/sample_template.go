@@ -20,10 +20,21 @@ const (
 	ProjectDescriptionParam = "PROJECT_DESCRIPTION"
 	ProjectAdminUserParam   = "PROJECT_ADMIN_USER"
 	ProjectRequesterParam   = "PROJECT_REQUESTING_USER"
+	// ProjectRequesterEmailParam carries the email of the requesting user, if the
+	// authentication layer provided one in the user's extra info.
+	ProjectRequesterEmailParam = "PROJECT_REQUESTING_USER_EMAIL"
+	// ProjectRequesterGroupsParam carries the groups of the requesting user as a
+	// comma separated list.
+	ProjectRequesterGroupsParam = "PROJECT_REQUESTING_USER_GROUPS"
+	// ProjectTimestampParam carries the RFC3339 time at which the project was requested.
+	ProjectTimestampParam = "PROJECT_REQUEST_TIMESTAMP"
+	// ProjectSequenceParam carries a number that increases with every project request
+	// served by this apiserver process.
+	ProjectSequenceParam = "PROJECT_REQUEST_SEQUENCE"
 )
 
 var (
-	parameters = []string{ProjectNameParam, ProjectDisplayNameParam, ProjectDescriptionParam, ProjectAdminUserParam, ProjectRequesterParam}
+	parameters = []string{ProjectNameParam, ProjectDisplayNameParam, ProjectDescriptionParam, ProjectAdminUserParam, ProjectRequesterParam, ProjectRequesterEmailParam, ProjectRequesterGroupsParam, ProjectTimestampParam, ProjectSequenceParam}
 )
 
 func DefaultTemplate() *templateapi.Template {
@@ -51,9 +51,18 @@ func NewEventSignaler(eventRecorder record.EventRecorder) NeedPodsSignaler {
 
 // NewUnidlerProxier creates a new Proxier for the given LoadBalancer and address which fires off
 // unidling signals connections and traffic.  It is intended to be used as one half of a HybridProxier.
-func NewUnidlerProxier(loadBalancer userspace.LoadBalancer, listenIP net.IP, iptables iptables.Interface, exec utilexec.Interface, pr utilnet.PortRange, syncPeriod, minSyncPeriod, udpIdleTimeout time.Duration, signaler NeedPodsSignaler) (*userspace.Proxier, error) {
+// Initial TCP connections to an idled service are held open (up to maxHeldConnections per service,
+// each for at most holdTimeout) while the service awakens, instead of being reset.  Passing zero
+// for either limit selects the corresponding default.
+func NewUnidlerProxier(loadBalancer userspace.LoadBalancer, listenIP net.IP, iptables iptables.Interface, exec utilexec.Interface, pr utilnet.PortRange, syncPeriod, minSyncPeriod, udpIdleTimeout time.Duration, maxHeldConnections int, holdTimeout time.Duration, signaler NeedPodsSignaler) (*userspace.Proxier, error) {
+	if maxHeldConnections <= 0 {
+		maxHeldConnections = DefaultMaxHeldConnections
+	}
+	if holdTimeout <= 0 {
+		holdTimeout = DefaultHoldTimeout
+	}
 	newFunc := func(protocol api.Protocol, ip net.IP, port int) (userspace.ProxySocket, error) {
-		return newUnidlerSocket(protocol, ip, port, signaler)
+		return newUnidlerSocket(protocol, ip, port, maxHeldConnections, holdTimeout, signaler)
 	}
 	return userspace.NewCustomProxier(loadBalancer, listenIP, iptables, exec, pr, syncPeriod, minSyncPeriod, udpIdleTimeout, newFunc)
 }
@@ -105,17 +105,23 @@ func (l *connectionList) Clear() {
 	l.conns = []heldConn{}
 }
 
-var (
-	// MaxHeldConnections is the maximum number of TCP connections per service that
-	// will be held by the unidler at once (new connections will cause older ones
-	// to be dropped after the limit is reached)
-	MaxHeldConnections = 16
+const (
+	// DefaultMaxHeldConnections is the default maximum number of TCP connections
+	// per service that will be held by the unidler at once (new connections will
+	// cause older ones to be dropped after the limit is reached)
+	DefaultMaxHeldConnections = 16
+
+	// DefaultHoldTimeout is the default length of time a held TCP connection
+	// will be kept open while waiting for an idled service to awaken
+	DefaultHoldTimeout = 120 * time.Second
+)
 
-	needPodsWaitTimeout = 120 * time.Second
+var (
+	needPodsWaitTimeout = DefaultHoldTimeout
 	needPodsTickLen     = 5 * time.Second
 )
 
-func newUnidlerSocket(protocol api.Protocol, ip net.IP, port int, signaler NeedPodsSignaler) (userspace.ProxySocket, error) {
+func newUnidlerSocket(protocol api.Protocol, ip net.IP, port int, maxHeldConns int, holdTimeout time.Duration, signaler NeedPodsSignaler) (userspace.ProxySocket, error) {
 	host := ""
 	if ip != nil {
 		host = ip.String()
@@ -127,7 +133,7 @@ func newUnidlerSocket(protocol api.Protocol, ip net.IP, port int, signaler NeedP
 		if err != nil {
 			return nil, err
 		}
-		return &tcpUnidlerSocket{Listener: listener, port: port, signaler: signaler}, nil
+		return &tcpUnidlerSocket{Listener: listener, port: port, maxHeldConns: maxHeldConns, holdTimeout: holdTimeout, signaler: signaler}, nil
 	case "UDP":
 		addr, err := net.ResolveUDPAddr("udp", net.JoinHostPort(host, strconv.Itoa(port)))
 		if err != nil {
@@ -146,8 +152,10 @@ func newUnidlerSocket(protocol api.Protocol, ip net.IP, port int, signaler NeedP
 // no new connections are allowed but existing connections are left untouched.
 type tcpUnidlerSocket struct {
 	net.Listener
-	port     int
-	signaler NeedPodsSignaler
+	port         int
+	maxHeldConns int
+	holdTimeout  time.Duration
+	signaler     NeedPodsSignaler
 }
 
 func (tcp *tcpUnidlerSocket) ListenPort() int {
@@ -206,7 +214,7 @@ func (tcp *tcpUnidlerSocket) awaitAwakening(service proxy.ServicePortName, loadB
 	ticker := time.NewTicker(needPodsTickLen)
 	defer ticker.Stop()
 	svcName := fmt.Sprintf("%s/%s:%s", service.Namespace, service.Name, service.Port)
-	allConns := newConnectionList(MaxHeldConnections, needPodsTickLen, needPodsWaitTimeout, svcName)
+	allConns := newConnectionList(tcp.maxHeldConns, needPodsTickLen, tcp.holdTimeout, svcName)
 
 	for {
 		select {
@@ -275,7 +283,7 @@ func (tcp *tcpUnidlerSocket) ProxyLoop(service proxy.ServicePortName, svcInfo *u
 			close(endpointsAvail)
 			// this shouldn't happen (ok should always be false)
 		}
-	case <-time.NewTimer(needPodsWaitTimeout).C:
+	case <-time.NewTimer(tcp.holdTimeout).C:
 		if allConns.Len() > 0 {
 			utilruntime.HandleError(fmt.Errorf("timed out %v TCP connections while waiting for idled service %s/%s:%s to awaken.", allConns.Len(), service.Namespace, service.Name, service.Port))
 			allConns.Clear()
@@ -128,6 +128,9 @@ func (a *runOnceDuration) applyProjectAnnotationLimit(namespace string, pod *kap
 	if err != nil {
 		return false, fmt.Errorf("cannot parse the ActiveDeadlineSeconds limit (%s) for project %s: %v", limit, ns.Name, err)
 	}
+	if limitInt64 < 0 {
+		return false, fmt.Errorf("the ActiveDeadlineSeconds limit (%s) for project %s must not be negative", limit, ns.Name)
+	}
 	pod.Spec.ActiveDeadlineSeconds = int64MinP(&limitInt64, pod.Spec.ActiveDeadlineSeconds)
 	return true, nil
 }
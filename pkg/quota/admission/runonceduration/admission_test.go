@@ -156,6 +156,18 @@ func TestRunOnceDurationAdmit(t *testing.T) {
 	}
 }
 
+func TestRunOnceDurationRejectsNegativeProjectLimit(t *testing.T) {
+	runOnceDuration := NewRunOnceDuration(testConfig(int64p(10)))
+	runOnceDuration.(oadmission.WantsProjectCache).SetProjectCache(testCache(map[string]string{
+		runonceduration.ActiveDeadlineSecondsLimitAnnotation: "-100",
+	}))
+	pod := testRunOncePod()
+	attrs := admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), "default", "test", kapi.Resource("pods").WithVersion("version"), "", admission.Create, nil)
+	if err := runOnceDuration.(admission.MutationInterface).Admit(attrs); err == nil {
+		t.Errorf("expected admission error for a negative project limit annotation")
+	}
+}
+
 func TestReadConfig(t *testing.T) {
 	configStr := `apiVersion: v1
 kind: RunOnceDurationConfig
@@ -61,6 +61,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterResourceQuotaList{},
 		&AppliedClusterResourceQuota{},
 		&AppliedClusterResourceQuotaList{},
+		&ClusterResourceQuotaUsage{},
 	)
 	return nil
 }
@@ -71,6 +72,7 @@ func addLegacyKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterResourceQuotaList{},
 		&AppliedClusterResourceQuota{},
 		&AppliedClusterResourceQuotaList{},
+		&ClusterResourceQuotaUsage{},
 	}
 	scheme.AddKnownTypes(LegacySchemeGroupVersion, types...)
 	return nil
@@ -104,6 +104,33 @@ type AppliedClusterResourceQuotaList struct {
 	Items []AppliedClusterResourceQuota
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceQuotaUsage reports the consumption of a ClusterResourceQuota
+// sliced by selected namespace, so the namespaces eating the shared budget can
+// be identified without summing ResourceQuota statuses by hand.  It is
+// returned by the usage subresource of ClusterResourceQuota.
+type ClusterResourceQuotaUsage struct {
+	metav1.TypeMeta
+	// Standard object's metadata.
+	metav1.ObjectMeta
+
+	// Total defines the actual enforced quota and its current usage across all projects
+	Total kapi.ResourceQuotaStatus
+
+	// Namespaces holds the usage for each selected project
+	Namespaces []ClusterResourceQuotaNamespaceUsage
+}
+
+// ClusterResourceQuotaNamespaceUsage holds the quota usage of a single selected project
+type ClusterResourceQuotaNamespaceUsage struct {
+	// Namespace is the name of the project this usage applies to
+	Namespace string
+
+	// Status indicates the quota and its current usage within this project
+	Status kapi.ResourceQuotaStatus
+}
+
 // ResourceQuotasStatusByNamespace provides type correct methods
 type ResourceQuotasStatusByNamespace struct {
 	orderedMap orderedMap
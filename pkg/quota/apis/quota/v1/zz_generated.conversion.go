@@ -36,6 +36,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_quota_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec,
 		Convert_v1_ClusterResourceQuotaStatus_To_quota_ClusterResourceQuotaStatus,
 		Convert_quota_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus,
+		Convert_v1_ClusterResourceQuotaUsage_To_quota_ClusterResourceQuotaUsage,
+		Convert_quota_ClusterResourceQuotaUsage_To_v1_ClusterResourceQuotaUsage,
 	)
 }
 
@@ -268,3 +270,53 @@ func autoConvert_quota_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStat
 func Convert_quota_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(in *quota.ClusterResourceQuotaStatus, out *v1.ClusterResourceQuotaStatus, s conversion.Scope) error {
 	return autoConvert_quota_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(in, out, s)
 }
+
+func autoConvert_v1_ClusterResourceQuotaUsage_To_quota_ClusterResourceQuotaUsage(in *v1.ClusterResourceQuotaUsage, out *quota.ClusterResourceQuotaUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := core_v1.Convert_v1_ResourceQuotaStatus_To_core_ResourceQuotaStatus(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]quota.ClusterResourceQuotaNamespaceUsage, len(*in))
+		for i := range *in {
+			(*out)[i].Namespace = (*in)[i].Namespace
+			if err := core_v1.Convert_v1_ResourceQuotaStatus_To_core_ResourceQuotaStatus(&(*in)[i].Status, &(*out)[i].Status, s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterResourceQuotaUsage_To_quota_ClusterResourceQuotaUsage is an autogenerated conversion function.
+func Convert_v1_ClusterResourceQuotaUsage_To_quota_ClusterResourceQuotaUsage(in *v1.ClusterResourceQuotaUsage, out *quota.ClusterResourceQuotaUsage, s conversion.Scope) error {
+	return autoConvert_v1_ClusterResourceQuotaUsage_To_quota_ClusterResourceQuotaUsage(in, out, s)
+}
+
+func autoConvert_quota_ClusterResourceQuotaUsage_To_v1_ClusterResourceQuotaUsage(in *quota.ClusterResourceQuotaUsage, out *v1.ClusterResourceQuotaUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := core_v1.Convert_core_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(v1.ResourceQuotasStatusByNamespace, len(*in))
+		for i := range *in {
+			(*out)[i].Namespace = (*in)[i].Namespace
+			if err := core_v1.Convert_core_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(&(*in)[i].Status, &(*out)[i].Status, s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+// Convert_quota_ClusterResourceQuotaUsage_To_v1_ClusterResourceQuotaUsage is an autogenerated conversion function.
+func Convert_quota_ClusterResourceQuotaUsage_To_v1_ClusterResourceQuotaUsage(in *quota.ClusterResourceQuotaUsage, out *v1.ClusterResourceQuotaUsage, s conversion.Scope) error {
+	return autoConvert_quota_ClusterResourceQuotaUsage_To_v1_ClusterResourceQuotaUsage(in, out, s)
+}
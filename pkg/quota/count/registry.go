@@ -0,0 +1,53 @@
+// Package count implements object count evaluators for origin resources so
+// their totals can be capped through ClusterResourceQuota selectors, not only
+// through per-namespace ResourceQuota objects. They are supposed to be passed
+// to the resource quota controllers and the origin resource quota admission
+// plugin.
+package count
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubernetes/pkg/quota"
+	"k8s.io/kubernetes/pkg/quota/generic"
+
+	buildapi "github.com/openshift/origin/pkg/build/apis/build"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+// countedResources are the origin resources whose object counts are enforced,
+// matched by the count/<resource>.<group> quota resource name.
+var countedResources = []schema.GroupVersionResource{
+	buildapi.SchemeGroupVersion.WithResource("builds"),
+	buildapi.SchemeGroupVersion.WithResource("buildconfigs"),
+	routeapi.SchemeGroupVersion.WithResource("routes"),
+}
+
+// NewReplenishmentEvaluators returns the evaluators used by the resource quota
+// controllers to count origin objects.
+func NewReplenishmentEvaluators(f quota.ListerForResourceFunc) []quota.Evaluator {
+	result := []quota.Evaluator{}
+	for _, gvr := range countedResources {
+		result = append(result,
+			generic.NewObjectCountEvaluator(false, gvr.GroupResource(), generic.ListResourceUsingListerFunc(f, gvr), ""))
+	}
+	return result
+}
+
+// NewReplenishmentEvaluatorsForAdmission returns the evaluators used by the
+// origin resource quota admission plugin.
+// This is different than what is used for reconciliation because admission has to check all forms of a resource (legacy and groupified), but
+// reconciliation only has to check one.
+func NewReplenishmentEvaluatorsForAdmission() []quota.Evaluator {
+	result := []quota.Evaluator{}
+	for _, gvr := range countedResources {
+		result = append(result,
+			generic.NewObjectCountEvaluator(false, gvr.GroupResource(), generic.ListResourceUsingListerFunc(nil, gvr), ""))
+	}
+	// add the handling for the old resources
+	result = append(result,
+		generic.NewObjectCountEvaluator(false, buildapi.LegacyResource("builds"), generic.ListResourceUsingListerFunc(nil, buildapi.SchemeGroupVersion.WithResource("builds")), ""),
+		generic.NewObjectCountEvaluator(false, buildapi.LegacyResource("buildconfigs"), generic.ListResourceUsingListerFunc(nil, buildapi.SchemeGroupVersion.WithResource("buildconfigs")), ""),
+		generic.NewObjectCountEvaluator(false, routeapi.LegacyResource("routes"), generic.ListResourceUsingListerFunc(nil, routeapi.SchemeGroupVersion.WithResource("routes")), ""),
+	)
+	return result
+}
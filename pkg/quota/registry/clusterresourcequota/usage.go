@@ -0,0 +1,52 @@
+package clusterresourcequota
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	quotaapi "github.com/openshift/origin/pkg/quota/apis/quota"
+	quotalister "github.com/openshift/origin/pkg/quota/generated/listers/quota/internalversion"
+)
+
+// UsageREST implements the usage subresource of ClusterResourceQuota.  It
+// projects the per-namespace slices already maintained in the quota status
+// into a ClusterResourceQuotaUsage so admins can see which of the selected
+// namespaces is consuming the shared budget.
+type UsageREST struct {
+	quotaLister quotalister.ClusterResourceQuotaLister
+}
+
+func NewUsageREST(quotaLister quotalister.ClusterResourceQuotaLister) *UsageREST {
+	return &UsageREST{
+		quotaLister: quotaLister,
+	}
+}
+
+var _ rest.Getter = &UsageREST{}
+
+func (r *UsageREST) New() runtime.Object {
+	return &quotaapi.ClusterResourceQuotaUsage{}
+}
+
+func (r *UsageREST) Get(ctx apirequest.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	clusterQuota, err := r.quotaLister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &quotaapi.ClusterResourceQuotaUsage{
+		ObjectMeta: clusterQuota.ObjectMeta,
+		Total:      *clusterQuota.Status.Total.DeepCopy(),
+	}
+	for e := clusterQuota.Status.Namespaces.OrderedKeys().Front(); e != nil; e = e.Next() {
+		namespace := e.Value.(string)
+		status, _ := clusterQuota.Status.Namespaces.Get(namespace)
+		usage.Namespaces = append(usage.Namespaces, quotaapi.ClusterResourceQuotaNamespaceUsage{
+			Namespace: namespace,
+			Status:    *status.DeepCopy(),
+		})
+	}
+	return usage, nil
+}
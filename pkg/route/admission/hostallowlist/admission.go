@@ -0,0 +1,128 @@
+// Package hostallowlist contains an admission plugin that restricts the
+// hostnames routes in a namespace may claim to an allowlist published on the
+// namespace by the cluster administrator.
+package hostallowlist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/project/cache"
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+const (
+	// PluginName is the name of the route hostname allowlist admission plugin.
+	PluginName = "route.openshift.io/RouteHostnameAllowlist"
+
+	// HostnameAllowlistAnnotation is a namespace annotation holding a comma
+	// separated list of hostname patterns that routes in the namespace may
+	// use. A pattern is either a full hostname or a wildcard of the form
+	// *.suffix matching exactly one additional label. Namespaces without the
+	// annotation are not restricted.
+	HostnameAllowlistAnnotation = "route.openshift.io/allowed-hostnames"
+)
+
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName,
+		func(config io.Reader) (admission.Interface, error) {
+			return NewHostnameAllowlist(), nil
+		})
+}
+
+// hostnameAllowlist is an implementation of admission.Interface that rejects
+// routes whose host is not covered by the namespace's hostname allowlist.
+type hostnameAllowlist struct {
+	*admission.Handler
+	cache *cache.ProjectCache
+}
+
+var _ = oadmission.WantsProjectCache(&hostnameAllowlist{})
+
+// NewHostnameAllowlist returns an admission plugin enforcing per-namespace
+// route hostname allowlists.
+func NewHostnameAllowlist() *hostnameAllowlist {
+	return &hostnameAllowlist{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+func (h *hostnameAllowlist) Admit(a admission.Attributes) error {
+	resource := a.GetResource().GroupResource()
+	if resource != routeapi.Resource("routes") && resource != routeapi.LegacyResource("routes") {
+		return nil
+	}
+	if len(a.GetSubresource()) > 0 {
+		return nil
+	}
+	route, ok := a.GetObject().(*routeapi.Route)
+	if !ok {
+		return nil
+	}
+	// an empty host will be allocated by the route allocator and is always allowed
+	if len(route.Spec.Host) == 0 {
+		return nil
+	}
+
+	if !h.cache.Running() {
+		return nil
+	}
+	namespace, err := h.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		return apierrors.NewForbidden(resource, route.Name, err)
+	}
+	allowlist, exists := namespace.Annotations[HostnameAllowlistAnnotation]
+	if !exists {
+		return nil
+	}
+
+	if !hostMatchesAllowlist(route.Spec.Host, allowlist) {
+		return apierrors.NewForbidden(resource, route.Name,
+			fmt.Errorf("hostname %q is not permitted in namespace %q - allowed hostnames: %s", route.Spec.Host, a.GetNamespace(), allowlist))
+	}
+	return nil
+}
+
+func (h *hostnameAllowlist) SetProjectCache(c *cache.ProjectCache) {
+	h.cache = c
+}
+
+func (h *hostnameAllowlist) ValidateInitialization() error {
+	if h.cache == nil {
+		return fmt.Errorf("%s plugin needs a project cache", PluginName)
+	}
+	return nil
+}
+
+// hostMatchesAllowlist returns true if host matches any pattern in the comma
+// separated allowlist. A leading "*." in a pattern matches exactly one
+// additional DNS label.
+func hostMatchesAllowlist(host, allowlist string) bool {
+	for _, pattern := range strings.Split(allowlist, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) == 0 {
+			continue
+		}
+		if matchesHostPattern(host, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesHostPattern(host, pattern string) bool {
+	if !strings.HasPrefix(pattern, "*.") {
+		return host == pattern
+	}
+	suffix := pattern[1:] // keep the leading dot
+	if !strings.HasSuffix(host, suffix) {
+		return false
+	}
+	label := strings.TrimSuffix(host, suffix)
+	return len(label) > 0 && !strings.Contains(label, ".")
+}
@@ -0,0 +1,29 @@
+package hostallowlist
+
+import (
+	"testing"
+)
+
+func TestHostMatchesAllowlist(t *testing.T) {
+	tests := []struct {
+		host      string
+		allowlist string
+		expected  bool
+	}{
+		{"www.example.com", "www.example.com", true},
+		{"www.example.com", "other.example.com", false},
+		{"www.example.com", "other.example.com,www.example.com", true},
+		{"www.example.com", " www.example.com ", true},
+		{"app.apps.example.com", "*.apps.example.com", true},
+		{"deep.app.apps.example.com", "*.apps.example.com", false},
+		{"apps.example.com", "*.apps.example.com", false},
+		{"www.example.com", "", false},
+		{"www.example.com", "*.example.com,shop.example.com", true},
+		{"shop.example.com", "*.apps.example.com,shop.example.com", true},
+	}
+	for _, test := range tests {
+		if actual := hostMatchesAllowlist(test.host, test.allowlist); actual != test.expected {
+			t.Errorf("host %q allowlist %q: expected %v, got %v", test.host, test.allowlist, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package acme
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	routeclient "github.com/openshift/origin/pkg/route/generated/internalclientset/typed/route/internalversion"
+)
+
+const (
+	// AcmeEnabledAnnotation marks a route as managed by the ACME controller.
+	// Only the value "true" enables management; any other value is ignored.
+	AcmeEnabledAnnotation = "kubernetes.io/tls-acme"
+	// AcmeExpiryAnnotation records the NotAfter time of the most recently
+	// issued certificate in RFC3339 format.
+	AcmeExpiryAnnotation = "acme.openshift.io/expiry"
+	// AcmeErrorAnnotation records the last issuance error for the route.
+	AcmeErrorAnnotation = "acme.openshift.io/error"
+
+	// renewBefore is how long before expiry a certificate is renewed.
+	renewBefore = 30 * 24 * time.Hour
+)
+
+// AcmeController watches routes annotated for ACME management and keeps a
+// valid certificate on them, performing HTTP-01 challenges by exposing
+// temporary challenge routes through the router.
+type AcmeController struct {
+	routeClient routeclient.RoutesGetter
+
+	issuer Issuer
+	solver ChallengeSolver
+
+	// queue contains keys of routes that need to be checked
+	queue      workqueue.RateLimitingInterface
+	maxRetries int
+
+	store     cache.Store
+	informer  cache.Controller
+	hasSynced cache.InformerSynced
+
+	// syncHandler does the work. It's factored out for unit testing
+	syncHandler func(key string) error
+}
+
+// NewAcmeController creates a controller that issues and renews certificates
+// for routes annotated with AcmeEnabledAnnotation=true.
+func NewAcmeController(routeClient routeclient.RoutesGetter, routes routeclient.RouteInterface, issuer Issuer, solver ChallengeSolver, resyncInterval time.Duration) *AcmeController {
+	c := &AcmeController{
+		routeClient: routeClient,
+		issuer:      issuer,
+		solver:      solver,
+		queue:       workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		maxRetries:  10,
+	}
+
+	c.store, c.informer = cache.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return routes.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return routes.Watch(options)
+			},
+		},
+		&routeapi.Route{},
+		resyncInterval,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueueRoute,
+			UpdateFunc: func(old, cur interface{}) {
+				c.enqueueRoute(cur)
+			},
+		},
+	)
+	c.hasSynced = c.informer.HasSynced
+
+	c.syncHandler = c.syncRoute
+
+	return c
+}
+
+// Run begins watching and syncing.
+func (c *AcmeController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	glog.Infof("Starting ACME route controller")
+	defer glog.Infof("Shutting down ACME route controller")
+
+	go c.informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (c *AcmeController) enqueueRoute(obj interface{}) {
+	route, ok := obj.(*routeapi.Route)
+	if !ok {
+		return
+	}
+	if route.Annotations[AcmeEnabledAnnotation] != "true" {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *AcmeController) worker() {
+	for c.work() {
+	}
+}
+
+// work returns true if the worker thread should continue
+func (c *AcmeController) work() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncHandler(key.(string)); err == nil {
+		c.queue.Forget(key)
+	} else {
+		if c.queue.NumRequeues(key) > c.maxRetries {
+			utilruntime.HandleError(fmt.Errorf("error syncing route %v: %v", key, err))
+			c.queue.Forget(key)
+		} else {
+			c.queue.AddRateLimited(key)
+		}
+	}
+
+	return true
+}
+
+func (c *AcmeController) syncRoute(key string) error {
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	route := obj.(*routeapi.Route)
+
+	if route.Annotations[AcmeEnabledAnnotation] != "true" {
+		return nil
+	}
+	if len(route.Spec.Host) == 0 {
+		return nil
+	}
+	if route.Spec.TLS != nil && route.Spec.TLS.Termination == routeapi.TLSTerminationPassthrough {
+		// HTTP-01 challenges cannot be answered for passthrough routes and the
+		// router has no place to install the issued certificate.
+		glog.V(2).Infof("Ignoring passthrough route %s/%s annotated for ACME", route.Namespace, route.Name)
+		return nil
+	}
+
+	if !needsCertificate(route) {
+		return nil
+	}
+
+	certPEM, keyPEM, err := c.issuer.Issue(route.Spec.Host, c.solver)
+	if err != nil {
+		c.recordError(route, err)
+		return fmt.Errorf("unable to obtain certificate for route %s/%s host %q: %v", route.Namespace, route.Name, route.Spec.Host, err)
+	}
+
+	routeCopy := route.DeepCopy()
+	if routeCopy.Spec.TLS == nil {
+		routeCopy.Spec.TLS = &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge}
+	}
+	routeCopy.Spec.TLS.Certificate = string(certPEM)
+	routeCopy.Spec.TLS.Key = string(keyPEM)
+	if expiry, err := certificateExpiry(certPEM); err == nil {
+		routeCopy.Annotations[AcmeExpiryAnnotation] = expiry.Format(time.RFC3339)
+	}
+	delete(routeCopy.Annotations, AcmeErrorAnnotation)
+
+	_, err = c.routeClient.Routes(routeCopy.Namespace).Update(routeCopy)
+	if kapierrors.IsConflict(err) {
+		// the route changed underneath us, requeue and recompute against the
+		// newer version
+		return err
+	}
+	return err
+}
+
+// recordError best-effort records the issuance failure on the route so that
+// users can see why their route has no certificate.
+func (c *AcmeController) recordError(route *routeapi.Route, issueErr error) {
+	routeCopy := route.DeepCopy()
+	routeCopy.Annotations[AcmeErrorAnnotation] = issueErr.Error()
+	if _, err := c.routeClient.Routes(routeCopy.Namespace).Update(routeCopy); err != nil {
+		glog.V(2).Infof("Unable to record ACME failure on route %s/%s: %v", route.Namespace, route.Name, err)
+	}
+}
+
+// needsCertificate returns true if the route has no usable certificate for
+// its host, or if the certificate it has expires within the renewal window.
+func needsCertificate(route *routeapi.Route) bool {
+	if route.Spec.TLS == nil || len(route.Spec.TLS.Certificate) == 0 {
+		return true
+	}
+	cert, err := parseCertificate([]byte(route.Spec.TLS.Certificate))
+	if err != nil {
+		return true
+	}
+	if err := cert.VerifyHostname(route.Spec.Host); err != nil {
+		return true
+	}
+	return time.Now().Add(renewBefore).After(cert.NotAfter)
+}
+
+func certificateExpiry(certPEM []byte) (time.Time, error) {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+func parseCertificate(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no certificate found in PEM data")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
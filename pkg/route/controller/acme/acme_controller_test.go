@@ -0,0 +1,72 @@
+package acme
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+func TestNeedsCertificate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tls      *routeapi.TLSConfig
+		expected bool
+	}{
+		{
+			name:     "no tls config",
+			tls:      nil,
+			expected: true,
+		},
+		{
+			name:     "empty certificate",
+			tls:      &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+			expected: true,
+		},
+		{
+			name:     "garbage certificate",
+			tls:      &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "not a pem"},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		route := &routeapi.Route{
+			Spec: routeapi.RouteSpec{
+				Host: "www.example.com",
+				TLS:  test.tls,
+			},
+		}
+		if actual := needsCertificate(route); actual != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestChallengeResponder(t *testing.T) {
+	responder := NewChallengeResponder()
+	responder.Set("token1", "token1.thumbprint")
+
+	req := httptest.NewRequest("GET", challengePathPrefix+"token1", nil)
+	w := httptest.NewRecorder()
+	responder.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	if w.Body.String() != "token1.thumbprint" {
+		t.Errorf("unexpected body %q", w.Body.String())
+	}
+
+	responder.Remove("token1")
+	w = httptest.NewRecorder()
+	responder.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404 after removal, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	responder.ServeHTTP(w, httptest.NewRequest("GET", "/other/path", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for non-challenge path, got %d", w.Code)
+	}
+}
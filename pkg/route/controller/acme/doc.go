@@ -0,0 +1,4 @@
+// Package acme contains a controller that obtains and renews TLS
+// certificates for routes that opt in to ACME management, completing
+// HTTP-01 challenges through the router.
+package acme
@@ -0,0 +1,364 @@
+package acme
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Issuer obtains a certificate for a host by completing an ACME HTTP-01
+// challenge through the supplied solver.
+type Issuer interface {
+	// Issue returns a PEM encoded certificate chain and private key for host.
+	Issue(host string, solver ChallengeSolver) (certPEM, keyPEM []byte, err error)
+}
+
+// LetsEncryptDirectoryURL is the production Let's Encrypt ACME v2 directory.
+const LetsEncryptDirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+
+// acmeIssuer is a minimal ACME v2 (RFC 8555) client sufficient for the
+// single-host HTTP-01 issuance the route controller performs.
+type acmeIssuer struct {
+	directoryURL string
+	accountKey   *rsa.PrivateKey
+	client       *http.Client
+
+	lock      sync.Mutex
+	directory *directory
+	accountID string
+	nonce     string
+}
+
+type directory struct {
+	NewNonce   string `json:"newNonce"`
+	NewAccount string `json:"newAccount"`
+	NewOrder   string `json:"newOrder"`
+}
+
+type order struct {
+	Status         string   `json:"status"`
+	Authorizations []string `json:"authorizations"`
+	Finalize       string   `json:"finalize"`
+	Certificate    string   `json:"certificate"`
+}
+
+type authorization struct {
+	Status     string `json:"status"`
+	Challenges []struct {
+		Type  string `json:"type"`
+		URL   string `json:"url"`
+		Token string `json:"token"`
+	} `json:"challenges"`
+}
+
+// NewIssuer returns an Issuer that talks to the ACME server at directoryURL,
+// registering an account under accountKey on first use.
+func NewIssuer(directoryURL string, accountKey *rsa.PrivateKey) Issuer {
+	return &acmeIssuer{
+		directoryURL: directoryURL,
+		accountKey:   accountKey,
+		client:       &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (i *acmeIssuer) Issue(host string, solver ChallengeSolver) ([]byte, []byte, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if err := i.ensureAccount(); err != nil {
+		return nil, nil, err
+	}
+
+	o, orderURL, err := i.newOrder(host)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, authzURL := range o.Authorizations {
+		if err := i.solveAuthorization(host, authzURL, solver); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	certKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: host},
+		DNSNames: []string{host},
+	}, certKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := i.post(o.Finalize, map[string]string{"csr": base64.RawURLEncoding.EncodeToString(csr)}); err != nil {
+		return nil, nil, err
+	}
+
+	certURL, err := i.waitForCertificate(orderURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM, err := i.get(certURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(certKey)})
+	return certPEM, keyPEM, nil
+}
+
+func (i *acmeIssuer) ensureAccount() error {
+	if i.directory == nil {
+		resp, err := i.client.Get(i.directoryURL)
+		if err != nil {
+			return fmt.Errorf("unable to fetch ACME directory: %v", err)
+		}
+		defer resp.Body.Close()
+		d := &directory{}
+		if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
+			return err
+		}
+		i.directory = d
+	}
+	if len(i.accountID) == 0 {
+		resp, err := i.rawPost(i.directory.NewAccount, map[string]interface{}{"termsOfServiceAgreed": true}, "")
+		if err != nil {
+			return fmt.Errorf("unable to register ACME account: %v", err)
+		}
+		defer resp.Body.Close()
+		i.accountID = resp.Header.Get("Location")
+	}
+	return nil
+}
+
+func (i *acmeIssuer) solveAuthorization(host, authzURL string, solver ChallengeSolver) error {
+	body, err := i.post(authzURL, nil)
+	if err != nil {
+		return err
+	}
+	authz := &authorization{}
+	if err := json.Unmarshal(body, authz); err != nil {
+		return err
+	}
+	if authz.Status == "valid" {
+		return nil
+	}
+
+	for _, challenge := range authz.Challenges {
+		if challenge.Type != "http-01" {
+			continue
+		}
+		keyAuth := challenge.Token + "." + i.keyThumbprint()
+		if err := solver.Present(host, challenge.Token, keyAuth); err != nil {
+			return err
+		}
+		defer func(token string) {
+			if err := solver.Cleanup(host, token); err != nil {
+				glog.V(2).Infof("Unable to clean up ACME challenge %s: %v", token, err)
+			}
+		}(challenge.Token)
+
+		if _, err := i.post(challenge.URL, struct{}{}); err != nil {
+			return err
+		}
+		return i.waitForAuthorization(authzURL)
+	}
+	return fmt.Errorf("authorization %s offers no http-01 challenge", authzURL)
+}
+
+func (i *acmeIssuer) waitForAuthorization(authzURL string) error {
+	return wait.Poll(2*time.Second, 2*time.Minute, func() (bool, error) {
+		body, err := i.post(authzURL, nil)
+		if err != nil {
+			return false, err
+		}
+		authz := &authorization{}
+		if err := json.Unmarshal(body, authz); err != nil {
+			return false, err
+		}
+		switch authz.Status {
+		case "valid":
+			return true, nil
+		case "invalid":
+			return false, fmt.Errorf("authorization %s failed validation", authzURL)
+		}
+		return false, nil
+	})
+}
+
+func (i *acmeIssuer) newOrder(host string) (*order, string, error) {
+	resp, err := i.rawPost(i.directory.NewOrder, map[string]interface{}{
+		"identifiers": []map[string]string{{"type": "dns", "value": host}},
+	}, i.accountID)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	o := &order{}
+	if err := json.NewDecoder(resp.Body).Decode(o); err != nil {
+		return nil, "", err
+	}
+	return o, resp.Header.Get("Location"), nil
+}
+
+func (i *acmeIssuer) waitForCertificate(orderURL string) (string, error) {
+	var certURL string
+	err := wait.Poll(2*time.Second, 2*time.Minute, func() (bool, error) {
+		body, err := i.post(orderURL, nil)
+		if err != nil {
+			return false, err
+		}
+		o := &order{}
+		if err := json.Unmarshal(body, o); err != nil {
+			return false, err
+		}
+		switch o.Status {
+		case "valid":
+			certURL = o.Certificate
+			return true, nil
+		case "invalid":
+			return false, fmt.Errorf("order %s failed", orderURL)
+		}
+		return false, nil
+	})
+	return certURL, err
+}
+
+// post sends a signed POST (or POST-as-GET when payload is nil) and returns
+// the response body.
+func (i *acmeIssuer) post(url string, payload interface{}) ([]byte, error) {
+	resp, err := i.rawPost(url, payload, i.accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (i *acmeIssuer) get(url string) ([]byte, error) {
+	return i.post(url, nil)
+}
+
+// rawPost signs payload as a JWS and posts it. When accountID is empty the
+// JWS embeds the full account key (used only for account registration).
+func (i *acmeIssuer) rawPost(url string, payload interface{}, accountID string) (*http.Response, error) {
+	nonce, err := i.getNonce()
+	if err != nil {
+		return nil, err
+	}
+	body, err := i.signJWS(url, nonce, accountID, payload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := i.client.Post(url, "application/jose+json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if n := resp.Header.Get("Replay-Nonce"); len(n) > 0 {
+		i.nonce = n
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		problem, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ACME request to %s failed: %s: %s", url, resp.Status, string(problem))
+	}
+	return resp, nil
+}
+
+func (i *acmeIssuer) getNonce() (string, error) {
+	if len(i.nonce) > 0 {
+		nonce := i.nonce
+		i.nonce = ""
+		return nonce, nil
+	}
+	resp, err := i.client.Head(i.directory.NewNonce)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	return resp.Header.Get("Replay-Nonce"), nil
+}
+
+func (i *acmeIssuer) signJWS(url, nonce, accountID string, payload interface{}) ([]byte, error) {
+	protected := map[string]interface{}{
+		"alg":   "RS256",
+		"nonce": nonce,
+		"url":   url,
+	}
+	if len(accountID) > 0 {
+		protected["kid"] = accountID
+	} else {
+		protected["jwk"] = i.jwk()
+	}
+	protectedJSON, err := json.Marshal(protected)
+	if err != nil {
+		return nil, err
+	}
+	protectedB64 := base64.RawURLEncoding.EncodeToString(protectedJSON)
+
+	payloadB64 := ""
+	if payload != nil {
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		payloadB64 = base64.RawURLEncoding.EncodeToString(payloadJSON)
+	}
+
+	digest := sha256.Sum256([]byte(protectedB64 + "." + payloadB64))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, i.accountKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]string{
+		"protected": protectedB64,
+		"payload":   payloadB64,
+		"signature": base64.RawURLEncoding.EncodeToString(sig),
+	})
+}
+
+func (i *acmeIssuer) jwk() map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(i.accountKey.PublicKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big(i.accountKey.PublicKey.E)),
+	}
+}
+
+// keyThumbprint returns the RFC 7638 thumbprint of the account key used in
+// HTTP-01 key authorizations.
+func (i *acmeIssuer) keyThumbprint() string {
+	// fields must be serialized in lexicographic order with no whitespace
+	jwk := fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`,
+		base64.RawURLEncoding.EncodeToString(big(i.accountKey.PublicKey.E)),
+		base64.RawURLEncoding.EncodeToString(i.accountKey.PublicKey.N.Bytes()))
+	sum := sha256.Sum256([]byte(jwk))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func big(n int) []byte {
+	b := make([]byte, 0, 4)
+	for n > 0 {
+		b = append([]byte{byte(n & 0xff)}, b...)
+		n >>= 8
+	}
+	return b
+}
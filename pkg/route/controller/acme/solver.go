@@ -0,0 +1,149 @@
+package acme
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	routeclient "github.com/openshift/origin/pkg/route/generated/internalclientset/typed/route/internalversion"
+)
+
+// ChallengeSolver publishes and withdraws HTTP-01 challenge responses so that
+// the ACME server can reach them at http://<host>/.well-known/acme-challenge/<token>.
+type ChallengeSolver interface {
+	// Present makes keyAuth available at the challenge path for host.
+	Present(host, token, keyAuth string) error
+	// Cleanup withdraws a previously presented challenge response.
+	Cleanup(host, token string) error
+}
+
+const challengePathPrefix = "/.well-known/acme-challenge/"
+
+// routeSolver answers HTTP-01 challenges by creating a temporary route for
+// the challenge path that points at the service serving the controller's
+// challenge responder, and removing it once validation completes.
+type routeSolver struct {
+	routeClient routeclient.RoutesGetter
+	// namespace and serviceName locate the service that fronts the responder.
+	namespace   string
+	serviceName string
+
+	responder *ChallengeResponder
+}
+
+// NewRouteSolver returns a ChallengeSolver that exposes responses from the
+// given responder through temporary routes pointing at serviceName in
+// namespace.
+func NewRouteSolver(routeClient routeclient.RoutesGetter, namespace, serviceName string, responder *ChallengeResponder) ChallengeSolver {
+	return &routeSolver{
+		routeClient: routeClient,
+		namespace:   namespace,
+		serviceName: serviceName,
+		responder:   responder,
+	}
+}
+
+func (s *routeSolver) Present(host, token, keyAuth string) error {
+	s.responder.Set(token, keyAuth)
+
+	route := &routeapi.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: challengeRouteName(token),
+			Annotations: map[string]string{
+				// the challenge route must never itself be managed
+				AcmeEnabledAnnotation: "false",
+			},
+		},
+		Spec: routeapi.RouteSpec{
+			Host: host,
+			Path: challengePathPrefix + token,
+			To: routeapi.RouteTargetReference{
+				Kind: "Service",
+				Name: s.serviceName,
+			},
+			Port: &routeapi.RoutePort{
+				TargetPort: intstr.FromString("http"),
+			},
+		},
+	}
+	_, err := s.routeClient.Routes(s.namespace).Create(route)
+	if kapierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *routeSolver) Cleanup(host, token string) error {
+	s.responder.Remove(token)
+
+	err := s.routeClient.Routes(s.namespace).Delete(challengeRouteName(token), nil)
+	if kapierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// challengeRouteName derives a route name from the challenge token. Tokens
+// are base64url encoded, so they are case sensitive and may contain
+// characters that are not valid in a DNS-1123 label; hashing them yields a
+// name that is always valid and never collides for distinct tokens.
+func challengeRouteName(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("acme-challenge-%x", sum[:16])
+}
+
+// ChallengeResponder serves HTTP-01 challenge responses for tokens that have
+// been presented and not yet cleaned up. It is safe for concurrent use.
+type ChallengeResponder struct {
+	lock      sync.RWMutex
+	responses map[string]string
+}
+
+// NewChallengeResponder returns an empty responder.
+func NewChallengeResponder() *ChallengeResponder {
+	return &ChallengeResponder{responses: map[string]string{}}
+}
+
+// Set records the key authorization for a token.
+func (r *ChallengeResponder) Set(token, keyAuth string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.responses[token] = keyAuth
+}
+
+// Remove forgets the key authorization for a token.
+func (r *ChallengeResponder) Remove(token string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.responses, token)
+}
+
+// ServeHTTP answers challenge requests with the stored key authorization.
+func (r *ChallengeResponder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.URL.Path, challengePathPrefix) {
+		http.NotFound(w, req)
+		return
+	}
+	token := strings.TrimPrefix(req.URL.Path, challengePathPrefix)
+
+	r.lock.RLock()
+	keyAuth, ok := r.responses[token]
+	r.lock.RUnlock()
+
+	if !ok {
+		glog.V(4).Infof("No challenge response registered for token %q", token)
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprint(w, keyAuth)
+}
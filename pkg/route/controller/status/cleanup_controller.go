@@ -0,0 +1,187 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+	routeclient "github.com/openshift/origin/pkg/route/generated/internalclientset/typed/route/internalversion"
+)
+
+// StatusCleanupController removes route status ingress entries written by
+// routers that are no longer part of the cluster, for example shards that
+// were renamed or removed. Stale Admitted entries otherwise linger forever
+// and mislead users and tooling about where a route is actually served.
+type StatusCleanupController struct {
+	routeClient routeclient.RoutesGetter
+
+	// activeRouters is the set of router names whose status entries are kept.
+	activeRouters sets.String
+
+	// queue contains keys of routes that need to be checked
+	queue      workqueue.RateLimitingInterface
+	maxRetries int
+
+	store     cache.Store
+	informer  cache.Controller
+	hasSynced cache.InformerSynced
+
+	// syncHandler does the work. It's factored out for unit testing
+	syncHandler func(key string) error
+}
+
+// NewStatusCleanupController creates a controller that prunes status ingress
+// entries from routes when the entry's router name is not in activeRouters.
+func NewStatusCleanupController(routeClient routeclient.RoutesGetter, routes routeclient.RouteInterface, activeRouters []string, resyncInterval time.Duration) *StatusCleanupController {
+	c := &StatusCleanupController{
+		routeClient:   routeClient,
+		activeRouters: sets.NewString(activeRouters...),
+		queue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		maxRetries:    5,
+	}
+
+	c.store, c.informer = cache.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return routes.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return routes.Watch(options)
+			},
+		},
+		&routeapi.Route{},
+		resyncInterval,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.enqueue(obj)
+			},
+			UpdateFunc: func(old, cur interface{}) {
+				c.enqueue(cur)
+			},
+		},
+	)
+	c.hasSynced = c.informer.HasSynced
+	c.syncHandler = c.syncRoute
+
+	return c
+}
+
+// Run begins watching and syncing.
+func (c *StatusCleanupController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	glog.Infof("Starting route status cleanup controller")
+
+	go c.informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	glog.Infof("Shutting down route status cleanup controller")
+}
+
+func (c *StatusCleanupController) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to get key for object %#v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *StatusCleanupController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *StatusCleanupController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHandler(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	if c.queue.NumRequeues(key) < c.maxRetries {
+		utilruntime.HandleError(fmt.Errorf("error syncing route %v: %v", key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("dropping route %q out of the queue: %v", key, err))
+	c.queue.Forget(key)
+	return true
+}
+
+// syncRoute removes stale status ingress entries from a single route.
+func (c *StatusCleanupController) syncRoute(key string) error {
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	route := obj.(*routeapi.Route)
+
+	pruned, changed := pruneStaleIngress(route.Status.Ingress, c.activeRouters)
+	if !changed {
+		return nil
+	}
+
+	copied := route.DeepCopy()
+	copied.Status.Ingress = pruned
+	glog.V(4).Infof("Removing %d stale status ingress entries from route %s/%s", len(route.Status.Ingress)-len(pruned), route.Namespace, route.Name)
+	if _, err := c.routeClient.Routes(copied.Namespace).UpdateStatus(copied); err != nil {
+		if kapierrors.IsConflict(err) || kapierrors.IsNotFound(err) {
+			// the route changed or went away underneath us - the informer will
+			// requeue the latest version if work remains.
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// pruneStaleIngress returns the ingress entries whose router is still active
+// and whether any entry was removed. An empty active set keeps everything so
+// a misconfigured controller cannot wipe all route status.
+func pruneStaleIngress(ingress []routeapi.RouteIngress, activeRouters sets.String) ([]routeapi.RouteIngress, bool) {
+	if activeRouters.Len() == 0 {
+		return ingress, false
+	}
+	kept := make([]routeapi.RouteIngress, 0, len(ingress))
+	for i := range ingress {
+		if activeRouters.Has(ingress[i].RouterName) {
+			kept = append(kept, ingress[i])
+		}
+	}
+	if len(kept) == len(ingress) {
+		return ingress, false
+	}
+	return kept, true
+}
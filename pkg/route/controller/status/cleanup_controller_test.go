@@ -0,0 +1,65 @@
+package status
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	routeapi "github.com/openshift/origin/pkg/route/apis/route"
+)
+
+func TestPruneStaleIngress(t *testing.T) {
+	ingress := []routeapi.RouteIngress{
+		{RouterName: "default"},
+		{RouterName: "old-shard"},
+		{RouterName: "internal"},
+	}
+
+	tests := []struct {
+		name          string
+		activeRouters sets.String
+		expected      []string
+		changed       bool
+	}{
+		{
+			name:          "empty active set keeps everything",
+			activeRouters: sets.NewString(),
+			expected:      []string{"default", "old-shard", "internal"},
+			changed:       false,
+		},
+		{
+			name:          "stale shard removed",
+			activeRouters: sets.NewString("default", "internal"),
+			expected:      []string{"default", "internal"},
+			changed:       true,
+		},
+		{
+			name:          "all active",
+			activeRouters: sets.NewString("default", "old-shard", "internal"),
+			expected:      []string{"default", "old-shard", "internal"},
+			changed:       false,
+		},
+	}
+
+	for _, test := range tests {
+		pruned, changed := pruneStaleIngress(ingress, test.activeRouters)
+		if changed != test.changed {
+			t.Errorf("%s: expected changed=%v, got %v", test.name, test.changed, changed)
+			continue
+		}
+		var names []string
+		for i := range pruned {
+			names = append(names, pruned[i].RouterName)
+		}
+		if len(names) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != test.expected[i] {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, names)
+				break
+			}
+		}
+	}
+}
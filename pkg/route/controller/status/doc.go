@@ -0,0 +1,3 @@
+// Package status contains a controller that prunes stale route status
+// ingress entries left behind by router shards that no longer exist.
+package status
@@ -23,6 +23,12 @@ type F5Plugin struct {
 	// VtepMap is a map of node ids and their ip addresses
 	// helps to sync events at router start vs node status update events
 	VtepMap map[types.UID]string
+
+	// RejectionRecorder is used to report routes that could not be programmed
+	// into F5 BIG-IP back into route status, so that admins can see route-level
+	// load balancer failures without logging into the appliance. Defaults to
+	// logging only.
+	RejectionRecorder controller.RejectionRecorder
 }
 
 // F5PluginConfig holds configuration for the f5 plugin.
@@ -90,7 +96,11 @@ func NewF5Plugin(cfg F5PluginConfig) (*F5Plugin, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &F5Plugin{f5, map[types.UID]string{}}, f5.Initialize()
+	return &F5Plugin{
+		F5Client:          f5,
+		VtepMap:           map[types.UID]string{},
+		RejectionRecorder: controller.LogRejections,
+	}, f5.Initialize()
 }
 
 // ensurePoolExists checks whether the named pool already exists in F5 BIG-IP
@@ -323,6 +333,35 @@ func (p *F5Plugin) HandleEndpoints(eventType watch.EventType,
 	return nil
 }
 
+// routeProgrammingFailedReason is the route status condition reason used when
+// a route cannot be programmed into F5 BIG-IP.
+const routeProgrammingFailedReason = "F5ProgrammingFailed"
+
+// routePolicyDescription returns a human readable description of the F5 policy
+// (or data group) and partition a route is programmed into, for use in status
+// messages.
+func (p *F5Plugin) routePolicyDescription(route *routeapi.Route) string {
+	tls := route.Spec.TLS
+	var policy string
+	switch {
+	case tls == nil || len(tls.Termination) == 0:
+		policy = fmt.Sprintf("policy %s on vserver %s", httpPolicyName, p.F5Client.httpVserver)
+	case tls.Termination == routeapi.TLSTerminationPassthrough:
+		policy = fmt.Sprintf("data group %s on vserver %s", passthroughRoutesDataGroupName, p.F5Client.httpsVserver)
+	default:
+		policy = fmt.Sprintf("policy %s on vserver %s", httpsPolicyName, p.F5Client.httpsVserver)
+	}
+	return fmt.Sprintf("%s in partition %s", policy, p.F5Client.partitionPath)
+}
+
+// recordRouteProgrammingFailure reports a route that could not be programmed
+// into F5 BIG-IP back into route status, including which policy and partition
+// the route belongs to and the error returned by the appliance.
+func (p *F5Plugin) recordRouteProgrammingFailure(route *routeapi.Route, err error) {
+	p.RejectionRecorder.RecordRouteRejection(route, routeProgrammingFailedReason,
+		fmt.Sprintf("failed to program %s: %v", p.routePolicyDescription(route), err))
+}
+
 // routeName returns a string that can be used as a rule name in F5 BIG-IP and
 // is distinct for the given route.
 func routeName(route routeapi.Route) string {
@@ -618,6 +657,7 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 
 		err := p.deleteRoute(routename)
 		if err != nil {
+			p.recordRouteProgrammingFailure(route, err)
 			return err
 		}
 
@@ -625,11 +665,13 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 		// did not already exist.
 		err = p.ensurePoolExists(poolname)
 		if err != nil {
+			p.recordRouteProgrammingFailure(route, err)
 			return err
 		}
 
 		err = p.addRoute(routename, poolname, hostname, pathname, route.Spec.TLS)
 		if err != nil {
+			p.recordRouteProgrammingFailure(route, err)
 			return err
 		}
 
@@ -652,11 +694,13 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 		// However, the pool may have already been created by HandleEndpoints.
 		err := p.ensurePoolExists(poolname)
 		if err != nil {
+			p.recordRouteProgrammingFailure(route, err)
 			return err
 		}
 
 		err = p.addRoute(routename, poolname, hostname, pathname, route.Spec.TLS)
 		if err != nil {
+			p.recordRouteProgrammingFailure(route, err)
 			return err
 		}
 	}
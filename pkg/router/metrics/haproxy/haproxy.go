@@ -221,7 +221,7 @@ func NewExporter(opts PrometheusOptions) (*Exporter, error) {
 			48: newFrontendMetric("http_requests_total", "Total HTTP requests.", nil),
 			60: newFrontendMetric("http_average_response_latency_milliseconds", "Average response latency of the last 1024 requests in milliseconds.", nil),
 		}),
-		reducedBackendExports: map[int]struct{}{2: {}, 3: {}, 7: {}, 17: {}},
+		reducedBackendExports: map[int]struct{}{2: {}, 3: {}, 7: {}, 17: {}, 48: {}},
 		backendMetrics: filterMetrics(opts.ExportedMetrics, metrics{
 			2:  newBackendMetric("current_queue", "Current number of queued requests not assigned to any server.", nil),
 			3:  newBackendMetric("max_queue", "Maximum observed number of queued requests not assigned to any server.", nil),
@@ -245,6 +245,7 @@ func NewExporter(opts PrometheusOptions) (*Exporter, error) {
 			42: newBackendMetric("http_responses_total", "Total of HTTP responses.", prometheus.Labels{"code": "4xx"}),
 			43: newBackendMetric("http_responses_total", "Total of HTTP responses.", prometheus.Labels{"code": "5xx"}),
 			44: newBackendMetric("http_responses_total", "Total of HTTP responses.", prometheus.Labels{"code": "other"}),
+			48: newBackendMetric("http_requests_total", "Total HTTP requests.", nil),
 			60: newBackendMetric("http_average_response_latency_milliseconds", "Average response latency of the last 1024 requests in milliseconds.", nil),
 		}),
 		serverMetrics: filterMetrics(opts.ExportedMetrics, metrics{
@@ -99,6 +99,8 @@ type templateRouter struct {
 	stateChanged bool
 	// metricReload tracks reloads
 	metricReload prometheus.Summary
+	// metricReloadFailure tracks whether the most recent reload failed
+	metricReloadFailure prometheus.Gauge
 	// metricWriteConfig tracks writing config
 	metricWriteConfig prometheus.Summary
 }
@@ -179,6 +181,12 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 		Help:      "Measures the time spent writing out the router configuration to disk in seconds.",
 	})
 	prometheus.MustRegister(metricWriteConfig)
+	metricReloadFailure := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "template_router",
+		Name:      "reload_failure",
+		Help:      "Metric to track the status of the most recent HAProxy reload (1 = failed, 0 = succeeded).",
+	})
+	prometheus.MustRegister(metricReloadFailure)
 
 	router := &templateRouter{
 		dir:                      dir,
@@ -201,8 +209,9 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 		peerEndpoints:            []Endpoint{},
 		bindPortsAfterSync:       cfg.bindPortsAfterSync,
 
-		metricReload:      metricsReload,
-		metricWriteConfig: metricWriteConfig,
+		metricReload:        metricsReload,
+		metricReloadFailure: metricReloadFailure,
+		metricWriteConfig:   metricWriteConfig,
 
 		rateLimitedCommitFunction: nil,
 	}
@@ -356,8 +365,10 @@ func (r *templateRouter) commitAndReload() error {
 	err := r.reloadRouter()
 	r.metricReload.Observe(float64(time.Now().Sub(reloadStart)) / float64(time.Second))
 	if err != nil {
+		r.metricReloadFailure.Set(1)
 		return err
 	}
+	r.metricReloadFailure.Set(0)
 
 	return nil
 }
@@ -655,6 +666,28 @@ func (r *templateRouter) createServiceAliasConfig(route *routeapi.Route, backend
 		}
 	}
 
+	// Per-route connection tuning. The values are validated here so templates
+	// can emit them without further checks.
+	if timeout, ok := route.Annotations[routeIdleTimeoutAnnotation]; ok {
+		if isValidTimeout(timeout) {
+			config.IdleTimeout = timeout
+		} else {
+			glog.Warningf("Ignoring invalid timeout %q on route %s/%s", timeout, route.Namespace, route.Name)
+		}
+	}
+	if config.TLSTermination == routeapi.TLSTerminationPassthrough {
+		config.UseProxyProtocol = isTrue(route.Annotations[routeProxyProtocolAnnotation])
+	}
+	if backups, ok := route.Annotations[routeBackupBackendsAnnotation]; ok {
+		config.BackupServiceUnits = make(map[string]bool)
+		for _, name := range strings.Split(backups, ",") {
+			key := endpointsKeyFromParts(route.Namespace, strings.TrimSpace(name))
+			if _, ok := serviceUnits[key]; ok {
+				config.BackupServiceUnits[key] = true
+			}
+		}
+	}
+
 	return &config
 }
 
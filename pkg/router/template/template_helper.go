@@ -54,6 +54,16 @@ func isInteger(s string) bool {
 	return (err == nil)
 }
 
+// haproxyTimeoutRegexp matches HAProxy time specifications: a positive
+// integer with an optional unit of us, ms, s, m, h or d.
+var haproxyTimeoutRegexp = regexp.MustCompile(`\A[1-9][0-9]*(us|ms|s|m|h|d)?\z`)
+
+// isValidTimeout determines if a given string is a valid HAProxy time
+// specification usable in a timeout directive.
+func isValidTimeout(s string) bool {
+	return haproxyTimeoutRegexp.MatchString(s)
+}
+
 func matchValues(s string, allowedValues ...string) bool {
 	glog.V(7).Infof("matchValues called with %s and %v", s, allowedValues)
 	for _, value := range allowedValues {
@@ -182,6 +192,7 @@ var helperFunctions = template.FuncMap{
 	"generateRouteRegexp":        generateRouteRegexp,        //generates a regular expression matching the route hosts (and paths)
 	"genCertificateHostName":     genCertificateHostName,     //generates host name to use for serving/matching certificates
 
-	"isTrue":     isTrue,     //determines if a given variable is a true value
-	"firstMatch": firstMatch, //anchors provided regular expression and evaluates against given strings, returns the first matched string or ""
+	"isTrue":         isTrue,         //determines if a given variable is a true value
+	"firstMatch":     firstMatch,     //anchors provided regular expression and evaluates against given strings, returns the first matched string or ""
+	"isValidTimeout": isValidTimeout, //determines if a given variable is a valid HAProxy time specification
 }
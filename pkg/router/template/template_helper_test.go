@@ -297,3 +297,26 @@ func TestMatchPattern(t *testing.T) {
 		}
 	}
 }
+
+func TestIsValidTimeout(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"5s", true},
+		{"100ms", true},
+		{"2m", true},
+		{"1h", true},
+		{"30", true},
+		{"0", false},
+		{"", false},
+		{"5seconds", false},
+		{"-5s", false},
+		{"5 s", false},
+	}
+	for _, test := range tests {
+		if actual := isValidTimeout(test.value); actual != test.expected {
+			t.Errorf("isValidTimeout(%q): expected %v, got %v", test.value, test.expected, actual)
+		}
+	}
+}
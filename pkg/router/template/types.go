@@ -17,6 +17,25 @@ type ServiceUnit struct {
 	EndpointTable []Endpoint
 }
 
+const (
+	// routeIdleTimeoutAnnotation overrides the router's default idle timeout
+	// for connections to a route. The value must be a valid HAProxy time
+	// specification (e.g. 5s, 2m, 1h); invalid values are ignored. For
+	// passthrough routes this bounds how long an idle TCP connection is held.
+	routeIdleTimeoutAnnotation = "haproxy.router.openshift.io/timeout"
+
+	// routeProxyProtocolAnnotation enables sending the PROXY protocol header
+	// to the backends of a passthrough route so they can recover the client
+	// address. Only the value "true" enables it.
+	routeProxyProtocolAnnotation = "haproxy.router.openshift.io/proxy-protocol"
+
+	// routeBackupBackendsAnnotation holds a comma separated list of service
+	// names from the route's alternate backends whose endpoints should only
+	// receive traffic when all endpoints of the remaining services are down.
+	// Names that do not match a service of the route are ignored.
+	routeBackupBackendsAnnotation = "haproxy.router.openshift.io/backup-backends"
+)
+
 // ServiceAliasConfig is a route for a service.  Uniquely identified by host + path.
 type ServiceAliasConfig struct {
 	// Name is the user-specified name of the route.
@@ -54,6 +73,22 @@ type ServiceAliasConfig struct {
 	// Annotations attached to this route
 	Annotations map[string]string
 
+	// IdleTimeout is the validated idle timeout for connections to this route,
+	// taken from the routeIdleTimeoutAnnotation. Empty means the router
+	// default applies.
+	IdleTimeout string
+
+	// UseProxyProtocol is true if the PROXY protocol header should be sent to
+	// the backends of a passthrough route, taken from the
+	// routeProxyProtocolAnnotation.
+	UseProxyProtocol bool
+
+	// BackupServiceUnits identifies the services of this route whose
+	// endpoints only receive traffic when all other endpoints are down,
+	// taken from the routeBackupBackendsAnnotation and keyed by service unit
+	// name.
+	BackupServiceUnits map[string]bool
+
 	// ServiceUnits is the weight for each service assigned to the route keyed by service name.
 	// It is used in calculating the weight for the server that is found in ServiceUnitNames
 	ServiceUnits map[string]int32
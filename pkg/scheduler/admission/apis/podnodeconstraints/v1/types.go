@@ -4,6 +4,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// NodeSelectorLabelExemptionsAnnotation is a project annotation holding a comma separated
+// list of blacklisted node selector labels that are allowed for pods in the project.
+const NodeSelectorLabelExemptionsAnnotation = "scheduler.openshift.io/node-selector-label-exemptions"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // PodNodeConstraintsConfig is the configuration for the pod node name
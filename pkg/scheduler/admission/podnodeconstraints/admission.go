@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 
 	"github.com/golang/glog"
 
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	admission "k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
@@ -18,6 +20,7 @@ import (
 	"github.com/openshift/origin/pkg/api/meta"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configlatest "github.com/openshift/origin/pkg/cmd/server/apis/config/latest"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
 	"github.com/openshift/origin/pkg/scheduler/admission/apis/podnodeconstraints"
 )
 
@@ -61,6 +64,7 @@ type podNodeConstraints struct {
 	selectorLabelBlacklist sets.String
 	config                 *podnodeconstraints.PodNodeConstraintsConfig
 	authorizer             authorizer.Authorizer
+	cache                  *projectcache.ProjectCache
 }
 
 func shouldCheckResource(resource schema.GroupResource, kind schema.GroupKind) (bool, error) {
@@ -80,6 +84,7 @@ func shouldCheckResource(resource schema.GroupResource, kind schema.GroupKind) (
 }
 
 var _ = oadmission.WantsAuthorizer(&podNodeConstraints{})
+var _ = oadmission.WantsProjectCache(&podNodeConstraints{})
 
 func readConfig(reader io.Reader) (*podnodeconstraints.PodNodeConstraintsConfig, error) {
 	if reader == nil || reflect.ValueOf(reader).IsNil() {
@@ -135,9 +140,16 @@ func (o *podNodeConstraints) getPodSpec(attr admission.Attributes) (kapi.PodSpec
 
 // validate PodSpec if NodeName or NodeSelector are specified
 func (o *podNodeConstraints) admitPodSpec(attr admission.Attributes, ps kapi.PodSpec) error {
+	exemptedLabels, err := o.projectExemptedSelectorLabels(attr.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(attr, err)
+	}
 	matchingLabels := []string{}
 	// nodeSelector blacklist filter
 	for nodeSelectorLabel := range ps.NodeSelector {
+		if exemptedLabels.Has(nodeSelectorLabel) {
+			continue
+		}
 		if o.selectorLabelBlacklist.Has(nodeSelectorLabel) {
 			matchingLabels = append(matchingLabels, nodeSelectorLabel)
 		}
@@ -162,14 +174,47 @@ func (o *podNodeConstraints) admitPodSpec(attr admission.Attributes, ps kapi.Pod
 	return nil
 }
 
+// projectExemptedSelectorLabels reads the node selector label exemption annotation from the
+// pod's project. Project admins may list blacklisted labels there to allow them for pods in
+// the project without a cluster-wide configuration change.
+func (o *podNodeConstraints) projectExemptedSelectorLabels(namespace string) (sets.String, error) {
+	ns, err := o.cache.GetNamespace(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up pod namespace: %v", err)
+	}
+	value, ok := ns.Annotations[podnodeconstraints.NodeSelectorLabelExemptionsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	exempted := sets.NewString()
+	for _, label := range strings.Split(value, ",") {
+		label = strings.TrimSpace(label)
+		if len(label) == 0 {
+			continue
+		}
+		if errs := utilvalidation.IsQualifiedName(label); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label %q in %s annotation on project %s: %s", label, podnodeconstraints.NodeSelectorLabelExemptionsAnnotation, ns.Name, strings.Join(errs, ", "))
+		}
+		exempted.Insert(label)
+	}
+	return exempted, nil
+}
+
 func (o *podNodeConstraints) SetAuthorizer(a authorizer.Authorizer) {
 	o.authorizer = a
 }
 
+func (o *podNodeConstraints) SetProjectCache(cache *projectcache.ProjectCache) {
+	o.cache = cache
+}
+
 func (o *podNodeConstraints) ValidateInitialization() error {
 	if o.authorizer == nil {
 		return fmt.Errorf("PodNodeConstraints needs an Openshift Authorizer")
 	}
+	if o.cache == nil {
+		return fmt.Errorf("PodNodeConstraints needs a project cache")
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package podnodeconstraints
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -11,15 +12,18 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubernetes/pkg/apis/batch"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
 	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
 	"k8s.io/kubernetes/pkg/serviceaccount"
 
 	_ "github.com/openshift/origin/pkg/api/install"
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/apis/authorization"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
 	"github.com/openshift/origin/pkg/scheduler/admission/apis/podnodeconstraints"
 	securityapi "github.com/openshift/origin/pkg/security/apis/security"
 )
@@ -27,14 +31,15 @@ import (
 func TestPodNodeConstraints(t *testing.T) {
 	ns := metav1.NamespaceDefault
 	tests := []struct {
-		config           *podnodeconstraints.PodNodeConstraintsConfig
-		resource         runtime.Object
-		kind             schema.GroupKind
-		groupresource    schema.GroupResource
-		userinfo         user.Info
-		reviewResponse   *authorizationapi.SubjectAccessReviewResponse
-		expectedResource string
-		expectedErrorMsg string
+		config             *podnodeconstraints.PodNodeConstraintsConfig
+		resource           runtime.Object
+		kind               schema.GroupKind
+		groupresource      schema.GroupResource
+		userinfo           user.Info
+		reviewResponse     *authorizationapi.SubjectAccessReviewResponse
+		projectAnnotations map[string]string
+		expectedResource   string
+		expectedErrorMsg   string
 	}{
 		// 0: expect unspecified defaults to not error
 		{
@@ -99,12 +104,37 @@ func TestPodNodeConstraints(t *testing.T) {
 			expectedResource: "pods/binding",
 			expectedErrorMsg: "",
 		},
+		// 7: expect project exemption annotation to allow a blacklisted label
+		{
+			config:         testConfig(),
+			resource:       nodeSelectorPod(),
+			userinfo:       serviceaccount.UserInfo("", "", ""),
+			reviewResponse: reviewResponse(false, ""),
+			projectAnnotations: map[string]string{
+				podnodeconstraints.NodeSelectorLabelExemptionsAnnotation: "bogus",
+			},
+			expectedResource: "pods/binding",
+			expectedErrorMsg: "",
+		},
+		// 8: expect exemption of an unrelated label to still reject
+		{
+			config:         testConfig(),
+			resource:       nodeSelectorPod(),
+			userinfo:       serviceaccount.UserInfo("", "", ""),
+			reviewResponse: reviewResponse(false, ""),
+			projectAnnotations: map[string]string{
+				podnodeconstraints.NodeSelectorLabelExemptionsAnnotation: "other",
+			},
+			expectedResource: "pods/binding",
+			expectedErrorMsg: "node selection by label(s) [bogus] is prohibited by policy for your role",
+		},
 	}
 	for i, tc := range tests {
 		var expectedError error
 		errPrefix := fmt.Sprintf("%d", i)
 		prc := NewPodNodeConstraints(tc.config)
 		prc.(oadmission.WantsAuthorizer).SetAuthorizer(fakeAuthorizer(t))
+		prc.(oadmission.WantsProjectCache).SetProjectCache(testCache(tc.projectAnnotations))
 		err := prc.(admission.InitializationValidator).ValidateInitialization()
 		if err != nil {
 			checkAdmitError(t, err, expectedError, errPrefix)
@@ -119,12 +149,30 @@ func TestPodNodeConstraints(t *testing.T) {
 	}
 }
 
+func TestPodNodeConstraintsInvalidExemptionAnnotation(t *testing.T) {
+	ns := metav1.NamespaceDefault
+	prc := NewPodNodeConstraints(testConfig())
+	prc.(oadmission.WantsAuthorizer).SetAuthorizer(fakeAuthorizer(t))
+	prc.(oadmission.WantsProjectCache).SetProjectCache(testCache(map[string]string{
+		podnodeconstraints.NodeSelectorLabelExemptionsAnnotation: "-invalid-",
+	}))
+	if err := prc.(admission.InitializationValidator).ValidateInitialization(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attrs := admission.NewAttributesRecord(nodeSelectorPod(), nil, kapi.Kind("Pod").WithVersion("version"), ns, "test", kapi.Resource("pods").WithVersion("version"), "", admission.Create, serviceaccount.UserInfo("", "", ""))
+	err := prc.(admission.MutationInterface).Admit(attrs)
+	if err == nil || !strings.Contains(err.Error(), "invalid label") {
+		t.Errorf("expected invalid label error, got: %v", err)
+	}
+}
+
 func TestPodNodeConstraintsPodUpdate(t *testing.T) {
 	ns := metav1.NamespaceDefault
 	var expectedError error
 	errPrefix := "PodUpdate"
 	prc := NewPodNodeConstraints(testConfig())
 	prc.(oadmission.WantsAuthorizer).SetAuthorizer(fakeAuthorizer(t))
+	prc.(oadmission.WantsProjectCache).SetProjectCache(testCache(nil))
 	err := prc.(admission.InitializationValidator).ValidateInitialization()
 	if err != nil {
 		checkAdmitError(t, err, expectedError, errPrefix)
@@ -141,6 +189,7 @@ func TestPodNodeConstraintsNonHandledResources(t *testing.T) {
 	var expectedError error
 	prc := NewPodNodeConstraints(testConfig())
 	prc.(oadmission.WantsAuthorizer).SetAuthorizer(fakeAuthorizer(t))
+	prc.(oadmission.WantsProjectCache).SetProjectCache(testCache(nil))
 	err := prc.(admission.InitializationValidator).ValidateInitialization()
 	if err != nil {
 		checkAdmitError(t, err, expectedError, errPrefix)
@@ -259,6 +308,7 @@ func TestPodNodeConstraintsResources(t *testing.T) {
 					errPrefix := fmt.Sprintf("%s; %s; %s", tr.prefix, tp.prefix, top.operation)
 					prc := NewPodNodeConstraints(tc.config)
 					prc.(oadmission.WantsAuthorizer).SetAuthorizer(fakeAuthorizer(t))
+					prc.(oadmission.WantsProjectCache).SetProjectCache(testCache(nil))
 					err := prc.(admission.InitializationValidator).ValidateInitialization()
 					if err != nil {
 						checkAdmitError(t, err, expectedError, errPrefix)
@@ -276,6 +326,16 @@ func TestPodNodeConstraintsResources(t *testing.T) {
 	}
 }
 
+func testCache(projectAnnotations map[string]string) *projectcache.ProjectCache {
+	kclient := &fake.Clientset{}
+	pCache := projectcache.NewFake(kclient.Core().Namespaces(), projectcache.NewCacheStore(cache.MetaNamespaceKeyFunc), "")
+	namespace := &kapi.Namespace{}
+	namespace.Name = metav1.NamespaceDefault
+	namespace.Annotations = projectAnnotations
+	pCache.Store.Add(namespace)
+	return pCache
+}
+
 func emptyConfig() *podnodeconstraints.PodNodeConstraintsConfig {
 	return &podnodeconstraints.PodNodeConstraintsConfig{}
 }
@@ -60,6 +60,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PodSecurityPolicySubjectReview{},
 		&PodSecurityPolicySelfSubjectReview{},
 		&PodSecurityPolicyReview{},
+		&SecurityContextConstraintsReview{},
 	)
 	return nil
 }
@@ -71,6 +72,7 @@ func addLegacyKnownTypes(scheme *runtime.Scheme) error {
 		&PodSecurityPolicySubjectReview{},
 		&PodSecurityPolicySelfSubjectReview{},
 		&PodSecurityPolicyReview{},
+		&SecurityContextConstraintsReview{},
 	}
 	scheme.AddKnownTypes(LegacySchemeGroupVersion, types...)
 	return nil
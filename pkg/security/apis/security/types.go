@@ -337,3 +337,44 @@ type ServiceAccountPodSecurityPolicyReviewStatus struct {
 	// Name contains the allowed and the denied ServiceAccount name
 	Name string
 }
+
+// +genclient
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecurityContextConstraintsReview explains how SCC admission would treat a PodTemplateSpec for a
+// particular user/SA tuple: every constraint considered, in priority order, with field-level
+// reasons for the ones that rejected the pod.
+type SecurityContextConstraintsReview struct {
+	metav1.TypeMeta
+
+	// Spec defines specification for the SecurityContextConstraintsReview.
+	Spec PodSecurityPolicySubjectReviewSpec
+
+	// Status represents the current information/status for the SecurityContextConstraintsReview.
+	Status SecurityContextConstraintsReviewStatus
+}
+
+// SecurityContextConstraintsReviewStatus contains information/status for SecurityContextConstraintsReview.
+type SecurityContextConstraintsReviewStatus struct {
+	// AllowedBy is a reference to the constraint that allows the PodTemplateSpec.
+	// A `nil`, indicates that it was denied by every considered constraint.
+	AllowedBy *kapi.ObjectReference
+
+	// Constraints holds the result for each considered constraint, in the priority order
+	// used during admission.
+	Constraints []SecurityContextConstraintsReviewResult
+}
+
+// SecurityContextConstraintsReviewResult holds the outcome of checking a single constraint.
+type SecurityContextConstraintsReviewResult struct {
+	// Name is the name of the SecurityContextConstraints.
+	Name string
+
+	// Allowed is true if this constraint admits the PodTemplateSpec.
+	Allowed bool
+
+	// Reasons lists the field-level reasons this constraint rejected the PodTemplateSpec.
+	// It is empty when Allowed is true.
+	Reasons []string
+}
@@ -53,6 +53,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_security_SecurityContextConstraints_To_v1_SecurityContextConstraints,
 		Convert_v1_SecurityContextConstraintsList_To_security_SecurityContextConstraintsList,
 		Convert_security_SecurityContextConstraintsList_To_v1_SecurityContextConstraintsList,
+		Convert_v1_SecurityContextConstraintsReview_To_security_SecurityContextConstraintsReview,
+		Convert_security_SecurityContextConstraintsReview_To_v1_SecurityContextConstraintsReview,
+		Convert_v1_SecurityContextConstraintsReviewResult_To_security_SecurityContextConstraintsReviewResult,
+		Convert_security_SecurityContextConstraintsReviewResult_To_v1_SecurityContextConstraintsReviewResult,
+		Convert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus,
+		Convert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus,
 		Convert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_security_ServiceAccountPodSecurityPolicyReviewStatus,
 		Convert_security_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus,
 		Convert_v1_SupplementalGroupsStrategyOptions_To_security_SupplementalGroupsStrategyOptions,
@@ -594,3 +600,95 @@ func autoConvert_security_SupplementalGroupsStrategyOptions_To_v1_SupplementalGr
 func Convert_security_SupplementalGroupsStrategyOptions_To_v1_SupplementalGroupsStrategyOptions(in *security.SupplementalGroupsStrategyOptions, out *v1.SupplementalGroupsStrategyOptions, s conversion.Scope) error {
 	return autoConvert_security_SupplementalGroupsStrategyOptions_To_v1_SupplementalGroupsStrategyOptions(in, out, s)
 }
+
+func autoConvert_v1_SecurityContextConstraintsReview_To_security_SecurityContextConstraintsReview(in *v1.SecurityContextConstraintsReview, out *security.SecurityContextConstraintsReview, s conversion.Scope) error {
+	if err := Convert_v1_PodSecurityPolicySubjectReviewSpec_To_security_PodSecurityPolicySubjectReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SecurityContextConstraintsReview_To_security_SecurityContextConstraintsReview is an autogenerated conversion function.
+func Convert_v1_SecurityContextConstraintsReview_To_security_SecurityContextConstraintsReview(in *v1.SecurityContextConstraintsReview, out *security.SecurityContextConstraintsReview, s conversion.Scope) error {
+	return autoConvert_v1_SecurityContextConstraintsReview_To_security_SecurityContextConstraintsReview(in, out, s)
+}
+
+func autoConvert_security_SecurityContextConstraintsReview_To_v1_SecurityContextConstraintsReview(in *security.SecurityContextConstraintsReview, out *v1.SecurityContextConstraintsReview, s conversion.Scope) error {
+	if err := Convert_security_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_security_SecurityContextConstraintsReview_To_v1_SecurityContextConstraintsReview is an autogenerated conversion function.
+func Convert_security_SecurityContextConstraintsReview_To_v1_SecurityContextConstraintsReview(in *security.SecurityContextConstraintsReview, out *v1.SecurityContextConstraintsReview, s conversion.Scope) error {
+	return autoConvert_security_SecurityContextConstraintsReview_To_v1_SecurityContextConstraintsReview(in, out, s)
+}
+
+func autoConvert_v1_SecurityContextConstraintsReviewResult_To_security_SecurityContextConstraintsReviewResult(in *v1.SecurityContextConstraintsReviewResult, out *security.SecurityContextConstraintsReviewResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Allowed = in.Allowed
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	return nil
+}
+
+// Convert_v1_SecurityContextConstraintsReviewResult_To_security_SecurityContextConstraintsReviewResult is an autogenerated conversion function.
+func Convert_v1_SecurityContextConstraintsReviewResult_To_security_SecurityContextConstraintsReviewResult(in *v1.SecurityContextConstraintsReviewResult, out *security.SecurityContextConstraintsReviewResult, s conversion.Scope) error {
+	return autoConvert_v1_SecurityContextConstraintsReviewResult_To_security_SecurityContextConstraintsReviewResult(in, out, s)
+}
+
+func autoConvert_security_SecurityContextConstraintsReviewResult_To_v1_SecurityContextConstraintsReviewResult(in *security.SecurityContextConstraintsReviewResult, out *v1.SecurityContextConstraintsReviewResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Allowed = in.Allowed
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	return nil
+}
+
+// Convert_security_SecurityContextConstraintsReviewResult_To_v1_SecurityContextConstraintsReviewResult is an autogenerated conversion function.
+func Convert_security_SecurityContextConstraintsReviewResult_To_v1_SecurityContextConstraintsReviewResult(in *security.SecurityContextConstraintsReviewResult, out *v1.SecurityContextConstraintsReviewResult, s conversion.Scope) error {
+	return autoConvert_security_SecurityContextConstraintsReviewResult_To_v1_SecurityContextConstraintsReviewResult(in, out, s)
+}
+
+func autoConvert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus(in *v1.SecurityContextConstraintsReviewStatus, out *security.SecurityContextConstraintsReviewStatus, s conversion.Scope) error {
+	if in.AllowedBy != nil {
+		in, out := &in.AllowedBy, &out.AllowedBy
+		*out = new(core.ObjectReference)
+		if err := core_v1.Convert_v1_ObjectReference_To_core_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AllowedBy = nil
+	}
+	out.Constraints = *(*[]security.SecurityContextConstraintsReviewResult)(unsafe.Pointer(&in.Constraints))
+	return nil
+}
+
+// Convert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus is an autogenerated conversion function.
+func Convert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus(in *v1.SecurityContextConstraintsReviewStatus, out *security.SecurityContextConstraintsReviewStatus, s conversion.Scope) error {
+	return autoConvert_v1_SecurityContextConstraintsReviewStatus_To_security_SecurityContextConstraintsReviewStatus(in, out, s)
+}
+
+func autoConvert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus(in *security.SecurityContextConstraintsReviewStatus, out *v1.SecurityContextConstraintsReviewStatus, s conversion.Scope) error {
+	if in.AllowedBy != nil {
+		in, out := &in.AllowedBy, &out.AllowedBy
+		*out = new(api_core_v1.ObjectReference)
+		if err := core_v1.Convert_core_ObjectReference_To_v1_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AllowedBy = nil
+	}
+	out.Constraints = *(*[]v1.SecurityContextConstraintsReviewResult)(unsafe.Pointer(&in.Constraints))
+	return nil
+}
+
+// Convert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus is an autogenerated conversion function.
+func Convert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus(in *security.SecurityContextConstraintsReviewStatus, out *v1.SecurityContextConstraintsReviewStatus, s conversion.Scope) error {
+	return autoConvert_security_SecurityContextConstraintsReviewStatus_To_v1_SecurityContextConstraintsReviewStatus(in, out, s)
+}
@@ -181,6 +181,13 @@ func validatePodSecurityPolicySubjectReviewSpec(podSecurityPolicySubjectReviewSp
 	return allErrs
 }
 
+// ValidateSecurityContextConstraintsReview validates SecurityContextConstraintsReview.
+func ValidateSecurityContextConstraintsReview(securityContextConstraintsReview *securityapi.SecurityContextConstraintsReview) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validatePodSecurityPolicySubjectReviewSpec(&securityContextConstraintsReview.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
 // ValidatePodSecurityPolicySelfSubjectReview validates PodSecurityPolicySelfSubjectReview.
 func ValidatePodSecurityPolicySelfSubjectReview(podSecurityPolicySelfSubjectReview *securityapi.PodSecurityPolicySelfSubjectReview) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -519,6 +519,87 @@ func (in *SecurityContextConstraintsList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextConstraintsReview) DeepCopyInto(out *SecurityContextConstraintsReview) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextConstraintsReview.
+func (in *SecurityContextConstraintsReview) DeepCopy() *SecurityContextConstraintsReview {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContextConstraintsReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityContextConstraintsReview) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextConstraintsReviewResult) DeepCopyInto(out *SecurityContextConstraintsReviewResult) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextConstraintsReviewResult.
+func (in *SecurityContextConstraintsReviewResult) DeepCopy() *SecurityContextConstraintsReviewResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContextConstraintsReviewResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextConstraintsReviewStatus) DeepCopyInto(out *SecurityContextConstraintsReviewStatus) {
+	*out = *in
+	if in.AllowedBy != nil {
+		in, out := &in.AllowedBy, &out.AllowedBy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(core.ObjectReference)
+			**out = **in
+		}
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]SecurityContextConstraintsReviewResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextConstraintsReviewStatus.
+func (in *SecurityContextConstraintsReviewStatus) DeepCopy() *SecurityContextConstraintsReviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContextConstraintsReviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountPodSecurityPolicyReviewStatus) DeepCopyInto(out *ServiceAccountPodSecurityPolicyReviewStatus) {
 	*out = *in
@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/origin/pkg/security/registry/podsecuritypolicyselfsubjectreview"
 	"github.com/openshift/origin/pkg/security/registry/podsecuritypolicysubjectreview"
 	sccstorage "github.com/openshift/origin/pkg/security/registry/securitycontextconstraints/etcd"
+	"github.com/openshift/origin/pkg/security/registry/securitycontextconstraintsreview"
 	oscc "github.com/openshift/origin/pkg/security/securitycontextconstraints"
 )
 
@@ -127,11 +128,16 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 		oscc.NewDefaultSCCMatcher(c.ExtraConfig.SecurityInformers.Security().InternalVersion().SecurityContextConstraints().Lister()),
 		kubeInternalClient,
 	)
+	securityContextConstraintsReviewStorage := securitycontextconstraintsreview.NewREST(
+		oscc.NewDefaultSCCMatcher(c.ExtraConfig.SecurityInformers.Security().InternalVersion().SecurityContextConstraints().Lister()),
+		kubeInternalClient,
+	)
 
 	v1Storage := map[string]rest.Storage{}
 	v1Storage["securityContextConstraints"] = sccStorage
 	v1Storage["podSecurityPolicyReviews"] = podSecurityPolicyReviewStorage
 	v1Storage["podSecurityPolicySubjectReviews"] = podSecurityPolicySubjectStorage
 	v1Storage["podSecurityPolicySelfSubjectReviews"] = podSecurityPolicySelfSubjectReviewStorage
+	v1Storage["securityContextConstraintsReviews"] = securityContextConstraintsReviewStorage
 	return v1Storage, nil
 }
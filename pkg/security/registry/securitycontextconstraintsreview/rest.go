@@ -0,0 +1,112 @@
+package securitycontextconstraintsreview
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	kapiref "k8s.io/kubernetes/pkg/api/ref"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/serviceaccount"
+
+	securityapi "github.com/openshift/origin/pkg/security/apis/security"
+	securityvalidation "github.com/openshift/origin/pkg/security/apis/security/validation"
+	scc "github.com/openshift/origin/pkg/security/securitycontextconstraints"
+)
+
+// REST implements the RESTStorage interface in terms of an Registry.
+type REST struct {
+	sccMatcher scc.SCCMatcher
+	client     clientset.Interface
+}
+
+var _ rest.Creater = &REST{}
+
+// NewREST creates a new REST for reviews.
+func NewREST(m scc.SCCMatcher, c clientset.Interface) *REST {
+	return &REST{sccMatcher: m, client: c}
+}
+
+// New creates a new SecurityContextConstraintsReview object
+func (r *REST) New() runtime.Object {
+	return &securityapi.SecurityContextConstraintsReview{}
+}
+
+// Create checks the PodTemplateSpec against every constraint applicable to the given user/SA
+// tuple, in the priority order used during admission, and records why each rejecting
+// constraint rejected it.
+func (r *REST) Create(ctx apirequest.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ bool) (runtime.Object, error) {
+	sccr, ok := obj.(*securityapi.SecurityContextConstraintsReview)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("not a SecurityContextConstraintsReview: %#v", obj))
+	}
+
+	ns, ok := apirequest.NamespaceFrom(ctx)
+	if !ok {
+		return nil, kapierrors.NewBadRequest("namespace parameter required.")
+	}
+
+	if errs := securityvalidation.ValidateSecurityContextConstraintsReview(sccr); len(errs) > 0 {
+		return nil, kapierrors.NewInvalid(kapi.Kind("SecurityContextConstraintsReview"), "", errs)
+	}
+
+	userInfo := &user.DefaultInfo{Name: sccr.Spec.User, Groups: sccr.Spec.Groups}
+	matchedConstraints, err := r.sccMatcher.FindApplicableSCCs(userInfo)
+	if err != nil {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("unable to find SecurityContextConstraints: %v", err))
+	}
+
+	saName := sccr.Spec.Template.Spec.ServiceAccountName
+	if len(saName) > 0 {
+		saUserInfo := serviceaccount.UserInfo(ns, saName, "")
+		saConstraints, err := r.sccMatcher.FindApplicableSCCs(saUserInfo)
+		if err != nil {
+			return nil, kapierrors.NewBadRequest(fmt.Sprintf("unable to find SecurityContextConstraints: %v", err))
+		}
+		matchedConstraints = append(matchedConstraints, saConstraints...)
+	}
+	scc.DeduplicateSecurityContextConstraints(matchedConstraints)
+	sort.Sort(scc.ByPriority(matchedConstraints))
+
+	sccr.Status = securityapi.SecurityContextConstraintsReviewStatus{}
+	var namespace *kapi.Namespace
+	for _, constraint := range matchedConstraints {
+		var (
+			provider scc.SecurityContextConstraintsProvider
+			err      error
+		)
+		if provider, namespace, err = scc.CreateProviderFromConstraint(ns, namespace, constraint, r.client); err != nil {
+			glog.Errorf("Unable to create provider for constraint: %v", err)
+			continue
+		}
+
+		result := securityapi.SecurityContextConstraintsReviewResult{Name: constraint.Name}
+		// AssignSecurityContext mutates the pod it checks, so give each constraint its own copy
+		pod := &kapi.Pod{Spec: *sccr.Spec.Template.Spec.DeepCopy()}
+		if errs := scc.AssignSecurityContext(provider, pod, field.NewPath(fmt.Sprintf("provider %s: ", provider.GetSCCName()))); len(errs) > 0 {
+			for _, err := range errs {
+				result.Reasons = append(result.Reasons, err.Error())
+			}
+		} else {
+			result.Allowed = true
+			if sccr.Status.AllowedBy == nil {
+				ref, err := kapiref.GetReference(legacyscheme.Scheme, constraint)
+				if err != nil {
+					return nil, kapierrors.NewInternalError(fmt.Errorf("unable to get SecurityContextConstraints reference: %v", err))
+				}
+				sccr.Status.AllowedBy = ref
+			}
+		}
+		sccr.Status.Constraints = append(sccr.Status.Constraints, result)
+	}
+	return sccr, nil
+}
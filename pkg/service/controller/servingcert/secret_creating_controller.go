@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -46,6 +47,14 @@ const (
 	// ServingCertExpiryAnnotation is an annotation that holds the expiry time of the certificate.  It accepts time in the
 	// RFC3339 format: 2018-11-29T17:44:39Z
 	ServingCertExpiryAnnotation = "service.alpha.openshift.io/expiry"
+	// ServingCertAdditionalSANsAnnotation is an annotation on a service that holds a comma separated list of
+	// additional DNS names to include in the serving certificate.  Each name must stay within the service's
+	// namespace, i.e. end with .<namespace>.svc or .<namespace>.svc.<suffix>.
+	ServingCertAdditionalSANsAnnotation = "service.alpha.openshift.io/serving-cert-additional-sans"
+	// ServingCertRegenerateAnnotation is an annotation on a serving cert secret that, when set to "true",
+	// forces the certificate to be regenerated on the next sync.  The annotation is removed once the
+	// certificate has been rotated.
+	ServingCertRegenerateAnnotation = "service.alpha.openshift.io/serving-cert-regenerate"
 )
 
 // ServiceServingCertController is responsible for synchronizing Service objects stored
@@ -228,11 +237,23 @@ func (sc *ServiceServingCertController) syncService(key string) error {
 		serviceCopy.Annotations = map[string]string{}
 	}
 
-	dnsName := serviceCopy.Name + "." + serviceCopy.Namespace + ".svc"
-	fqDNSName := dnsName + "." + sc.dnsSuffix
+	dnsNames, err := serverCertDNSNames(serviceCopy, sc.dnsSuffix)
+	if err != nil {
+		// an invalid additional SAN is a user error, not a transient failure; record it on
+		// the service the same way secret creation failures are recorded
+		serviceCopy.Annotations[ServingCertErrorAnnotation] = err.Error()
+		serviceCopy.Annotations[ServingCertErrorNumAnnotation] = strconv.Itoa(getNumFailures(serviceCopy) + 1)
+		_, updateErr := sc.serviceClient.Services(serviceCopy.Namespace).Update(serviceCopy)
+
+		// if we're past the max retries and we successfully updated, then the sync loop successfully handled this service and we want to forget it
+		if updateErr == nil && getNumFailures(serviceCopy) >= sc.maxRetries {
+			return nil
+		}
+		return err
+	}
 	certificateLifetime := 365 * 2 // 2 years
 	servingCert, err := sc.ca.MakeServerCert(
-		sets.NewString(dnsName, fqDNSName),
+		dnsNames,
 		certificateLifetime,
 		extensions.ServiceServerCertificateExtensionV1(serviceCopy),
 	)
@@ -348,6 +369,33 @@ func (sc *ServiceServingCertController) requiresCertGeneration(service *v1.Servi
 	return true
 }
 
+// serverCertDNSNames returns the DNS names to place in the serving certificate for the
+// given service: the in-namespace and fully qualified service names, plus any additional
+// SANs requested via annotation.  Additional SANs are validated to stay within the
+// service's namespace so that a service cannot claim names belonging to other projects.
+func serverCertDNSNames(service *v1.Service, dnsSuffix string) (sets.String, error) {
+	dnsName := service.Name + "." + service.Namespace + ".svc"
+	fqDNSName := dnsName + "." + dnsSuffix
+	names := sets.NewString(dnsName, fqDNSName)
+
+	spec := service.Annotations[ServingCertAdditionalSANsAnnotation]
+	if len(spec) == 0 {
+		return names, nil
+	}
+	namespaceSuffix := "." + service.Namespace + ".svc"
+	for _, san := range strings.Split(spec, ",") {
+		san = strings.TrimSpace(san)
+		if len(san) == 0 {
+			continue
+		}
+		if !strings.HasSuffix(san, namespaceSuffix) && !strings.HasSuffix(san, namespaceSuffix+"."+dnsSuffix) {
+			return nil, fmt.Errorf("additional SAN %q is not in namespace %q: it must end with %q or %q", san, service.Namespace, namespaceSuffix, namespaceSuffix+"."+dnsSuffix)
+		}
+		names.Insert(san)
+	}
+	return names, nil
+}
+
 func ownerRef(service *v1.Service) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion: "v1",
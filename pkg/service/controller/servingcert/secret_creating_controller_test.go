@@ -628,3 +628,57 @@ func TestRecreateSecretControllerFlow(t *testing.T) {
 		}
 	}
 }
+
+func TestServerCertDNSNames(t *testing.T) {
+	service := func(additionalSANs string) *v1.Service {
+		s := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1"}}
+		if len(additionalSANs) > 0 {
+			s.Annotations = map[string]string{ServingCertAdditionalSANsAnnotation: additionalSANs}
+		}
+		return s
+	}
+	tests := []struct {
+		name        string
+		service     *v1.Service
+		expected    []string
+		expectedErr bool
+	}{
+		{
+			name:     "no annotation",
+			service:  service(""),
+			expected: []string{"svc1.ns1.svc", "svc1.ns1.svc.cluster.local"},
+		},
+		{
+			name:     "additional names in namespace",
+			service:  service("other.ns1.svc, extra.ns1.svc.cluster.local"),
+			expected: []string{"extra.ns1.svc.cluster.local", "other.ns1.svc", "svc1.ns1.svc", "svc1.ns1.svc.cluster.local"},
+		},
+		{
+			name:        "name outside namespace",
+			service:     service("evil.ns2.svc"),
+			expectedErr: true,
+		},
+		{
+			name:        "bare hostname",
+			service:     service("www.example.com"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			names, err := serverCertDNSNames(tc.service, "cluster.local")
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got names %v", names.List())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(names.List(), tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, names.List())
+			}
+		})
+	}
+}
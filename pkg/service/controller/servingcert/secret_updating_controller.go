@@ -15,7 +15,9 @@ import (
 	kcoreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
 	"k8s.io/kubernetes/pkg/controller"
 
 	"github.com/openshift/origin/pkg/cmd/server/crypto"
@@ -41,6 +43,11 @@ type ServiceServingCertUpdateController struct {
 	dnsSuffix string
 	// minTimeLeftForCert is how much time is remaining for the serving cert before regenerating it.
 	minTimeLeftForCert time.Duration
+	// certExpiryAlertWindow is how far ahead of expiry a warning event is raised for a serving
+	// cert that has not been rotated yet.
+	certExpiryAlertWindow time.Duration
+
+	eventRecorder record.EventRecorder
 
 	// syncHandler does the work. It's factored out for unit testing
 	syncHandler func(serviceKey string) error
@@ -48,16 +55,22 @@ type ServiceServingCertUpdateController struct {
 
 // NewServiceServingCertUpdateController creates a new ServiceServingCertUpdateController.
 // TODO this should accept a shared informer
-func NewServiceServingCertUpdateController(services informers.ServiceInformer, secrets informers.SecretInformer, secretClient kcoreclient.SecretsGetter, ca *crypto.CA, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertUpdateController {
+func NewServiceServingCertUpdateController(services informers.ServiceInformer, secrets informers.SecretInformer, coreClient kcoreclient.CoreV1Interface, ca *crypto.CA, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertUpdateController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&kcoreclient.EventSinkImpl{Interface: coreClient.Events("")})
+
 	sc := &ServiceServingCertUpdateController{
-		secretClient: secretClient,
+		secretClient: coreClient,
 
 		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 
 		ca:        ca,
 		dnsSuffix: dnsSuffix,
 		// TODO base the expiry time on a percentage of the time for the lifespan of the cert
-		minTimeLeftForCert: 1 * time.Hour,
+		minTimeLeftForCert:    1 * time.Hour,
+		certExpiryAlertWindow: 30 * 24 * time.Hour,
+
+		eventRecorder: eventBroadcaster.NewRecorder(legacyscheme.Scheme, v1.EventSource{Component: "service-serving-cert-update-controller"}),
 	}
 
 	sc.serviceLister = services.Lister()
@@ -176,17 +189,21 @@ func (sc *ServiceServingCertUpdateController) syncSecret(key string) error {
 
 	regenerate, service := sc.requiresRegeneration(sharedSecret)
 	if !regenerate {
+		sc.alertOnPendingExpiry(sharedSecret)
 		return nil
 	}
 
 	// make a copy to avoid mutating cache state
 	secretCopy := sharedSecret.DeepCopy()
 
-	dnsName := service.Name + "." + secretCopy.Namespace + ".svc"
-	fqDNSName := dnsName + "." + sc.dnsSuffix
+	dnsNames, err := serverCertDNSNames(service, sc.dnsSuffix)
+	if err != nil {
+		sc.eventRecorder.Eventf(service, v1.EventTypeWarning, "ServingCertRotationFailed", "unable to regenerate serving certificate in secret %s/%s: %v", secretCopy.Namespace, secretCopy.Name, err)
+		return err
+	}
 	certificateLifetime := 365 * 2 // 2 years
 	servingCert, err := sc.ca.MakeServerCert(
-		sets.NewString(dnsName, fqDNSName),
+		dnsNames,
 		certificateLifetime,
 		extensions.ServiceServerCertificateExtensionV1(service),
 	)
@@ -194,6 +211,7 @@ func (sc *ServiceServingCertUpdateController) syncSecret(key string) error {
 		return err
 	}
 	secretCopy.Annotations[ServingCertExpiryAnnotation] = servingCert.Certs[0].NotAfter.Format(time.RFC3339)
+	delete(secretCopy.Annotations, ServingCertRegenerateAnnotation)
 	secretCopy.Data[v1.TLSCertKey], secretCopy.Data[v1.TLSPrivateKeyKey], err = servingCert.GetPEMBytes()
 	if err != nil {
 		return err
@@ -201,9 +219,29 @@ func (sc *ServiceServingCertUpdateController) syncSecret(key string) error {
 	ocontroller.EnsureOwnerRef(secretCopy, ownerRef(service))
 
 	_, err = sc.secretClient.Secrets(secretCopy.Namespace).Update(secretCopy)
+	if err == nil {
+		sc.eventRecorder.Eventf(service, v1.EventTypeNormal, "ServingCertRotated", "regenerated serving certificate in secret %s/%s", secretCopy.Namespace, secretCopy.Name)
+	}
 	return err
 }
 
+// alertOnPendingExpiry raises a warning event when the serving cert in the secret is
+// nearing expiry but has not been rotated yet, giving admins advance notice.
+func (sc *ServiceServingCertUpdateController) alertOnPendingExpiry(secret *v1.Secret) {
+	expiryString, ok := secret.Annotations[ServingCertExpiryAnnotation]
+	if !ok {
+		return
+	}
+	expiry, err := time.Parse(time.RFC3339, expiryString)
+	if err != nil {
+		return
+	}
+	if time.Now().Add(sc.certExpiryAlertWindow).Before(expiry) {
+		return
+	}
+	sc.eventRecorder.Eventf(secret, v1.EventTypeWarning, "ServingCertNearingExpiry", "serving certificate in secret %s/%s expires at %s and will be rotated automatically", secret.Namespace, secret.Name, expiryString)
+}
+
 func (sc *ServiceServingCertUpdateController) requiresRegeneration(secret *v1.Secret) (bool, *v1.Service) {
 	serviceName := secret.Annotations[ServiceNameAnnotation]
 	if len(serviceName) == 0 {
@@ -226,6 +264,21 @@ func (sc *ServiceServingCertUpdateController) requiresRegeneration(secret *v1.Se
 		return false, nil
 	}
 
+	// explicit rotation request
+	if secret.Annotations[ServingCertRegenerateAnnotation] == "true" {
+		return true, sharedService
+	}
+
+	// regenerate when the requested SANs are not covered by the current certificate, so that
+	// changes to the additional SANs annotation take effect without a forced rotation
+	if dnsNames, err := serverCertDNSNames(sharedService, sc.dnsSuffix); err == nil {
+		if certs, err := crypto.CertsFromPEM(secret.Data[v1.TLSCertKey]); err == nil && len(certs) > 0 {
+			if !sets.NewString(certs[0].DNSNames...).IsSuperset(dnsNames) {
+				return true, sharedService
+			}
+		}
+	}
+
 	// if we don't have an ownerref, just go ahead and regenerate.  It's easier than writing a
 	// secondary logic flow.
 	if !ocontroller.HasOwnerRef(secret, ownerRef(sharedService)) {
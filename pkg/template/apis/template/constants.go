@@ -39,4 +39,15 @@ const (
 	// BindableAnnotation indicates whether the template service broker should
 	// advertise the template as being bindable (default is true)
 	BindableAnnotation = "template.openshift.io/bindable"
+
+	// ParameterTypeAnnotationPrefix, suffixed with a parameter name, declares the
+	// JSON Schema type ("string", "integer", "number" or "boolean") that the
+	// template service broker should advertise for that parameter in its catalog
+	// response.  Parameters without the annotation are advertised as strings.
+	ParameterTypeAnnotationPrefix = "template.openshift.io/parameter-type-"
+
+	// ParameterEnumAnnotationPrefix, suffixed with a parameter name, declares a
+	// comma separated list of permitted values that the template service broker
+	// should advertise for that parameter in its catalog response.
+	ParameterEnumAnnotationPrefix = "template.openshift.io/parameter-enum-"
 )
@@ -59,6 +59,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Template{},
 		&TemplateList{},
+		&TemplateInstantiateRequest{},
 		&TemplateInstance{},
 		&TemplateInstanceList{},
 		&BrokerTemplateInstance{},
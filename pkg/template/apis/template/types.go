@@ -77,6 +77,81 @@ type Parameter struct {
 
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool
+
+	// Optional: ValueFrom selects the parameter value from a key of a Secret
+	// or ConfigMap in the namespace where the template is instantiated, so
+	// that sensitive values need not pass through the client or be stored in
+	// the TemplateInstance spec in cleartext. It takes precedence over Value
+	// and Generate.
+	ValueFrom *ParameterSource
+
+	// Optional: Type constrains the parameter value. One of string (the
+	// default), int, bool, base64 or json. A value that does not parse as
+	// the declared type is rejected during instantiation.
+	Type ParameterType
+
+	// Optional: Pattern is a regular expression the parameter value must
+	// match. The expression is anchored to the complete value.
+	Pattern string
+
+	// Optional: AllowedValues enumerates the only values the parameter may
+	// take. An empty list places no restriction on the value.
+	AllowedValues []string
+
+	// Optional: Min is the minimum permitted numeric value for an int
+	// parameter, or the minimum length for any other parameter type.
+	Min *int64
+
+	// Optional: Max is the maximum permitted numeric value for an int
+	// parameter, or the maximum length for any other parameter type.
+	Max *int64
+}
+
+// ParameterSource selects the source of a parameter value. Exactly one of
+// its members must be set.
+type ParameterSource struct {
+	// SecretKeyRef selects a key of a Secret in the namespace where the
+	// template is instantiated.
+	SecretKeyRef *kapi.SecretKeySelector
+
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace where the
+	// template is instantiated.
+	ConfigMapKeyRef *kapi.ConfigMapKeySelector
+}
+
+// ParameterType identifies how a parameter value is interpreted and
+// validated during template instantiation.
+type ParameterType string
+
+const (
+	// ParameterTypeString places no structural restriction on the value.
+	ParameterTypeString ParameterType = "string"
+	// ParameterTypeInt requires the value to parse as a base 10 integer.
+	ParameterTypeInt ParameterType = "int"
+	// ParameterTypeBool requires the value to parse as a boolean.
+	ParameterTypeBool ParameterType = "bool"
+	// ParameterTypeBase64 requires the value to be valid base64 data.
+	ParameterTypeBase64 ParameterType = "base64"
+	// ParameterTypeJSON requires the value to be a valid JSON document.
+	ParameterTypeJSON ParameterType = "json"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TemplateInstantiateRequest requests server-side instantiation of a stored
+// template through the templates/instantiate subresource. The request name
+// identifies the template to instantiate.
+type TemplateInstantiateRequest struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Parameters are values applied by name to the template's parameters
+	// before processing. Names that do not match a template parameter are
+	// rejected.
+	Parameters map[string]string
+
+	// DryRun, when true, returns the rendered objects without creating them.
+	DryRun bool
 }
 
 // +genclient
@@ -138,6 +213,10 @@ type TemplateInstanceStatus struct {
 
 	// Objects references the objects created by the TemplateInstance.
 	Objects []TemplateInstanceObject
+
+	// ObservedGeneration is the most recent generation of the spec that has
+	// been reconciled into created objects.
+	ObservedGeneration int64
 }
 
 // TemplateInstanceCondition contains condition information for a
@@ -31,6 +31,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_template_BrokerTemplateInstanceSpec_To_v1_BrokerTemplateInstanceSpec,
 		Convert_v1_Parameter_To_template_Parameter,
 		Convert_template_Parameter_To_v1_Parameter,
+		Convert_v1_ParameterSource_To_template_ParameterSource,
+		Convert_template_ParameterSource_To_v1_ParameterSource,
 		Convert_v1_Template_To_template_Template,
 		Convert_template_Template_To_v1_Template,
 		Convert_v1_TemplateInstance_To_template_TemplateInstance,
@@ -47,6 +49,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_template_TemplateInstanceSpec_To_v1_TemplateInstanceSpec,
 		Convert_v1_TemplateInstanceStatus_To_template_TemplateInstanceStatus,
 		Convert_template_TemplateInstanceStatus_To_v1_TemplateInstanceStatus,
+		Convert_v1_TemplateInstantiateRequest_To_template_TemplateInstantiateRequest,
+		Convert_template_TemplateInstantiateRequest_To_v1_TemplateInstantiateRequest,
 		Convert_v1_TemplateList_To_template_TemplateList,
 		Convert_template_TemplateList_To_v1_TemplateList,
 	)
@@ -160,6 +164,20 @@ func autoConvert_v1_Parameter_To_template_Parameter(in *v1.Parameter, out *templ
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(template.ParameterSource)
+		if err := Convert_v1_ParameterSource_To_template_ParameterSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	out.Type = template.ParameterType(in.Type)
+	out.Pattern = in.Pattern
+	out.AllowedValues = *(*[]string)(unsafe.Pointer(&in.AllowedValues))
+	out.Min = (*int64)(unsafe.Pointer(in.Min))
+	out.Max = (*int64)(unsafe.Pointer(in.Max))
 	return nil
 }
 
@@ -176,6 +194,20 @@ func autoConvert_template_Parameter_To_v1_Parameter(in *template.Parameter, out
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(v1.ParameterSource)
+		if err := Convert_template_ParameterSource_To_v1_ParameterSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	out.Type = v1.ParameterType(in.Type)
+	out.Pattern = in.Pattern
+	out.AllowedValues = *(*[]string)(unsafe.Pointer(&in.AllowedValues))
+	out.Min = (*int64)(unsafe.Pointer(in.Min))
+	out.Max = (*int64)(unsafe.Pointer(in.Max))
 	return nil
 }
 
@@ -184,6 +216,60 @@ func Convert_template_Parameter_To_v1_Parameter(in *template.Parameter, out *v1.
 	return autoConvert_template_Parameter_To_v1_Parameter(in, out, s)
 }
 
+func autoConvert_v1_ParameterSource_To_template_ParameterSource(in *v1.ParameterSource, out *template.ParameterSource, s conversion.Scope) error {
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(core.SecretKeySelector)
+		if err := core_v1.Convert_v1_SecretKeySelector_To_core_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(core.ConfigMapKeySelector)
+		if err := core_v1.Convert_v1_ConfigMapKeySelector_To_core_ConfigMapKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_ParameterSource_To_template_ParameterSource is an autogenerated conversion function.
+func Convert_v1_ParameterSource_To_template_ParameterSource(in *v1.ParameterSource, out *template.ParameterSource, s conversion.Scope) error {
+	return autoConvert_v1_ParameterSource_To_template_ParameterSource(in, out, s)
+}
+
+func autoConvert_template_ParameterSource_To_v1_ParameterSource(in *template.ParameterSource, out *v1.ParameterSource, s conversion.Scope) error {
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(api_core_v1.SecretKeySelector)
+		if err := core_v1.Convert_core_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(api_core_v1.ConfigMapKeySelector)
+		if err := core_v1.Convert_core_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	return nil
+}
+
+// Convert_template_ParameterSource_To_v1_ParameterSource is an autogenerated conversion function.
+func Convert_template_ParameterSource_To_v1_ParameterSource(in *template.ParameterSource, out *v1.ParameterSource, s conversion.Scope) error {
+	return autoConvert_template_ParameterSource_To_v1_ParameterSource(in, out, s)
+}
+
 func autoConvert_v1_Template_To_template_Template(in *v1.Template, out *template.Template, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Message = in.Message
@@ -198,7 +284,17 @@ func autoConvert_v1_Template_To_template_Template(in *v1.Template, out *template
 	} else {
 		out.Objects = nil
 	}
-	out.Parameters = *(*[]template.Parameter)(unsafe.Pointer(&in.Parameters))
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]template.Parameter, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Parameter_To_template_Parameter(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	out.ObjectLabels = *(*map[string]string)(unsafe.Pointer(&in.ObjectLabels))
 	return nil
 }
@@ -211,7 +307,17 @@ func Convert_v1_Template_To_template_Template(in *v1.Template, out *template.Tem
 func autoConvert_template_Template_To_v1_Template(in *template.Template, out *v1.Template, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Message = in.Message
-	out.Parameters = *(*[]v1.Parameter)(unsafe.Pointer(&in.Parameters))
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]v1.Parameter, len(*in))
+		for i := range *in {
+			if err := Convert_template_Parameter_To_v1_Parameter(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	if in.Objects != nil {
 		in, out := &in.Objects, &out.Objects
 		*out = make([]runtime.RawExtension, len(*in))
@@ -441,6 +547,7 @@ func autoConvert_v1_TemplateInstanceStatus_To_template_TemplateInstanceStatus(in
 	} else {
 		out.Objects = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
@@ -462,6 +569,7 @@ func autoConvert_template_TemplateInstanceStatus_To_v1_TemplateInstanceStatus(in
 	} else {
 		out.Objects = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
@@ -470,6 +578,30 @@ func Convert_template_TemplateInstanceStatus_To_v1_TemplateInstanceStatus(in *te
 	return autoConvert_template_TemplateInstanceStatus_To_v1_TemplateInstanceStatus(in, out, s)
 }
 
+func autoConvert_v1_TemplateInstantiateRequest_To_template_TemplateInstantiateRequest(in *v1.TemplateInstantiateRequest, out *template.TemplateInstantiateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1_TemplateInstantiateRequest_To_template_TemplateInstantiateRequest is an autogenerated conversion function.
+func Convert_v1_TemplateInstantiateRequest_To_template_TemplateInstantiateRequest(in *v1.TemplateInstantiateRequest, out *template.TemplateInstantiateRequest, s conversion.Scope) error {
+	return autoConvert_v1_TemplateInstantiateRequest_To_template_TemplateInstantiateRequest(in, out, s)
+}
+
+func autoConvert_template_TemplateInstantiateRequest_To_v1_TemplateInstantiateRequest(in *template.TemplateInstantiateRequest, out *v1.TemplateInstantiateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_template_TemplateInstantiateRequest_To_v1_TemplateInstantiateRequest is an autogenerated conversion function.
+func Convert_template_TemplateInstantiateRequest_To_v1_TemplateInstantiateRequest(in *template.TemplateInstantiateRequest, out *v1.TemplateInstantiateRequest, s conversion.Scope) error {
+	return autoConvert_template_TemplateInstantiateRequest_To_v1_TemplateInstantiateRequest(in, out, s)
+}
+
 func autoConvert_v1_TemplateList_To_template_TemplateList(in *v1.TemplateList, out *template.TemplateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
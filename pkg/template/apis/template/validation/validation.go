@@ -1,8 +1,11 @@
 package validation
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
@@ -14,6 +17,14 @@ import (
 
 var ParameterNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
 
+var validParameterTypes = []string{
+	string(templateapi.ParameterTypeString),
+	string(templateapi.ParameterTypeInt),
+	string(templateapi.ParameterTypeBool),
+	string(templateapi.ParameterTypeBase64),
+	string(templateapi.ParameterTypeJSON),
+}
+
 // ValidateParameter tests if required fields in the Parameter are set.
 func ValidateParameter(param *templateapi.Parameter, fldPath *field.Path) (allErrs field.ErrorList) {
 	if len(param.Name) == 0 {
@@ -23,6 +34,123 @@ func ValidateParameter(param *templateapi.Parameter, fldPath *field.Path) (allEr
 	if !ParameterNameRegexp.MatchString(param.Name) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), param.Name, fmt.Sprintf("does not match %v", ParameterNameRegexp)))
 	}
+	if len(param.Type) > 0 {
+		found := false
+		for _, t := range validParameterTypes {
+			if string(param.Type) == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), param.Type, validParameterTypes))
+		}
+	}
+	if len(param.Pattern) > 0 {
+		if _, err := regexp.Compile(param.Pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pattern"), param.Pattern, fmt.Sprintf("must be a valid regular expression: %v", err)))
+		}
+	}
+	if param.Min != nil && param.Max != nil && *param.Min > *param.Max {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), *param.Min, "min may not be greater than max"))
+	}
+	if param.ValueFrom != nil {
+		allErrs = append(allErrs, validateParameterSource(param.ValueFrom, fldPath.Child("valueFrom"))...)
+	}
+	return
+}
+
+// validateParameterSource tests that a parameter source selects exactly one
+// key of a Secret or ConfigMap.
+func validateParameterSource(source *templateapi.ParameterSource, fldPath *field.Path) (allErrs field.ErrorList) {
+	numSources := 0
+	if source.SecretKeyRef != nil {
+		numSources++
+		if len(source.SecretKeyRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "name"), ""))
+		}
+		if len(source.SecretKeyRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "key"), ""))
+		}
+	}
+	if source.ConfigMapKeyRef != nil {
+		numSources++
+		if len(source.ConfigMapKeyRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "name"), ""))
+		}
+		if len(source.ConfigMapKeyRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "key"), ""))
+		}
+	}
+	if numSources != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, source, "exactly one of secretKeyRef or configMapKeyRef must be specified"))
+	}
+	return
+}
+
+// ValidateParameterValue tests the resolved value of a Parameter against the
+// constraints the parameter declares. It assumes generators have already run,
+// so an empty value for a non-required parameter is accepted.
+func ValidateParameterValue(param *templateapi.Parameter, fldPath *field.Path) (allErrs field.ErrorList) {
+	if len(param.Value) == 0 {
+		return
+	}
+
+	var intValue *int64
+	switch param.Type {
+	case "", templateapi.ParameterTypeString:
+	case templateapi.ParameterTypeInt:
+		v, err := strconv.ParseInt(param.Value, 10, 64)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, "must be an integer"))
+		} else {
+			intValue = &v
+		}
+	case templateapi.ParameterTypeBool:
+		if _, err := strconv.ParseBool(param.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, "must be a boolean"))
+		}
+	case templateapi.ParameterTypeBase64:
+		if _, err := base64.StdEncoding.DecodeString(param.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, "must be valid base64 data"))
+		}
+	case templateapi.ParameterTypeJSON:
+		if !json.Valid([]byte(param.Value)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, "must be a valid JSON document"))
+		}
+	}
+
+	if len(param.Pattern) > 0 {
+		if re, err := regexp.Compile(`\A(?:` + param.Pattern + `)\z`); err == nil && !re.MatchString(param.Value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, fmt.Sprintf("does not match pattern %q", param.Pattern)))
+		}
+	}
+
+	if len(param.AllowedValues) > 0 {
+		found := false
+		for _, allowed := range param.AllowedValues {
+			if param.Value == allowed {
+				found = true
+				break
+			}
+		}
+		if !found {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("value"), param.Value, param.AllowedValues))
+		}
+	}
+
+	// Min and Max bound the numeric value of an int parameter and the length
+	// of every other parameter type.
+	measure := int64(len(param.Value))
+	if intValue != nil {
+		measure = *intValue
+	}
+	if param.Min != nil && measure < *param.Min {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, fmt.Sprintf("must not be less than %d", *param.Min)))
+	}
+	if param.Max != nil && measure > *param.Max {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), param.Value, fmt.Sprintf("must not be greater than %d", *param.Max)))
+	}
 	return
 }
 
@@ -93,8 +221,32 @@ func ValidateTemplateInstance(templateInstance *templateapi.TemplateInstance) (a
 func ValidateTemplateInstanceUpdate(templateInstance, oldTemplateInstance *templateapi.TemplateInstance) (allErrs field.ErrorList) {
 	allErrs = validation.ValidateObjectMetaUpdate(&templateInstance.ObjectMeta, &oldTemplateInstance.ObjectMeta, field.NewPath("metadata"))
 
-	if !kapihelper.Semantic.DeepEqual(templateInstance.Spec, oldTemplateInstance.Spec) {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	// The template and its parameter inputs may change so that the previously
+	// created objects can be upgraded, but the requester is fixed at creation
+	// time.
+	if !kapihelper.Semantic.DeepEqual(templateInstance.Spec.Requester, oldTemplateInstance.Spec.Requester) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.requester"), "field is immutable"))
+	}
+
+	templateCopy := templateInstance.Spec.Template.DeepCopy()
+	if templateCopy.Name == "" {
+		templateCopy.Name = "dummy"
+	}
+	if templateCopy.Namespace == "" {
+		templateCopy.Namespace = "dummy"
+	}
+	for _, err := range ValidateTemplate(templateCopy) {
+		err.Field = "spec.template." + err.Field
+		allErrs = append(allErrs, err)
+	}
+	if templateInstance.Spec.Secret != nil {
+		if templateInstance.Spec.Secret.Name != "" {
+			for _, msg := range validation.ValidateSecretName(templateInstance.Spec.Secret.Name, false) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec.secret.name"), templateInstance.Spec.Secret.Name, msg))
+			}
+		} else {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.secret.name"), ""))
+		}
 	}
 	return
 }
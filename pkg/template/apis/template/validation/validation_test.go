@@ -50,6 +50,44 @@ func TestValidateParameter(t *testing.T) {
 	}
 }
 
+func TestValidateParameterValue(t *testing.T) {
+	min := int64(2)
+	max := int64(10)
+	var tests = []struct {
+		parameter       templateapi.Parameter
+		isValidExpected bool
+	}{
+		{templateapi.Parameter{Name: "UNTYPED", Value: "anything"}, true},
+		{templateapi.Parameter{Name: "EMPTY", Type: templateapi.ParameterTypeInt}, true},
+		{templateapi.Parameter{Name: "INT", Type: templateapi.ParameterTypeInt, Value: "42"}, true},
+		{templateapi.Parameter{Name: "INT", Type: templateapi.ParameterTypeInt, Value: "forty-two"}, false},
+		{templateapi.Parameter{Name: "BOOL", Type: templateapi.ParameterTypeBool, Value: "true"}, true},
+		{templateapi.Parameter{Name: "BOOL", Type: templateapi.ParameterTypeBool, Value: "yes"}, false},
+		{templateapi.Parameter{Name: "B64", Type: templateapi.ParameterTypeBase64, Value: "aGVsbG8="}, true},
+		{templateapi.Parameter{Name: "B64", Type: templateapi.ParameterTypeBase64, Value: "not base64!"}, false},
+		{templateapi.Parameter{Name: "JSON", Type: templateapi.ParameterTypeJSON, Value: `{"a": 1}`}, true},
+		{templateapi.Parameter{Name: "JSON", Type: templateapi.ParameterTypeJSON, Value: `{"a": `}, false},
+		{templateapi.Parameter{Name: "PATTERN", Pattern: `[a-z]+`, Value: "lower"}, true},
+		{templateapi.Parameter{Name: "PATTERN", Pattern: `[a-z]+`, Value: "UPPER"}, false},
+		{templateapi.Parameter{Name: "ENUM", AllowedValues: []string{"small", "large"}, Value: "small"}, true},
+		{templateapi.Parameter{Name: "ENUM", AllowedValues: []string{"small", "large"}, Value: "medium"}, false},
+		{templateapi.Parameter{Name: "RANGE", Type: templateapi.ParameterTypeInt, Min: &min, Max: &max, Value: "5"}, true},
+		{templateapi.Parameter{Name: "RANGE", Type: templateapi.ParameterTypeInt, Min: &min, Max: &max, Value: "11"}, false},
+		{templateapi.Parameter{Name: "LENGTH", Min: &min, Max: &max, Value: "abc"}, true},
+		{templateapi.Parameter{Name: "LENGTH", Min: &min, Max: &max, Value: "a"}, false},
+	}
+
+	for i, test := range tests {
+		errs := ValidateParameterValue(&test.parameter, field.NewPath("parameters").Index(i))
+		if test.isValidExpected && len(errs) != 0 {
+			t.Errorf("%d: expected zero validation errors on parameter %s=%q, got %v", i, test.parameter.Name, test.parameter.Value, errs)
+		}
+		if !test.isValidExpected && len(errs) == 0 {
+			t.Errorf("%d: expected validation errors on parameter %s=%q", i, test.parameter.Name, test.parameter.Value)
+		}
+	}
+}
+
 func TestValidateProcessTemplate(t *testing.T) {
 	var tests = []struct {
 		template        *templateapi.Template
@@ -371,61 +409,56 @@ func TestValidateTemplateInstanceUpdate(t *testing.T) {
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Name = "new"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Name = "b@d"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
+			expectedErrorType: field.ErrorTypeInvalid,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Namespace = "new"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Namespace = "b@d"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
+			expectedErrorType: field.ErrorTypeInvalid,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Parameters[0].Name = "new"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Parameters[0].Name = "b@d"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
+			expectedErrorType: field.ErrorTypeInvalid,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Template.Parameters = nil
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Secret.Name = "new"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Secret.Name = "b@d"
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
+			expectedErrorType: field.ErrorTypeInvalid,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
 				new.Spec.Secret.Name = ""
 			},
-			expectedErrorType: field.ErrorTypeForbidden,
+			expectedErrorType: field.ErrorTypeRequired,
 		},
 		{
 			modifyTemplateInstance: func(new *templateapi.TemplateInstance) {
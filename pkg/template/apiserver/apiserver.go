@@ -10,6 +10,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	restclient "k8s.io/client-go/rest"
+	kclientsetinternal "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	authorizationclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/authorization/internalversion"
 
 	templateapiv1 "github.com/openshift/api/template/v1"
@@ -101,6 +102,10 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 	if err != nil {
 		return nil, err
 	}
+	kubeClient, err := kclientsetinternal.NewForConfig(c.ExtraConfig.KubeAPIServerClientConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	templateStorage, err := templateetcd.NewREST(c.GenericConfig.RESTOptionsGetter)
 	if err != nil {
@@ -118,6 +123,7 @@ func (c *completedConfig) newV1RESTStorage() (map[string]rest.Storage, error) {
 	v1Storage := map[string]rest.Storage{}
 	v1Storage["processedTemplates"] = templateregistry.NewREST()
 	v1Storage["templates"] = templateStorage
+	v1Storage["templates/instantiate"] = templateregistry.NewInstantiateREST(templateStorage, kubeClient, c.ExtraConfig.KubeAPIServerClientConfig)
 	v1Storage["templateinstances"] = templateInstanceStorage
 	v1Storage["templateinstances/status"] = templateInstanceStatusStorage
 	v1Storage["brokertemplateinstances"] = brokerTemplateInstanceStorage
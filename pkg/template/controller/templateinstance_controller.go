@@ -13,9 +13,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kerrs "k8s.io/apimachinery/pkg/util/errors"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/client-go/rest"
@@ -25,6 +29,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/authorization"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
 	kclientsetinternal "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 
 	"github.com/golang/glog"
@@ -34,6 +39,7 @@ import (
 	"github.com/openshift/origin/pkg/authorization/util"
 	buildclient "github.com/openshift/origin/pkg/build/generated/internalclientset"
 	"github.com/openshift/origin/pkg/bulk"
+	templatepkg "github.com/openshift/origin/pkg/template"
 	templateapi "github.com/openshift/origin/pkg/template/apis/template"
 	templateinternalclient "github.com/openshift/origin/pkg/template/client/internalversion"
 	"github.com/openshift/origin/pkg/template/generated/informers/internalversion/template/internalversion"
@@ -119,8 +125,9 @@ func (c *TemplateInstanceController) sync(key string) error {
 		return err
 	}
 
-	if templateInstance.HasCondition(templateapi.TemplateInstanceReady, kapi.ConditionTrue) ||
-		templateInstance.HasCondition(templateapi.TemplateInstanceInstantiateFailure, kapi.ConditionTrue) {
+	if templateInstance.Status.ObservedGeneration == templateInstance.Generation &&
+		(templateInstance.HasCondition(templateapi.TemplateInstanceReady, kapi.ConditionTrue) ||
+			templateInstance.HasCondition(templateapi.TemplateInstanceInstantiateFailure, kapi.ConditionTrue)) {
 		return nil
 	}
 
@@ -129,7 +136,8 @@ func (c *TemplateInstanceController) sync(key string) error {
 	// TODO: Rename this to templateInstanceCopy
 	templateInstance = templateInstance.DeepCopy()
 
-	if len(templateInstance.Status.Objects) != len(templateInstance.Spec.Template.Objects) {
+	if len(templateInstance.Status.Objects) != len(templateInstance.Spec.Template.Objects) ||
+		templateInstance.Status.ObservedGeneration != templateInstance.Generation {
 		err = c.instantiate(templateInstance)
 		if err != nil {
 			glog.V(4).Infof("TemplateInstance controller: instantiate %s returned %v", key, err)
@@ -140,6 +148,15 @@ func (c *TemplateInstanceController) sync(key string) error {
 				Reason:  "Failed",
 				Message: formatError(err),
 			})
+		} else {
+			// clear any failure left over from a previous generation so that
+			// readiness for the upgraded objects is re-evaluated.
+			templateInstance.SetCondition(templateapi.TemplateInstanceCondition{
+				Type:   templateapi.TemplateInstanceInstantiateFailure,
+				Status: kapi.ConditionFalse,
+				Reason: "Instantiated",
+			})
+			templateInstance.Status.ObservedGeneration = templateInstance.Generation
 		}
 	}
 
@@ -382,6 +399,20 @@ func (c *TemplateInstanceController) instantiate(templateInstance *templateapi.T
 		}
 	}
 
+	// resolve parameters that select their value from a Secret or ConfigMap
+	// key, checking that the requester may read each referenced object.
+	if err := templatepkg.ResolveParameterSources(c.kc.Core(), templateInstance.Namespace, template, func(resource, name string) error {
+		return util.Authorize(c.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
+			Namespace: templateInstance.Namespace,
+			Verb:      "get",
+			Group:     kapi.GroupName,
+			Resource:  resource,
+			Name:      name,
+		})
+	}); err != nil {
+		return err
+	}
+
 	if err := util.Authorize(c.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
 		Namespace: templateInstance.Namespace,
 		Verb:      "create",
@@ -439,14 +470,18 @@ func (c *TemplateInstanceController) instantiate(templateInstance *templateapi.T
 			if info.Mapping.Resource == "" {
 				return nil, errors.New("resource was empty")
 			}
-			if err := util.Authorize(c.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      "create",
-				Group:     info.Mapping.GroupVersionKind.Group,
-				Resource:  info.Mapping.Resource,
-				Name:      info.Name,
-			}); err != nil {
-				return nil, err
+			// the requester needs both create (for first instantiation) and
+			// update (for upgrading previously created objects).
+			for _, verb := range []string{"create", "update"} {
+				if err := util.Authorize(c.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      verb,
+					Group:     info.Mapping.GroupVersionKind.Group,
+					Resource:  info.Mapping.Resource,
+					Name:      info.Name,
+				}); err != nil {
+					return nil, err
+				}
 			}
 			return obj, nil
 		},
@@ -468,21 +503,29 @@ func (c *TemplateInstanceController) instantiate(templateInstance *templateapi.T
 		if len(info.Namespace) > 0 {
 			namespace = info.Namespace
 		}
+		codec := legacyscheme.Codecs.LegacyCodec(info.Mapping.GroupVersionKind.GroupVersion())
+		// record the rendered configuration so that a later spec change can be
+		// three-way merged into the object.
+		if err := kubectl.CreateApplyAnnotation(info, codec); err != nil {
+			return nil, err
+		}
 		createObj, createErr := helper.Create(namespace, false, obj)
 		if kerrors.IsAlreadyExists(createErr) {
-			obj, err := helper.Get(namespace, info.Name, false)
+			existing, err := helper.Get(namespace, info.Name, false)
 			if err != nil {
 				return nil, err
 			}
 
-			meta, err := meta.Accessor(obj)
+			meta, err := meta.Accessor(existing)
 			if err != nil {
 				return nil, err
 			}
 
 			for _, ownerRef := range meta.GetOwnerReferences() {
 				if reflect.DeepEqual(ownerRef, templateInstanceOwnerRef) {
-					createObj, createErr = obj, nil
+					// the object was created by us: upgrade it to the newly
+					// rendered configuration.
+					createObj, createErr = c.upgradeObject(info, helper, codec, existing, namespace)
 					break
 				}
 			}
@@ -526,6 +569,64 @@ func (c *TemplateInstanceController) instantiate(templateInstance *templateapi.T
 	return nil
 }
 
+// upgradeObject applies a freshly rendered template object to an object that a
+// previous instantiation created, using a three-way merge between the last
+// applied configuration, the new rendering and the live object so that changes
+// made out of band (e.g. by other controllers) are preserved.
+func (c *TemplateInstanceController) upgradeObject(info *resource.Info, helper *resource.Helper, codec runtime.Codec, existing runtime.Object, namespace string) (runtime.Object, error) {
+	current, err := runtime.Encode(codec, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := kubectl.GetOriginalConfiguration(info.Mapping, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	modified, err := kubectl.GetModifiedConfiguration(info, true, codec)
+	if err != nil {
+		return nil, err
+	}
+
+	var patchType types.PatchType
+	var patch []byte
+
+	versionedObject, err := legacyscheme.Scheme.New(info.Mapping.GroupVersionKind)
+	switch {
+	case runtime.IsNotRegisteredError(err):
+		// fall back to generic JSON merge patch for unregistered types.
+		patchType = types.MergePatchType
+		preconditions := []mergepatch.PreconditionFunc{
+			mergepatch.RequireKeyUnchanged("apiVersion"),
+			mergepatch.RequireKeyUnchanged("kind"),
+			mergepatch.RequireMetadataKeyUnchanged("name"),
+		}
+		patch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current, preconditions...)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		patchType = types.StrategicMergePatchType
+		lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+		if err != nil {
+			return nil, err
+		}
+		patch, err = strategicpatch.CreateThreeWayMergePatch(original, modified, current, lookupPatchMeta, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if string(patch) == "{}" {
+		return existing, nil
+	}
+
+	return helper.Patch(namespace, info.Name, patchType, patch)
+}
+
 // formatError returns err.Error(), unless err is an Aggregate, in which case it
 // "\n"-separates the contained errors.
 func formatError(err error) string {
@@ -0,0 +1,78 @@
+package template
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+)
+
+// ResolveParameterSources fills in the values of template parameters that
+// select their value from a Secret or ConfigMap key in the given namespace.
+// authorize, if non-nil, is called with the resource ("secrets" or
+// "configmaps") and name of each referenced object before it is read, so
+// callers can check that the requester may read it.  A resolved parameter's
+// generator is cleared, as for values supplied directly.
+func ResolveParameterSources(client kcoreclient.CoreInterface, namespace string, t *templateapi.Template, authorize func(resource, name string) error) error {
+	for i := range t.Parameters {
+		param := &t.Parameters[i]
+		if param.ValueFrom == nil {
+			continue
+		}
+
+		var value string
+		var found bool
+		var optional bool
+
+		switch {
+		case param.ValueFrom.SecretKeyRef != nil:
+			ref := param.ValueFrom.SecretKeyRef
+			if authorize != nil {
+				if err := authorize("secrets", ref.Name); err != nil {
+					return err
+				}
+			}
+			secret, err := client.Secrets(namespace).Get(ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("parameter %s: %v", param.Name, err)
+			}
+			var data []byte
+			data, found = secret.Data[ref.Key]
+			value = string(data)
+			optional = ref.Optional != nil && *ref.Optional
+			if !found && !optional {
+				return fmt.Errorf("parameter %s: key %q not found in secret %s/%s", param.Name, ref.Key, namespace, ref.Name)
+			}
+
+		case param.ValueFrom.ConfigMapKeyRef != nil:
+			ref := param.ValueFrom.ConfigMapKeyRef
+			if authorize != nil {
+				if err := authorize("configmaps", ref.Name); err != nil {
+					return err
+				}
+			}
+			configMap, err := client.ConfigMaps(namespace).Get(ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("parameter %s: %v", param.Name, err)
+			}
+			value, found = configMap.Data[ref.Key]
+			optional = ref.Optional != nil && *ref.Optional
+			if !found && !optional {
+				return fmt.Errorf("parameter %s: key %q not found in configmap %s/%s", param.Name, ref.Key, namespace, ref.Name)
+			}
+
+		default:
+			return fmt.Errorf("parameter %s: no source specified", param.Name)
+		}
+
+		if !found {
+			continue
+		}
+		param.Value = value
+		param.Generate = ""
+	}
+
+	return nil
+}
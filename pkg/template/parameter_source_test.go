@@ -0,0 +1,154 @@
+package template
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+)
+
+func TestResolveParameterSources(t *testing.T) {
+	optional := true
+	client := fake.NewSimpleClientset(
+		&kapi.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "test"},
+			Data:       map[string][]byte{"password": []byte("hunter2")},
+		},
+		&kapi.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "test"},
+			Data:       map[string]string{"size": "large"},
+		},
+	)
+
+	tests := []struct {
+		name          string
+		parameter     templateapi.Parameter
+		expectedValue string
+		expectError   bool
+	}{
+		{
+			name: "secret key",
+			parameter: templateapi.Parameter{
+				Name: "PASSWORD",
+				ValueFrom: &templateapi.ParameterSource{
+					SecretKeyRef: &kapi.SecretKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "creds"},
+						Key:                  "password",
+					},
+				},
+			},
+			expectedValue: "hunter2",
+		},
+		{
+			name: "configmap key",
+			parameter: templateapi.Parameter{
+				Name: "SIZE",
+				ValueFrom: &templateapi.ParameterSource{
+					ConfigMapKeyRef: &kapi.ConfigMapKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "settings"},
+						Key:                  "size",
+					},
+				},
+			},
+			expectedValue: "large",
+		},
+		{
+			name: "missing key",
+			parameter: templateapi.Parameter{
+				Name: "PASSWORD",
+				ValueFrom: &templateapi.ParameterSource{
+					SecretKeyRef: &kapi.SecretKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "creds"},
+						Key:                  "missing",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "missing optional key",
+			parameter: templateapi.Parameter{
+				Name:  "PASSWORD",
+				Value: "default",
+				ValueFrom: &templateapi.ParameterSource{
+					SecretKeyRef: &kapi.SecretKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "creds"},
+						Key:                  "missing",
+						Optional:             &optional,
+					},
+				},
+			},
+			expectedValue: "default",
+		},
+		{
+			name: "missing secret",
+			parameter: templateapi.Parameter{
+				Name: "PASSWORD",
+				ValueFrom: &templateapi.ParameterSource{
+					SecretKeyRef: &kapi.SecretKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "missing"},
+						Key:                  "password",
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		tpl := &templateapi.Template{
+			Parameters: []templateapi.Parameter{test.parameter},
+		}
+		err := ResolveParameterSources(client.Core(), "test", tpl, nil)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("%s: expected error, got none", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if tpl.Parameters[0].Value != test.expectedValue {
+			t.Errorf("%s: expected value %q, got %q", test.name, test.expectedValue, tpl.Parameters[0].Value)
+		}
+	}
+}
+
+func TestResolveParameterSourcesAuthorize(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&kapi.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "test"},
+			Data:       map[string][]byte{"password": []byte("hunter2")},
+		},
+	)
+
+	tpl := &templateapi.Template{
+		Parameters: []templateapi.Parameter{
+			{
+				Name: "PASSWORD",
+				ValueFrom: &templateapi.ParameterSource{
+					SecretKeyRef: &kapi.SecretKeySelector{
+						LocalObjectReference: kapi.LocalObjectReference{Name: "creds"},
+						Key:                  "password",
+					},
+				},
+			},
+		},
+	}
+
+	err := ResolveParameterSources(client.Core(), "test", tpl, func(resource, name string) error {
+		return fmt.Errorf("denied %s/%s", resource, name)
+	})
+	if err == nil {
+		t.Error("expected error when authorization is denied, got none")
+	}
+	if len(tpl.Parameters[0].Value) != 0 {
+		t.Errorf("expected no value to be resolved, got %q", tpl.Parameters[0].Value)
+	}
+}
@@ -0,0 +1,187 @@
+package template
+
+import (
+	goerrors "errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	"k8s.io/kubernetes/pkg/apis/authorization"
+	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kclientsetinternal "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+
+	"github.com/openshift/origin/pkg/authorization/util"
+	"github.com/openshift/origin/pkg/bulk"
+	"github.com/openshift/origin/pkg/template"
+	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+	templatevalidation "github.com/openshift/origin/pkg/template/apis/template/validation"
+	"github.com/openshift/origin/pkg/template/generator"
+	restutil "github.com/openshift/origin/pkg/util/rest"
+)
+
+// InstantiateREST implements the instantiate subresource of templates. It
+// processes a stored template server-side and creates the rendered objects in
+// a single request, after checking that the requester may create every object.
+// When the request sets dryRun, the rendered objects are returned without
+// being created.
+type InstantiateREST struct {
+	templates rest.Getter
+	kc        kclientsetinternal.Interface
+	config    *restclient.Config
+	mapper    meta.RESTMapper
+}
+
+var _ rest.NamedCreater = &InstantiateREST{}
+
+// NewInstantiateREST creates a new instantiate subresource backed by the
+// provided template getter.
+func NewInstantiateREST(templates rest.Getter, kc kclientsetinternal.Interface, config *restclient.Config) *InstantiateREST {
+	return &InstantiateREST{
+		templates: templates,
+		kc:        kc,
+		config:    config,
+		mapper:    restutil.DefaultMultiRESTMapper(),
+	}
+}
+
+// New returns a new TemplateInstantiateRequest
+func (s *InstantiateREST) New() runtime.Object {
+	return &templateapi.TemplateInstantiateRequest{}
+}
+
+// Create instantiates the named template using the parameter values from the
+// request.
+func (s *InstantiateREST) Create(ctx apirequest.Context, name string, obj runtime.Object, _ rest.ValidateObjectFunc, _ bool) (runtime.Object, error) {
+	req, ok := obj.(*templateapi.TemplateInstantiateRequest)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("not a template instantiate request: %#v", obj))
+	}
+	if len(req.Name) > 0 && req.Name != name {
+		return nil, errors.NewBadRequest("name in URL does not match name in TemplateInstantiateRequest object")
+	}
+
+	namespace, ok := apirequest.NamespaceFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("namespace is required")
+	}
+	u, ok := apirequest.UserFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user is required")
+	}
+
+	stored, err := s.templates.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	tpl := stored.(*templateapi.Template).DeepCopy()
+
+	for k, v := range req.Parameters {
+		param := template.GetParameterByName(tpl, k)
+		if param == nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("unknown parameter name %q", k))
+		}
+		param.Value = v
+		param.Generate = ""
+	}
+
+	// resolve parameters that select their value from a Secret or ConfigMap
+	// key, checking that the requester may read each referenced object.
+	if err := template.ResolveParameterSources(s.kc.Core(), namespace, tpl, func(resource, refName string) error {
+		return util.Authorize(s.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "get",
+			Group:     kapi.GroupName,
+			Resource:  resource,
+			Name:      refName,
+		})
+	}); err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	if errs := templatevalidation.ValidateProcessedTemplate(tpl); len(errs) > 0 {
+		return nil, errors.NewInvalid(templateapi.Kind("Template"), tpl.Name, errs)
+	}
+
+	generators := map[string]generator.Generator{
+		"expression": generator.NewExpressionValueGenerator(rand.New(rand.NewSource(time.Now().UnixNano()))),
+	}
+	processor := template.NewProcessor(generators)
+	if errs := processor.Process(tpl); len(errs) > 0 {
+		return nil, errors.NewInvalid(templateapi.Kind("Template"), tpl.Name, errs)
+	}
+
+	if req.DryRun {
+		// return the rendered objects without creating anything, encoding them
+		// with the unstructured codec before the REST layer gets its shot.
+		for i := range tpl.Objects {
+			tpl.Objects[i] = runtime.NewEncodable(unstructured.UnstructuredJSONScheme, tpl.Objects[i])
+		}
+		return tpl, nil
+	}
+
+	if errs := runtime.DecodeList(tpl.Objects, legacyscheme.Codecs.UniversalDecoder()); len(errs) > 0 {
+		return nil, errors.NewInternalError(utilerrors.NewAggregate(errs))
+	}
+
+	b := bulk.Bulk{
+		Mapper: &resource.Mapper{
+			RESTMapper:   s.mapper,
+			ObjectTyper:  legacyscheme.Scheme,
+			ClientMapper: bulk.ClientMapperFromConfig(s.config),
+		},
+		Op: func(info *resource.Info, ns string, obj runtime.Object) (runtime.Object, error) {
+			if len(info.Namespace) > 0 {
+				ns = info.Namespace
+			}
+			if ns == "" {
+				return nil, goerrors.New("namespace was empty")
+			}
+			if info.Mapping.Resource == "" {
+				return nil, goerrors.New("resource was empty")
+			}
+			if err := util.Authorize(s.kc.Authorization().SubjectAccessReviews(), u, &authorization.ResourceAttributes{
+				Namespace: ns,
+				Verb:      "create",
+				Group:     info.Mapping.GroupVersionKind.Group,
+				Resource:  info.Mapping.Resource,
+				Name:      info.Name,
+			}); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		},
+	}
+
+	// run all the SARs first so the create below is as close to all-or-nothing
+	// as the API allows.
+	if errs := b.Run(&kapi.List{Items: tpl.Objects}, namespace); len(errs) > 0 {
+		return nil, errors.NewForbidden(templateapi.Resource("templates"), name, utilerrors.NewAggregate(errs))
+	}
+
+	b.Op = func(info *resource.Info, ns string, obj runtime.Object) (runtime.Object, error) {
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		if len(info.Namespace) > 0 {
+			ns = info.Namespace
+		}
+		return helper.Create(ns, false, obj)
+	}
+	if errs := b.Run(&kapi.List{Items: tpl.Objects}, namespace); len(errs) > 0 {
+		return nil, errors.NewInternalError(utilerrors.NewAggregate(errs))
+	}
+
+	for i := range tpl.Objects {
+		tpl.Objects[i] = runtime.NewEncodable(unstructured.UnstructuredJSONScheme, tpl.Objects[i])
+	}
+	return tpl, nil
+}
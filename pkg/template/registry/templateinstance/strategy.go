@@ -44,6 +44,12 @@ func (templateInstanceStrategy) PrepareForUpdate(ctx apirequest.Context, obj, ol
 	prev := old.(*templateapi.TemplateInstance)
 
 	curr.Status = prev.Status
+
+	// bump the generation when the spec changes so the controller knows to
+	// re-instantiate and upgrade the previously created objects.
+	if !kapihelper.Semantic.DeepEqual(curr.Spec, prev.Spec) {
+		curr.Generation = prev.Generation + 1
+	}
 }
 
 // Canonicalize normalizes the object after validation.
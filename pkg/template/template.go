@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	templateapi "github.com/openshift/origin/pkg/template/apis/template"
+	"github.com/openshift/origin/pkg/template/apis/template/validation"
 	. "github.com/openshift/origin/pkg/template/generator"
 	"github.com/openshift/origin/pkg/util"
 	"github.com/openshift/origin/pkg/util/stringreplace"
@@ -43,6 +44,18 @@ func (p *Processor) Process(template *templateapi.Template) field.ErrorList {
 		return append(templateErrors, errs...)
 	}
 
+	// Reject the instantiation if any resolved parameter value violates the
+	// constraints its parameter declares, reporting an error per parameter.
+	paramPath := field.NewPath("template").Child("parameters")
+	for i := range template.Parameters {
+		if errs := validation.ValidateParameterValue(&template.Parameters[i], paramPath.Index(i)); len(errs) > 0 {
+			templateErrors = append(templateErrors, errs...)
+		}
+	}
+	if len(templateErrors) > 0 {
+		return templateErrors
+	}
+
 	// Place parameters into a map for efficient lookup
 	paramMap := make(map[string]templateapi.Parameter)
 	for _, param := range template.Parameters {
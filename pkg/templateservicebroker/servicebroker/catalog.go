@@ -2,6 +2,7 @@ package servicebroker
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,12 +45,21 @@ func serviceFromTemplate(template *templateapiv1.Template) *api.Service {
 	paramOrdering := []string{}
 	required := []string{}
 	for _, param := range template.Parameters {
-		properties[param.Name] = &jsschema.Schema{
+		paramType := parameterType(template.Annotations[templateapi.ParameterTypeAnnotationPrefix+param.Name])
+		schema := &jsschema.Schema{
 			Title:       param.DisplayName,
 			Description: param.Description,
-			Default:     param.Value,
-			Type:        []jsschema.PrimitiveType{jsschema.StringType},
+			Type:        []jsschema.PrimitiveType{paramType},
 		}
+		if param.Value != "" || paramType == jsschema.StringType {
+			schema.Default = parameterValue(param.Value, paramType)
+		}
+		if enum, ok := template.Annotations[templateapi.ParameterEnumAnnotationPrefix+param.Name]; ok {
+			for _, value := range strings.Split(enum, ",") {
+				schema.Enum = append(schema.Enum, parameterValue(strings.TrimSpace(value), paramType))
+			}
+		}
+		properties[param.Name] = schema
 		if param.Required && param.Generate == "" {
 			required = append(required, param.Name)
 		}
@@ -115,6 +125,41 @@ func serviceFromTemplate(template *templateapiv1.Template) *api.Service {
 	}
 }
 
+// parameterType maps a parameter type annotation value to a JSON Schema
+// primitive type, defaulting to string for missing or unrecognised values.
+func parameterType(annotation string) jsschema.PrimitiveType {
+	switch annotation {
+	case "integer":
+		return jsschema.IntegerType
+	case "number":
+		return jsschema.NumberType
+	case "boolean":
+		return jsschema.BooleanType
+	}
+	return jsschema.StringType
+}
+
+// parameterValue converts a template parameter value (always a string) to the
+// declared JSON Schema type, falling back to the string itself when the value
+// does not parse.
+func parameterValue(value string, paramType jsschema.PrimitiveType) interface{} {
+	switch paramType {
+	case jsschema.IntegerType:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	case jsschema.NumberType:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case jsschema.BooleanType:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
 // Catalog returns our service catalog (one service per OpenShift template in
 // configured namespace(s)).
 func (b *Broker) Catalog() *api.Response {
@@ -148,3 +148,56 @@ func TestServiceFromTemplate(t *testing.T) {
 		t.Errorf("service.Plans[0].Bindable incorrectly set")
 	}
 }
+
+func TestServiceFromTemplateParameterSchemas(t *testing.T) {
+	template := &templateapiv1.Template{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "name",
+			UID:  "ee33151d-a34d-442d-a0ca-6353b73a58fd",
+			Annotations: map[string]string{
+				templateapi.ParameterTypeAnnotationPrefix + "replicas": "integer",
+				templateapi.ParameterTypeAnnotationPrefix + "debug":    "boolean",
+				templateapi.ParameterEnumAnnotationPrefix + "size":     "small, medium, large",
+				templateapi.ParameterEnumAnnotationPrefix + "replicas": "1,2,4",
+			},
+		},
+		Parameters: []templateapiv1.Parameter{
+			{
+				Name:  "replicas",
+				Value: "2",
+			},
+			{
+				Name:  "debug",
+				Value: "false",
+			},
+			{
+				Name:  "size",
+				Value: "small",
+			},
+		},
+	}
+
+	service := serviceFromTemplate(template)
+	properties := service.Plans[0].Schemas.ServiceInstance.Create["parameters"].Properties
+
+	expected := map[string]*schema.Schema{
+		"replicas": {
+			Default: int64(2),
+			Type:    schema.PrimitiveTypes{schema.IntegerType},
+			Enum:    []interface{}{int64(1), int64(2), int64(4)},
+		},
+		"debug": {
+			Default: false,
+			Type:    schema.PrimitiveTypes{schema.BooleanType},
+		},
+		"size": {
+			Default: "small",
+			Type:    schema.PrimitiveTypes{schema.StringType},
+			Enum:    []interface{}{"small", "medium", "large"},
+		},
+	}
+
+	if !reflect.DeepEqual(properties, expected) {
+		t.Errorf("properties did not match.  Got:\n%#v\nExpected:\n%#v\n", properties, expected)
+	}
+}
@@ -2,17 +2,21 @@ package servicebroker
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/golang/glog"
 
 	authorizationv1 "k8s.io/api/authorization/v1"
 	kapiv1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/authentication/user"
 
 	templateapiv1 "github.com/openshift/api/template/v1"
+	"github.com/openshift/origin/pkg/bulk"
 	templateapi "github.com/openshift/origin/pkg/template/apis/template"
 	"github.com/openshift/origin/pkg/templateservicebroker/openservicebroker/api"
 	"github.com/openshift/origin/pkg/templateservicebroker/util"
@@ -79,7 +83,10 @@ func (b *Broker) lastOperationProvisioning(u user.Info, instanceID string) *api.
 }
 
 // lastOperationDerovisioning returns the status of an asynchronous deprovision
-// operation.
+// operation.  The description reports which of the resources created by the
+// template instance have been finalized so far, and deletes that were lost
+// (for example because the broker restarted between accepting the deprovision
+// and the delete being applied) are retried as orphan mitigation.
 func (b *Broker) lastOperationDeprovisioning(u user.Info, instanceID string) *api.Response {
 	brokerTemplateInstance, err := b.templateclient.BrokerTemplateInstances().Get(instanceID, metav1.GetOptions{})
 	if err != nil {
@@ -101,5 +108,85 @@ func (b *Broker) lastOperationDeprovisioning(u user.Info, instanceID string) *ap
 		return api.Forbidden(err)
 	}
 
-	return api.NewResponse(http.StatusOK, &api.LastOperationResponse{State: api.LastOperationStateInProgress}, nil)
+	templateInstance, err := b.templateclient.TemplateInstances(namespace).Get(brokerTemplateInstance.Spec.TemplateInstance.Name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			// the template instance and its objects are gone; all that
+			// remains is the broker template instance itself.  Retry its
+			// deletion in case the original delete was lost.
+			b.mitigateOrphan(brokerTemplateInstance)
+			return api.NewResponse(http.StatusOK, &api.LastOperationResponse{State: api.LastOperationStateInProgress, Description: "waiting for the broker template instance to be removed"}, nil)
+		}
+		return api.InternalServerError(err)
+	}
+
+	if templateInstance.DeletionTimestamp == nil {
+		// the accepted deprovision never reached the template instance;
+		// re-issue the cascading delete.
+		glog.V(2).Infof("Template service broker: LastOperation: retrying lost deprovision of instanceID %s", instanceID)
+		b.mitigateOrphan(brokerTemplateInstance)
+	}
+
+	return api.NewResponse(http.StatusOK, &api.LastOperationResponse{State: api.LastOperationStateInProgress, Description: b.finalizationReport(templateInstance)}, nil)
+}
+
+// mitigateOrphan re-issues the cascading delete for a broker template
+// instance whose original deprovision delete appears to have been lost.
+// Errors are ignored; the delete will be retried on the next poll.
+func (b *Broker) mitigateOrphan(brokerTemplateInstance *templateapiv1.BrokerTemplateInstance) {
+	opts := metav1.NewPreconditionDeleteOptions(string(brokerTemplateInstance.UID))
+	policy := metav1.DeletePropagationForeground
+	opts.PropagationPolicy = &policy
+	if err := b.templateclient.BrokerTemplateInstances().Delete(brokerTemplateInstance.Name, opts); err != nil && !kerrors.IsNotFound(err) {
+		glog.V(2).Infof("Template service broker: orphan mitigation delete of %s failed: %v", brokerTemplateInstance.Name, err)
+	}
+}
+
+// finalizationReport describes how many of the resources created by the
+// template instance have been deleted so far, naming any that remain or whose
+// status could not be determined.
+func (b *Broker) finalizationReport(templateInstance *templateapiv1.TemplateInstance) string {
+	if len(templateInstance.Status.Objects) == 0 {
+		return "waiting for the template instance to be removed"
+	}
+
+	var remaining, failed []string
+	deleted := 0
+	for _, object := range templateInstance.Status.Objects {
+		name := fmt.Sprintf("%s %s/%s", object.Ref.Kind, object.Ref.Namespace, object.Ref.Name)
+
+		mapping, err := b.restmapper.RESTMapping(object.Ref.GroupVersionKind().GroupKind())
+		if err != nil {
+			failed = append(failed, name)
+			continue
+		}
+		cli, err := bulk.ClientMapperFromConfig(b.extconfig).ClientForMapping(mapping)
+		if err != nil {
+			failed = append(failed, name)
+			continue
+		}
+		obj, err := cli.Get().Resource(mapping.Resource).NamespaceIfScoped(object.Ref.Namespace, mapping.Scope.Name() == meta.RESTScopeNameNamespace).Name(object.Ref.Name).Do().Get()
+		switch {
+		case kerrors.IsNotFound(err):
+			deleted++
+		case err != nil:
+			failed = append(failed, name)
+		default:
+			if accessor, err := meta.Accessor(obj); err == nil && accessor.GetUID() != object.Ref.UID {
+				// the object was recreated by someone else; ours is gone
+				deleted++
+			} else {
+				remaining = append(remaining, name)
+			}
+		}
+	}
+
+	description := fmt.Sprintf("deleted %d/%d created resources", deleted, len(templateInstance.Status.Objects))
+	if len(remaining) > 0 {
+		description += fmt.Sprintf("; awaiting deletion of %s", strings.Join(remaining, ", "))
+	}
+	if len(failed) > 0 {
+		description += fmt.Sprintf("; could not determine the status of %s", strings.Join(failed, ", "))
+	}
+	return description
 }
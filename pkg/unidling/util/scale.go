@@ -3,6 +3,7 @@ package util
 import (
 	"github.com/golang/glog"
 
+	kappsapiv1 "k8s.io/api/apps/v1"
 	kapiv1 "k8s.io/api/core/v1"
 	kextapi "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,8 +11,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	kextensionsclient "k8s.io/client-go/kubernetes/typed/extensions/v1beta1"
+	kappsapi "k8s.io/kubernetes/pkg/apis/apps"
 	kapi "k8s.io/kubernetes/pkg/apis/core"
+	kextensionsapi "k8s.io/kubernetes/pkg/apis/extensions"
+	kappsinternalclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/apps/internalversion"
 	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	kextensionsinternalclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/extensions/internalversion"
 
 	appsapiv1 "github.com/openshift/api/apps/v1"
 	appsapi "github.com/openshift/origin/pkg/apps/apis/apps"
@@ -24,11 +29,13 @@ import (
 
 type AnnotationFunc func(currentReplicas int32, annotations map[string]string)
 
-func NewScaleAnnotater(scales kextensionsclient.ScalesGetter, dcs appsclient.DeploymentConfigsGetter, rcs kcoreclient.ReplicationControllersGetter, changeAnnots AnnotationFunc) *ScaleAnnotater {
+func NewScaleAnnotater(scales kextensionsclient.ScalesGetter, dcs appsclient.DeploymentConfigsGetter, rcs kcoreclient.ReplicationControllersGetter, deploys kextensionsinternalclient.DeploymentsGetter, ssets kappsinternalclient.StatefulSetsGetter, changeAnnots AnnotationFunc) *ScaleAnnotater {
 	return &ScaleAnnotater{
 		scales:            scales,
 		dcs:               dcs,
 		rcs:               rcs,
+		deploys:           deploys,
+		ssets:             ssets,
 		ChangeAnnotations: changeAnnots,
 	}
 }
@@ -37,6 +44,8 @@ type ScaleAnnotater struct {
 	scales            kextensionsclient.ScalesGetter
 	dcs               appsclient.DeploymentConfigsGetter
 	rcs               kcoreclient.ReplicationControllersGetter
+	deploys           kextensionsinternalclient.DeploymentsGetter
+	ssets             kappsinternalclient.StatefulSetsGetter
 	ChangeAnnotations AnnotationFunc
 }
 
@@ -47,18 +56,22 @@ type ScaleUpdater interface {
 
 // ScaleUpdater implements unidlingutil.ScaleUpdater
 type scaleUpdater struct {
-	encoder   runtime.Encoder
-	namespace string
-	dcGetter  appsclient.DeploymentConfigsGetter
-	rcGetter  kcoreclient.ReplicationControllersGetter
+	encoder      runtime.Encoder
+	namespace    string
+	dcGetter     appsclient.DeploymentConfigsGetter
+	rcGetter     kcoreclient.ReplicationControllersGetter
+	deployGetter kextensionsinternalclient.DeploymentsGetter
+	ssetGetter   kappsinternalclient.StatefulSetsGetter
 }
 
-func NewScaleUpdater(encoder runtime.Encoder, namespace string, dcGetter appsclient.DeploymentConfigsGetter, rcGetter kcoreclient.ReplicationControllersGetter) ScaleUpdater {
+func NewScaleUpdater(encoder runtime.Encoder, namespace string, dcGetter appsclient.DeploymentConfigsGetter, rcGetter kcoreclient.ReplicationControllersGetter, deployGetter kextensionsinternalclient.DeploymentsGetter, ssetGetter kappsinternalclient.StatefulSetsGetter) ScaleUpdater {
 	return scaleUpdater{
-		encoder:   encoder,
-		namespace: namespace,
-		dcGetter:  dcGetter,
-		rcGetter:  rcGetter,
+		encoder:      encoder,
+		namespace:    namespace,
+		dcGetter:     dcGetter,
+		rcGetter:     rcGetter,
+		deployGetter: deployGetter,
+		ssetGetter:   ssetGetter,
 	}
 }
 
@@ -112,6 +125,44 @@ func (s scaleUpdater) Update(annotator *ScaleAnnotater, obj runtime.Object, scal
 		}
 
 		_, err = s.rcGetter.ReplicationControllers(s.namespace).Patch(typedObj.Name, types.StrategicMergePatchType, patchBytes)
+	case *kextensionsapi.Deployment:
+		if typedObj.Annotations == nil {
+			typedObj.Annotations = make(map[string]string)
+		}
+
+		annotator.ChangeAnnotations(typedObj.Spec.Replicas, typedObj.Annotations)
+		typedObj.Spec.Replicas = scale.Spec.Replicas
+
+		newObj, err = runtime.Encode(s.encoder, typedObj)
+		if err != nil {
+			return err
+		}
+
+		patchBytes, err = strategicpatch.CreateTwoWayMergePatch(originalObj, newObj, &kextapi.Deployment{})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.deployGetter.Deployments(s.namespace).Patch(typedObj.Name, types.StrategicMergePatchType, patchBytes)
+	case *kappsapi.StatefulSet:
+		if typedObj.Annotations == nil {
+			typedObj.Annotations = make(map[string]string)
+		}
+
+		annotator.ChangeAnnotations(typedObj.Spec.Replicas, typedObj.Annotations)
+		typedObj.Spec.Replicas = scale.Spec.Replicas
+
+		newObj, err = runtime.Encode(s.encoder, typedObj)
+		if err != nil {
+			return err
+		}
+
+		patchBytes, err = strategicpatch.CreateTwoWayMergePatch(originalObj, newObj, &kappsapiv1.StatefulSet{})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.ssetGetter.StatefulSets(s.namespace).Patch(typedObj.Name, types.StrategicMergePatchType, patchBytes)
 	}
 	return err
 }
@@ -144,6 +195,26 @@ func (c *ScaleAnnotater) GetObjectWithScale(namespace string, ref unidlingapi.Cr
 			Spec: kextapi.ScaleSpec{Replicas: rc.Spec.Replicas},
 		}
 		obj = rc
+	case ref.Kind == "Deployment" && (ref.Group == kextensionsapi.GroupName || ref.Group == kappsapi.GroupName):
+		var deployment *kextensionsapi.Deployment
+		deployment, err = c.deploys.Deployments(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		scale = &kextapi.Scale{
+			Spec: kextapi.ScaleSpec{Replicas: deployment.Spec.Replicas},
+		}
+		obj = deployment
+	case ref.Kind == "StatefulSet" && ref.Group == kappsapi.GroupName:
+		var sset *kappsapi.StatefulSet
+		sset, err = c.ssets.StatefulSets(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		scale = &kextapi.Scale{
+			Spec: kextapi.ScaleSpec{Replicas: sset.Spec.Replicas},
+		}
+		obj = sset
 	default:
 		scale, err = c.scales.Scales(namespace).Get(ref.Kind, ref.Name)
 		if err != nil {
@@ -166,7 +237,7 @@ func (c *ScaleAnnotater) UpdateObjectScale(updater ScaleUpdater, namespace strin
 	}
 
 	switch obj.(type) {
-	case *appsapi.DeploymentConfig, *kapi.ReplicationController:
+	case *appsapi.DeploymentConfig, *kapi.ReplicationController, *kextensionsapi.Deployment, *kappsapi.StatefulSet:
 		return updater.Update(c, obj, scale)
 	default:
 		glog.V(2).Infof("Unidling unknown type %t: using scale interface and not removing annotations", obj)
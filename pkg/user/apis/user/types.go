@@ -21,6 +21,10 @@ type User struct {
 	Identities []string
 
 	Groups []string
+
+	// Disabled prevents the user from authenticating. Existing tokens stop
+	// working, but role bindings and owned objects are kept intact.
+	Disabled bool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -50,6 +54,9 @@ type Identity struct {
 	User kapi.ObjectReference
 
 	Extra map[string]string
+
+	// Disabled prevents logins through this identity
+	Disabled bool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -90,6 +90,7 @@ func autoConvert_v1_Identity_To_user_Identity(in *v1.Identity, out *user.Identit
 		return err
 	}
 	out.Extra = *(*map[string]string)(unsafe.Pointer(&in.Extra))
+	out.Disabled = in.Disabled
 	return nil
 }
 
@@ -106,6 +107,7 @@ func autoConvert_user_Identity_To_v1_Identity(in *user.Identity, out *v1.Identit
 		return err
 	}
 	out.Extra = *(*map[string]string)(unsafe.Pointer(&in.Extra))
+	out.Disabled = in.Disabled
 	return nil
 }
 
@@ -161,6 +163,7 @@ func autoConvert_v1_User_To_user_User(in *v1.User, out *user.User, s conversion.
 	out.FullName = in.FullName
 	out.Identities = *(*[]string)(unsafe.Pointer(&in.Identities))
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Disabled = in.Disabled
 	return nil
 }
 
@@ -174,6 +177,7 @@ func autoConvert_user_User_To_v1_User(in *user.User, out *v1.User, s conversion.
 	out.FullName = in.FullName
 	out.Identities = *(*[]string)(unsafe.Pointer(&in.Identities))
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Disabled = in.Disabled
 	return nil
 }
 